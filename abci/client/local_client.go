@@ -137,11 +137,7 @@ func (app *localClient) PrepareProposal(ctx context.Context, req *cmtabci.Reques
 		return nil, fmt.Errorf("invalid max tx bytes: %d", req.MaxTxBytes)
 	}
 
-	// For local client, we'll just return the transactions as-is
-	// In a real implementation, this would involve transaction ordering and filtering
-	return &cmtabci.ResponsePrepareProposal{
-		Txs: req.Txs,
-	}, nil
+	return app.Application.PrepareProposal(ctx, req)
 }
 
 func (app *localClient) ProcessProposal(ctx context.Context, req *cmtabci.RequestProcessProposal) (*cmtabci.ResponseProcessProposal, error) {
@@ -153,11 +149,7 @@ func (app *localClient) ProcessProposal(ctx context.Context, req *cmtabci.Reques
 		return nil, fmt.Errorf("ProcessProposal validation failed: %w", err)
 	}
 
-	// For local client, we'll accept all proposals
-	// In a real implementation, this would involve validation logic
-	return &cmtabci.ResponseProcessProposal{
-		Status: cmtabci.ResponseProcessProposal_ACCEPT,
-	}, nil
+	return app.Application.ProcessProposal(ctx, req)
 }
 
 func (app *localClient) ExtendVote(ctx context.Context, req *cmtabci.RequestExtendVote) (*cmtabci.ResponseExtendVote, error) {
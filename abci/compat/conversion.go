@@ -1,6 +1,10 @@
 package compat
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	cmcrypto "github.com/cometbft/cometbft/crypto"
 	cmcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
@@ -78,17 +82,116 @@ func CheckTxResponseFromComet(src *cmtabci.ResponseCheckTx) *localabci.CheckTxRe
 	}
 }
 
-// ConsensusParams conversion
+// ConsensusParams conversion. Params left nil in src, which are not updated,
+// stay nil. The ABCI params have no local equivalent and are dropped.
+// CometBFT validator params have no NextPubKeyTypes and SwitchHeight, see
+// ConsensusParamUpdatesFromFinalizeBlock for the updates scheduling a switch of
+// validator key types.
 func ConsensusParamsFromComet(src *cmttypes.ConsensusParams) *protoabci.ConsensusParams {
 	if src == nil {
 		return nil
 	}
-	return &protoabci.ConsensusParams{
-		Block:     &protoabci.BlockParams{},      // Use abci package types
-		Evidence:  &prototypes.EvidenceParams{},  // Use types1 alias
-		Validator: &prototypes.ValidatorParams{}, // Use types1 alias
-		Version:   &prototypes.VersionParams{},   // Use types1 alias
+	dst := &protoabci.ConsensusParams{}
+	if src.Block != nil {
+		dst.Block = &protoabci.BlockParams{
+			MaxBytes: src.Block.MaxBytes,
+			MaxGas:   src.Block.MaxGas,
+		}
 	}
+	if src.Evidence != nil {
+		dst.Evidence = &prototypes.EvidenceParams{
+			MaxAgeNumBlocks: src.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  src.Evidence.MaxAgeDuration,
+			MaxBytes:        src.Evidence.MaxBytes,
+		}
+	}
+	if src.Validator != nil {
+		dst.Validator = &prototypes.ValidatorParams{
+			PubKeyTypes: append([]string{}, src.Validator.PubKeyTypes...),
+		}
+	}
+	if src.Version != nil {
+		dst.Version = &prototypes.VersionParams{
+			AppVersion: src.Version.App,
+		}
+	}
+	return dst
+}
+
+// EventTypeValidatorKeySwitch is the type of the FinalizeBlock event with
+// which the application schedules a switch of validator key types, as the
+// validator params of its consensus param updates have no NextPubKeyTypes and
+// SwitchHeight. The event must come with validator param updates, whose
+// PubKeyTypes stay allowed until the switch height:
+//
+//	validator_key_switch.switch_height=100
+//	validator_key_switch.next_pub_key_types=ed25519,hybrid
+const (
+	EventTypeValidatorKeySwitch = "validator_key_switch"
+
+	AttributeKeySwitchHeight    = "switch_height"
+	AttributeKeyNextPubKeyTypes = "next_pub_key_types"
+)
+
+// ValidatorKeySwitchEvent returns the event scheduling a switch to
+// nextPubKeyTypes at switchHeight.
+func ValidatorKeySwitchEvent(switchHeight int64, nextPubKeyTypes []string) cmtabci.Event {
+	return cmtabci.Event{
+		Type: EventTypeValidatorKeySwitch,
+		Attributes: []cmtabci.EventAttribute{
+			{Key: AttributeKeySwitchHeight, Value: strconv.FormatInt(switchHeight, 10), Index: true},
+			{Key: AttributeKeyNextPubKeyTypes, Value: strings.Join(nextPubKeyTypes, ","), Index: true},
+		},
+	}
+}
+
+// ConsensusParamUpdatesFromFinalizeBlock returns the consensus param updates
+// of res, see ConsensusParamsFromComet, with the NextPubKeyTypes and
+// SwitchHeight of its validator_key_switch event, if any.
+func ConsensusParamUpdatesFromFinalizeBlock(res *cmtabci.ResponseFinalizeBlock) (*protoabci.ConsensusParams, error) {
+	updates := ConsensusParamsFromComet(res.ConsensusParamUpdates)
+	found := false
+	for _, event := range res.Events {
+		if event.Type != EventTypeValidatorKeySwitch {
+			continue
+		}
+		if found {
+			return nil, fmt.Errorf("more than one %s event", EventTypeValidatorKeySwitch)
+		}
+		found = true
+		if updates == nil || updates.Validator == nil {
+			return nil, fmt.Errorf("%s event without validator param updates", EventTypeValidatorKeySwitch)
+		}
+		if err := setValidatorKeySwitch(updates.Validator, event); err != nil {
+			return nil, fmt.Errorf("invalid %s event: %w", EventTypeValidatorKeySwitch, err)
+		}
+	}
+	return updates, nil
+}
+
+func setValidatorKeySwitch(params *prototypes.ValidatorParams, event cmtabci.Event) error {
+	var (
+		height   string
+		keyTypes string
+	)
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case AttributeKeySwitchHeight:
+			height = attr.Value
+		case AttributeKeyNextPubKeyTypes:
+			keyTypes = attr.Value
+		}
+	}
+	switchHeight, err := strconv.ParseInt(height, 10, 64)
+	if err != nil || switchHeight <= 0 {
+		return fmt.Errorf("%s must be a positive height, got %q", AttributeKeySwitchHeight, height)
+	}
+	if keyTypes == "" {
+		return fmt.Errorf("%s can't be empty", AttributeKeyNextPubKeyTypes)
+	}
+	params.NextPubKeyTypes = strings.Split(keyTypes, ",")
+	params.SwitchHeight = switchHeight
+	return nil
 }
 
 // Convert slice of ExecTxResult
@@ -138,7 +241,9 @@ func ConsensusParamsToProtoTypes(src *protoabci.ConsensusParams) *prototypes.Con
 	var validator prototypes.ValidatorParams
 	if src.Validator != nil {
 		validator = prototypes.ValidatorParams{
-			PubKeyTypes: src.Validator.PubKeyTypes,
+			PubKeyTypes:     src.Validator.PubKeyTypes,
+			NextPubKeyTypes: src.Validator.NextPubKeyTypes,
+			SwitchHeight:    src.Validator.SwitchHeight,
 		}
 	}
 
@@ -153,11 +153,11 @@ func (app *Application) Echo(ctx context.Context, req *abci.EchoRequest) (*abci.
 
 // Additional methods required by the Application interface
 func (app *Application) PrepareProposal(ctx context.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
-	return &abci.PrepareProposalResponse{}, nil
+	return &abci.PrepareProposalResponse{Txs: req.Txs}, nil
 }
 
 func (app *Application) ProcessProposal(ctx context.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
-	return &abci.ProcessProposalResponse{}, nil
+	return &abci.ProcessProposalResponse{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 }
 
 func (app *Application) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
@@ -1,13 +1,25 @@
 package kvstore
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
 
 	dbm "github.com/cometbft/cometbft-db"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/fluentum-chain/fluentum/abci/example/code"
 	abci "github.com/fluentum-chain/fluentum/abci/types"
+	cryptoenc "github.com/fluentum-chain/fluentum/crypto/encoding"
 	"github.com/fluentum-chain/fluentum/libs/log"
+	pc "github.com/fluentum-chain/fluentum/proto/tendermint/crypto"
+)
+
+const (
+	ValidatorSetChangePrefix string = "val:"
 )
 
 var _ abci.Application = (*PersistentKVStoreApplication)(nil)
@@ -107,15 +119,26 @@ func (app *PersistentKVStoreApplication) Echo(ctx context.Context, req *abci.Ech
 
 // Additional required methods for the Application interface
 func (app *PersistentKVStoreApplication) PrepareProposal(ctx context.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
-	return &abci.PrepareProposalResponse{}, nil
+	return &abci.PrepareProposalResponse{Txs: req.Txs}, nil
 }
 
 func (app *PersistentKVStoreApplication) ProcessProposal(ctx context.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
-	return &abci.ProcessProposalResponse{}, nil
+	return &abci.ProcessProposalResponse{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 }
 
+// FinalizeBlock applies the validator set changes of the block, see
+// MakeValSetChangeTx. Other transactions always succeed.
 func (app *PersistentKVStoreApplication) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
-	return &abci.FinalizeBlockResponse{}, nil
+	app.ValUpdates = make([]abci.ValidatorUpdate, 0)
+	txResults := make([]*abci.ExecTxResult, len(req.Txs))
+	for i, tx := range req.Txs {
+		if isValidatorTx(tx) {
+			txResults[i] = app.execValidatorTx(tx)
+		} else {
+			txResults[i] = &abci.ExecTxResult{Code: code.CodeTypeOK}
+		}
+	}
+	return &abci.FinalizeBlockResponse{TxResults: txResults, ValidatorUpdates: app.ValUpdates}, nil
 }
 
 func (app *PersistentKVStoreApplication) ExtendVote(ctx context.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
@@ -125,3 +148,52 @@ func (app *PersistentKVStoreApplication) ExtendVote(ctx context.Context, req *ab
 func (app *PersistentKVStoreApplication) VerifyVoteExtension(ctx context.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
 	return &abci.VerifyVoteExtensionResponse{}, nil
 }
+
+//---------------------------------------------
+// update validators
+
+// MakeValSetChangeTx returns a transaction setting the power of the validator
+// with pubkey, which is removed if power is 0.
+func MakeValSetChangeTx(pubkey pc.PublicKey, power int64) []byte {
+	pk, err := cryptoenc.PubKeyFromProto(pubkey)
+	if err != nil {
+		panic(err)
+	}
+	pubStr := base64.StdEncoding.EncodeToString(pk.Bytes())
+	return []byte(fmt.Sprintf("%s%s!%d", ValidatorSetChangePrefix, pubStr, power))
+}
+
+func isValidatorTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, []byte(ValidatorSetChangePrefix))
+}
+
+// format is "val:pubkey!power"
+// pubkey is a base64-encoded 32-byte ed25519 key
+func (app *PersistentKVStoreApplication) execValidatorTx(tx []byte) *abci.ExecTxResult {
+	tx = tx[len(ValidatorSetChangePrefix):]
+
+	pubKeyAndPower := strings.Split(string(tx), "!")
+	if len(pubKeyAndPower) != 2 {
+		return &abci.ExecTxResult{
+			Code: code.CodeTypeEncodingError,
+			Log:  fmt.Sprintf("Expected 'pubkey!power'. Got %v", pubKeyAndPower)}
+	}
+	pubkeyS, powerS := pubKeyAndPower[0], pubKeyAndPower[1]
+
+	pubkey, err := base64.StdEncoding.DecodeString(pubkeyS)
+	if err != nil {
+		return &abci.ExecTxResult{
+			Code: code.CodeTypeEncodingError,
+			Log:  fmt.Sprintf("Pubkey (%s) is invalid base64", pubkeyS)}
+	}
+
+	power, err := strconv.ParseInt(powerS, 10, 64)
+	if err != nil {
+		return &abci.ExecTxResult{
+			Code: code.CodeTypeEncodingError,
+			Log:  fmt.Sprintf("Power (%s) is not an int", powerS)}
+	}
+
+	app.ValUpdates = append(app.ValUpdates, cmtabci.UpdateValidator(pubkey, power, ""))
+	return &abci.ExecTxResult{Code: code.CodeTypeOK}
+}
@@ -234,13 +234,13 @@ func (a *ABCIAdapter) ExtendVote(ctx context.Context, req *cmtabci.RequestExtend
 // PrepareProposal implements cmtabci.Application
 func (a *ABCIAdapter) PrepareProposal(ctx context.Context, req *cmtabci.RequestPrepareProposal) (*cmtabci.ResponsePrepareProposal, error) {
 	// TODO: Implement or forward to underlying app if needed
-	return &cmtabci.ResponsePrepareProposal{}, nil
+	return &cmtabci.ResponsePrepareProposal{Txs: req.Txs}, nil
 }
 
 // ProcessProposal implements cmtabci.Application
 func (a *ABCIAdapter) ProcessProposal(ctx context.Context, req *cmtabci.RequestProcessProposal) (*cmtabci.ResponseProcessProposal, error) {
 	// TODO: Implement or forward to underlying app if needed
-	return &cmtabci.ResponseProcessProposal{}, nil
+	return &cmtabci.ResponseProcessProposal{Status: cmtabci.ResponseProcessProposal_ACCEPT}, nil
 }
 
 // VerifyVoteExtension implements cmtabci.Application
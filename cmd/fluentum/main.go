@@ -40,6 +40,8 @@ import (
 	_ "github.com/fluentum-chain/fluentum/crypto/ed25519"
 	"github.com/fluentum-chain/fluentum/app"
 	"github.com/fluentum-chain/fluentum/core/plugin"
+	"github.com/fluentum-chain/fluentum/core/validator"
+	"github.com/fluentum-chain/fluentum/features"
 	fluentumlog "github.com/fluentum-chain/fluentum/libs/log"
 	"github.com/fluentum-chain/fluentum/node"
 	"github.com/fluentum-chain/fluentum/p2p"
@@ -406,6 +408,36 @@ func loadQuantumSigner(cfg *config.Config) error {
 	return nil
 }
 
+// loadAIValidator loads the AI validator plugin if AI validation is enabled in
+// the feature configuration, and returns nil otherwise.
+func loadAIValidator(cfg *core.FeatureConfig) (*validator.AIValidator, error) {
+	aiConfig := cfg.Features.AIValidation
+	if !cfg.Features.Enabled || !aiConfig.Enabled {
+		return nil, nil
+	}
+	if aiConfig.PluginPath == "" {
+		return nil, errors.New("AI validation is enabled but features.ai_validation.plugin_path is not set")
+	}
+
+	aiValidator, err := validator.NewAIValidator(&validator.AIValidatorConfig{
+		EnableAIPrediction:  true,
+		BatchSize:           aiConfig.MaxBatchSize,
+		ConfidenceThreshold: aiConfig.ConfidenceThreshold,
+		PluginPath:          aiConfig.PluginPath,
+		ModelConfig: map[string]interface{}{
+			"weights_path":         aiConfig.ModelPath,
+			"use_gpu":              aiConfig.UseGPU,
+			"max_batch_size":       aiConfig.MaxBatchSize,
+			"confidence_threshold": aiConfig.ConfidenceThreshold,
+			"enable_logging":       aiConfig.EnableLogging,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AI validator: %w", err)
+	}
+	return aiValidator, nil
+}
+
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
 func AddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -801,12 +833,20 @@ func startNode(cmd *cobra.Command, encodingConfig app.EncodingConfig) error {
 	nodeConfig.Moniker = moniker
 
 	// Initialize feature loader
-	featuresPath := filepath.Join(homeDir, "config", "features.toml")
-	featureLoader := core.NewFeatureLoader(featuresPath, version.Version)
+	featureLoader, err := core.NewFeatureLoader(homeDir, version.Version)
+	if err != nil {
+		return fmt.Errorf("failed to create feature loader: %w", err)
+	}
 	if err := featureLoader.LoadConfiguration(); err != nil {
 		return fmt.Errorf("failed to load feature configuration: %w", err)
 	}
 
+	// Export the feature metrics with the node ones
+	if nodeConfig.Instrumentation.Prometheus {
+		featureLoader.GetFeatureManager().SetMetrics(
+			features.PrometheusMetrics(nodeConfig.Instrumentation.Namespace, "chain_id", chainID))
+	}
+
 	// Initialize and start features
 	if err := featureLoader.InitializeFeatures(); err != nil {
 		return fmt.Errorf("failed to initialize features: %w", err)
@@ -829,8 +869,6 @@ func startNode(cmd *cobra.Command, encodingConfig app.EncodingConfig) error {
 	fmt.Printf("DEBUG: nodeConfig.RPC.ListenAddress = %s\n", nodeConfig.RPC.ListenAddress)
 
 	// Load node configuration
-	var err error
-
 	// Override default configuration with flag values if needed
 	if testnetMode {
 		// nodeConfig.P2P.Seeds = "seed1.testnet:26656,seed2.testnet:26656"
@@ -859,9 +897,32 @@ func startNode(cmd *cobra.Command, encodingConfig app.EncodingConfig) error {
 		return fmt.Errorf("failed to load or generate node key: %w", err)
 	}
 
-	// Create ClientCreator using Fluentum's proxy
-	adapter := &CosmosAppAdapter{App: appInstance}
-	clientCreator := proxy.NewLocalClientCreator(adapter)
+	// Create ClientCreator using Fluentum's proxy. The blocks proposed by the
+	// node are ordered by the AI plugin if AI validation is enabled.
+	var abciApp abcitypes.Application = &CosmosAppAdapter{App: appInstance}
+	aiValidator, err := loadAIValidator(featureLoader.GetConfig())
+	if err != nil {
+		return err
+	}
+	if aiValidator != nil {
+		orderingApp := validator.NewOrderingApplication(abciApp, aiValidator)
+		orderingApp.SetLogger(fluentumLogger.With("module", "ai_ordering"))
+		abciApp = orderingApp
+		fluentumLogger.Info("AI transaction ordering enabled", "plugin", featureLoader.GetConfig().Features.AIValidation.PluginPath)
+	}
+	clientCreator := proxy.NewLocalClientCreator(abciApp)
+
+	// The quantum signer plugin is registered for quantum_reload. Votes and
+	// proposals are signed by privVal, whose signatures the node counts.
+	if err := loadQuantumSigner(nodeConfig); err != nil {
+		return err
+	}
+
+	// The AI validator is handed to the node, which registers its metrics
+	var nodeOptions []node.Option
+	if aiValidator != nil {
+		nodeOptions = append(nodeOptions, node.AIValidator(aiValidator))
+	}
 
 	// GenesisDocProvider, DBProvider, MetricsProvider
 	genesisDocProvider := node.DefaultGenesisDocProviderFunc(nodeConfig)
@@ -878,6 +939,7 @@ func startNode(cmd *cobra.Command, encodingConfig app.EncodingConfig) error {
 		dbProvider,
 		metricsProvider,
 		fluentumLogger,
+		nodeOptions...,
 	)
 
 	if err != nil {
@@ -952,7 +1014,8 @@ enable_metrics = true
 max_latency_ms = 1000
 
 [features.ai_validation]
-enabled = true
+enabled = false
+plugin_path = ""
 model_path = ""
 use_gpu = true
 max_batch_size = 32
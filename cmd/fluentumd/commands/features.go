@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"os"
 	"text/tabwriter"
@@ -23,6 +25,7 @@ func FeatureCommands() *cobra.Command {
 		featureEnableCommand(),
 		featureDisableCommand(),
 		featureUpdateCommand(),
+		featureTrustCommand(),
 	)
 
 	return cmd
@@ -34,7 +37,7 @@ func featureListCommand() *cobra.Command {
 		Short: "List all installed features",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get the feature manager from the command context
-			fm, ok := cmd.Context().Value("featureManager").(*features.FeatureManager)
+			fm, ok := cmd.Context().Value("featureManager").(*features.Manager)
 			if !ok {
 				return fmt.Errorf("failed to get feature manager from context")
 			}
@@ -51,7 +54,7 @@ func featureListCommand() *cobra.Command {
 
 			for _, f := range featureList {
 				status := "Not loaded"
-				if f.Plugin != nil {
+				if f.Feature != nil {
 					status = "Loaded"
 				}
 				fmt.Fprintf(w, "%s\t%s\t%v\t%s\n",
@@ -81,13 +84,13 @@ func featureInstallCommand() *cobra.Command {
 			}
 
 			// Get the feature manager from the command context
-			fm, ok := cmd.Context().Value("featureManager").(*features.FeatureManager)
+			fm, ok := cmd.Context().Value("featureManager").(*features.Manager)
 			if !ok {
 				return fmt.Errorf("failed to get feature manager from context")
 			}
 
 			// Check if feature is already installed
-			if _, exists := fm.GetFeature(featureName); exists {
+			if fm.HasFeature(featureName) {
 				return fmt.Errorf("feature %s is already installed", featureName)
 			}
 
@@ -115,13 +118,13 @@ func featureUninstallCommand() *cobra.Command {
 			featureName := args[0]
 
 			// Get the feature manager from the command context
-			fm, ok := cmd.Context().Value("featureManager").(*features.FeatureManager)
+			fm, ok := cmd.Context().Value("featureManager").(*features.Manager)
 			if !ok {
 				return fmt.Errorf("failed to get feature manager from context")
 			}
 
 			// Check if feature exists before uninstalling
-			if _, exists := fm.GetFeature(featureName); !exists {
+			if !fm.HasFeature(featureName) {
 				return fmt.Errorf("feature %s not found", featureName)
 			}
 
@@ -144,13 +147,13 @@ func featureEnableCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			featureName := args[0]
 			// Get the feature manager from the command context
-			fm, ok := cmd.Context().Value("featureManager").(*features.FeatureManager)
+			fm, ok := cmd.Context().Value("featureManager").(*features.Manager)
 			if !ok {
 				return fmt.Errorf("failed to get feature manager from context")
 			}
 
 			// Check if feature exists before enabling
-			if _, exists := fm.GetFeature(featureName); !exists {
+			if !fm.HasFeature(featureName) {
 				return fmt.Errorf("feature %s not found", featureName)
 			}
 
@@ -174,13 +177,13 @@ func featureDisableCommand() *cobra.Command {
 			featureName := args[0]
 
 			// Get the feature manager from the command context
-			fm, ok := cmd.Context().Value("featureManager").(*features.FeatureManager)
+			fm, ok := cmd.Context().Value("featureManager").(*features.Manager)
 			if !ok {
 				return fmt.Errorf("failed to get feature manager from context")
 			}
 
 			// Check if feature exists before disabling
-			if _, exists := fm.GetFeature(featureName); !exists {
+			if !fm.HasFeature(featureName) {
 				return fmt.Errorf("feature %s not found", featureName)
 			}
 
@@ -204,7 +207,7 @@ func featureUpdateCommand() *cobra.Command {
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get the feature manager from the command context
-			fm, ok := cmd.Context().Value("featureManager").(*features.FeatureManager)
+			fm, ok := cmd.Context().Value("featureManager").(*features.Manager)
 			if !ok {
 				return fmt.Errorf("failed to get feature manager from context")
 			}
@@ -235,7 +238,7 @@ func featureUpdateCommand() *cobra.Command {
 			featureName := args[0]
 
 			// Check if feature exists before updating
-			if _, exists := fm.GetFeature(featureName); !exists {
+			if !fm.HasFeature(featureName) {
 				return fmt.Errorf("feature %s not found", featureName)
 			}
 
@@ -254,6 +257,90 @@ func featureUpdateCommand() *cobra.Command {
 	return cmd
 }
 
+func featureTrustCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trust",
+		Short: "Manage the publisher keys trusted to sign features",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "add <publisher> <algorithm> <public-key>",
+			Short: "Trust a publisher key, given in base64, to sign features (algorithm: ed25519 or dilithium)",
+			Args:  cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				trustStore, err := trustStoreFromContext(cmd)
+				if err != nil {
+					return err
+				}
+
+				pubKey, err := base64.StdEncoding.DecodeString(args[2])
+				if err != nil {
+					return fmt.Errorf("invalid public key: %w", err)
+				}
+				if err := trustStore.Add(args[0], features.TrustedKey{Algorithm: args[1], PublicKey: pubKey}); err != nil {
+					return fmt.Errorf("failed to trust key: %w", err)
+				}
+
+				fmt.Printf("Successfully trusted %s key of publisher: %s\n", args[1], args[0])
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "remove <publisher>",
+			Short: "Remove the keys of a publisher",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				trustStore, err := trustStoreFromContext(cmd)
+				if err != nil {
+					return err
+				}
+
+				if err := trustStore.Remove(args[0]); err != nil {
+					return err
+				}
+
+				fmt.Printf("Successfully removed publisher: %s\n", args[0])
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "list",
+			Short: "List the trusted publisher keys",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				trustStore, err := trustStoreFromContext(cmd)
+				if err != nil {
+					return err
+				}
+
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "PUBLISHER\tALGORITHM\tKEY SHA256")
+				for _, publisher := range trustStore.Publishers() {
+					for _, key := range trustStore.Keys(publisher) {
+						fmt.Fprintf(w, "%s\t%s\t%X\n", publisher, key.Algorithm, sha256.Sum256(key.PublicKey))
+					}
+				}
+
+				return w.Flush()
+			},
+		},
+	)
+
+	return cmd
+}
+
+func trustStoreFromContext(cmd *cobra.Command) (*features.TrustStore, error) {
+	fm, ok := cmd.Context().Value("featureManager").(*features.Manager)
+	if !ok {
+		return nil, fmt.Errorf("failed to get feature manager from context")
+	}
+	trustStore := fm.TrustStore()
+	if trustStore == nil {
+		return nil, fmt.Errorf("no trust store configured")
+	}
+	return trustStore, nil
+}
+
 // RegisterFeatureCommands registers the feature commands with the root command
 func RegisterFeatureCommands(rootCmd *cobra.Command) {
 	// Add the feature command
@@ -282,7 +369,8 @@ func RegisterFeatureCommands(rootCmd *cobra.Command) {
 		fmt.Println("  # List all installed features")
 		fmt.Println("  fluentumd feature list")
 		fmt.Println()
-		fmt.Println("  # Install a feature")
+		fmt.Println("  # Trust a publisher key, then install a feature signed with it")
+		fmt.Println("  fluentumd feature trust add fluentum ed25519 <base64-public-key>")
 		fmt.Println("  fluentumd feature install qmoe_validator")
 		fmt.Println()
 		fmt.Println("  # Enable a feature")
@@ -4,18 +4,28 @@ import (
 	"context"
 	"os"
 
-	"github.com/fluentum-chain/fluentum/features"
 	"github.com/fluentum-chain/fluentum/cmd/fluentumd/commands"
+	"github.com/fluentum-chain/fluentum/config"
+	"github.com/fluentum-chain/fluentum/features"
 	"github.com/fluentum-chain/fluentum/libs/log"
+	"github.com/fluentum-chain/fluentum/version"
 	"github.com/spf13/cobra"
 )
 
+// featuresConfig is the configuration of the feature manager.
+type featuresConfig struct {
+	registry string
+}
+
+func (c *featuresConfig) GetRegistry() string { return c.registry }
+func (c *featuresConfig) IsEnabled() bool     { return true }
+func (c *featuresConfig) GetAutoUpdate() bool { return false }
+
 func main() {
 	// Initialize logger
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
 
-	// Initialize feature manager
-	featureManager := features.NewFeatureManager(logger)
+	registryConfig := config.DefaultFeaturesConfig().Registry
 
 	// Create root command
 	rootCmd := &cobra.Command{
@@ -24,6 +34,21 @@ func main() {
 		Long: `Fluentum is a high-performance blockchain with AI-powered validation
 and quantum-resistant security features.`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize feature manager
+			featureManager := features.NewManager(&featuresConfig{
+				registry: os.ExpandEnv(registryConfig.LocalPath),
+			}, nil)
+			featureManager.SetNodeVersion(version.Version)
+
+			trustStore, err := features.LoadTrustStore(os.ExpandEnv(registryConfig.TrustStore))
+			if err != nil {
+				return err
+			}
+			featureManager.SetRegistry(
+				features.NewHTTPRegistry(registryConfig.RemoteRegistry, registryConfig.InsecureSkipVerify),
+				trustStore,
+			)
+
 			// Add feature manager to context
 			ctx := context.WithValue(cmd.Context(), "featureManager", featureManager)
 			cmd.SetContext(ctx)
@@ -31,11 +56,21 @@ and quantum-resistant security features.`,
 		},
 	}
 
+	flags := rootCmd.PersistentFlags()
+	flags.StringVar(&registryConfig.LocalPath, "features-dir", registryConfig.LocalPath,
+		"Directory features are installed in")
+	flags.StringVar(&registryConfig.RemoteRegistry, "registry", registryConfig.RemoteRegistry,
+		"URL of the feature registry")
+	flags.StringVar(&registryConfig.TrustStore, "trust-store", registryConfig.TrustStore,
+		"File holding the publisher keys trusted to sign features")
+	flags.BoolVar(&registryConfig.InsecureSkipVerify, "insecure-skip-verify", registryConfig.InsecureSkipVerify,
+		"Skip TLS verification of the feature registry")
+
 	// Register subcommands
 	commands.RegisterFeatureCommands(rootCmd)
 
 	// Execute the root command
-	if err := rootCmd.Execute(); err != nil {
+	if err := rootCmd.ExecuteContext(context.Background()); err != nil {
 		logger.Error("Command execution failed", "error", err)
 		os.Exit(1)
 	}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/fluentum-chain/fluentum/privval"
 )
 
+var validatorKeyType string
+
 // GenValidatorCmd allows the generation of a keypair for a
 // validator.
 var GenValidatorCmd = &cobra.Command{
@@ -16,15 +19,24 @@ var GenValidatorCmd = &cobra.Command{
 	Aliases: []string{"gen_validator"},
 	Short:   "Generate new validator keypair",
 	PreRun:  deprecateSnakeCase,
-	Run:     genValidator,
+	RunE:    genValidator,
+}
+
+func init() {
+	GenValidatorCmd.Flags().StringVar(&validatorKeyType, "key-type", privval.KeyTypeEd25519,
+		fmt.Sprintf("type of the generated key, one of %s", strings.Join(privval.KeyTypes, "|")))
 }
 
-func genValidator(cmd *cobra.Command, args []string) {
-	pv := privval.GenFilePV("", "")
+func genValidator(cmd *cobra.Command, args []string) error {
+	pv, err := privval.GenFilePVWithKeyType("", "", validatorKeyType)
+	if err != nil {
+		return err
+	}
 	jsbz, err := tmjson.Marshal(pv)
 	if err != nil {
 		panic(err)
 	}
 	fmt.Printf(`%v
 `, string(jsbz))
+	return nil
 }
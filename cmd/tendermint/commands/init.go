@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	cfg "github.com/fluentum-chain/fluentum/config"
+	"github.com/fluentum-chain/fluentum/crypto/kms"
 	tmos "github.com/fluentum-chain/fluentum/libs/os"
 	tmrand "github.com/fluentum-chain/fluentum/libs/rand"
 	"github.com/fluentum-chain/fluentum/p2p"
@@ -56,6 +57,19 @@ func initFilesWithConfig(config *cfg.Config) error {
 		logger.Info("Generated node key", "path", nodeKeyFile)
 	}
 
+	// key encryption key of the quantum key pairs
+	if config.Quantum.Enabled && config.Quantum.KeyBackend == cfg.QuantumKeyBackendLocal {
+		kekFile := config.Quantum.LocalKeyFilePath()
+		if tmos.FileExists(kekFile) {
+			logger.Info("Found key encryption key", "path", kekFile)
+		} else {
+			if err := kms.InitLocalKeyFile(kekFile); err != nil {
+				return err
+			}
+			logger.Info("Generated key encryption key", "path", kekFile)
+		}
+	}
+
 	// genesis file
 	genFile := config.GenesisFile()
 	if tmos.FileExists(genFile) {
@@ -12,7 +12,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/fluentum-chain/fluentum/abci/types"
 	tmcfg "github.com/fluentum-chain/fluentum/config"
-	prototmstate "github.com/fluentum-chain/fluentum/proto/tendermint/state"
+	sm "github.com/fluentum-chain/fluentum/state"
 	blockmocks "github.com/fluentum-chain/fluentum/state/indexer/mocks"
 	"github.com/fluentum-chain/fluentum/state/mocks"
 	txmocks "github.com/fluentum-chain/fluentum/state/txindex/mocks"
@@ -140,10 +140,10 @@ func TestReIndexEvent(t *testing.T) {
 		On("LoadBlock", height).Return(&types.Block{Data: types.Data{Txs: types.Txs{make(types.Tx, 1)}}})
 
 	dtx := abci.ExecTxResult{}
-	abciResp := &prototmstate.ABCIResponses{
-		DeliverTxs: []*abci.ExecTxResult{&dtx},
-		EndBlock:   &abci.EndBlockResponse{},
-		BeginBlock: &abci.BeginBlockResponse{},
+	abciResp := &sm.ABCIResponses{
+		FinalizeBlock: &abci.FinalizeBlockResponse{
+			TxResults: []*abci.ExecTxResult{&dtx},
+		},
 	}
 
 	mockBlockIndexer.
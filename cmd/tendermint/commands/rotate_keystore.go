@@ -0,0 +1,246 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/kms"
+)
+
+var (
+	rotateNewKey       bool
+	rotateKeyAlgorithm string
+)
+
+// RotateKeystoreCmd re-encrypts a quantum key pair file with a new passphrase,
+// migrating legacy files to the current keystore version, or with the current
+// key of its key encryption backend, and optionally replaces the key pair with
+// a newly generated one.
+var RotateKeystoreCmd = &cobra.Command{
+	Use:     "rotate-keystore [file]",
+	Aliases: []string{"rotate_keystore"},
+	Short:   "Change the passphrase of a quantum key pair file, or rotate its key pair",
+	Long: `Change the passphrase of a quantum key pair file, and save it in the
+current keystore format, with the passphrase derived into the encryption key
+by Argon2id. Legacy files, whose passphrase is the raw AES key, are migrated.
+
+With --new-key, the key pair is replaced by a newly generated one of the same
+algorithm, or of --algorithm for legacy files whose algorithm is unknown. The
+previous file is kept as [file].bak, which must not exist yet.
+
+The new file is written next to [file], checked to decrypt with the new
+passphrase or key, and only then renamed over [file].
+
+The current and new passphrases are read from the terminal, or one per line
+from the standard input if it is not a terminal.
+
+Files encrypted by a key encryption backend are instead decrypted and
+re-encrypted by the key_backend of the [quantum] config section, e.g. with the
+latest version of the KMS key. No passphrase is read for them.`,
+	Args:   cobra.ExactArgs(1),
+	PreRun: deprecateSnakeCase,
+	RunE:   rotateKeystore,
+}
+
+func init() {
+	RotateKeystoreCmd.Flags().BoolVar(&rotateNewKey, "new-key", false,
+		"replace the key pair with a newly generated one")
+	RotateKeystoreCmd.Flags().StringVar(&rotateKeyAlgorithm, "algorithm", "",
+		fmt.Sprintf("algorithm of the new key pair, one of %s (default: the algorithm of the file)",
+			strings.Join(crypto.KeyAlgorithms, "|")))
+}
+
+func rotateKeystore(cmd *cobra.Command, args []string) error {
+	filename := args[0]
+	if rotateKeyAlgorithm != "" && !rotateNewKey {
+		return errors.New("--algorithm can only be set with --new-key")
+	}
+
+	ks, err := openKeystore(cmd.Context(), filename)
+	if err != nil {
+		return err
+	}
+	keypair, err := ks.load(filename)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", filename, err)
+	}
+	if err := ks.rotate(); err != nil {
+		return err
+	}
+
+	if rotateNewKey {
+		algorithm := rotateKeyAlgorithm
+		if algorithm == "" {
+			algorithm = keypair.Algorithm
+		}
+		if algorithm == "" {
+			return errors.New("the algorithm of the key pair is unknown, set it with --algorithm")
+		}
+		keypair, err = crypto.NewKeyPair(algorithm)
+		if err != nil {
+			return err
+		}
+	}
+
+	if rotateNewKey {
+		backup := filename + ".bak"
+		if err := backupKeystore(filename, backup); err != nil {
+			return err
+		}
+		logger.Info("Saved previous key pair", "file", backup)
+	}
+	if err := replaceKeystore(ks, filename, keypair); err != nil {
+		return err
+	}
+	logger.Info("Rotated keystore", "file", filename, "algorithm", keypair.Algorithm, "new_key", rotateNewKey)
+	return nil
+}
+
+// keystore loads and saves key pair files with an encryption key.
+type keystore interface {
+	load(filename string) (crypto.KeyPair, error)
+	save(filename string, keypair crypto.KeyPair) error
+	// rotate replaces the encryption key used by load and save.
+	rotate() error
+}
+
+// openKeystore returns the keystore of filename: the key encryption backend
+// configured in the [quantum] section if the file was encrypted by a backend,
+// or the passphrase keystore otherwise, whose current passphrase is read.
+func openKeystore(ctx context.Context, filename string) (keystore, error) {
+	name, err := crypto.KeyFileBackend(filename)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		reader := bufio.NewReader(os.Stdin)
+		password, err := readPassphrase(reader, "Current passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		return &passphraseKeystore{reader: reader, password: password}, nil
+	}
+	backend, err := kms.NewBackend(config.Quantum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the %s key backend: %w", name, err)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &backendKeystore{ctx: ctx, backend: backend}, nil
+}
+
+// passphraseKeystore encrypts the key pair files with a passphrase, the new
+// one being read from the standard input on rotation.
+type passphraseKeystore struct {
+	reader   *bufio.Reader
+	password []byte
+}
+
+func (ks *passphraseKeystore) load(filename string) (crypto.KeyPair, error) {
+	return crypto.LoadKeyPairFromFile(filename, ks.password)
+}
+
+func (ks *passphraseKeystore) rotate() error {
+	newPassword, err := readPassphrase(ks.reader, "New passphrase: ")
+	if err != nil {
+		return err
+	}
+	if len(newPassword) == 0 {
+		return errors.New("the new passphrase can't be empty")
+	}
+	confirmation, err := readPassphrase(ks.reader, "Repeat new passphrase: ")
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(newPassword, confirmation) {
+		return errors.New("the new passphrases don't match")
+	}
+	ks.password = newPassword
+	return nil
+}
+
+func (ks *passphraseKeystore) save(filename string, keypair crypto.KeyPair) error {
+	return crypto.SaveKeyPairToFile(filename, keypair, ks.password)
+}
+
+// backendKeystore encrypts the key pair files with a key encryption backend.
+// Saving a file encrypts it with the current key of the backend, e.g. the
+// latest version of a KMS key.
+type backendKeystore struct {
+	ctx     context.Context
+	backend crypto.KeyEncryptionBackend
+}
+
+func (ks *backendKeystore) load(filename string) (crypto.KeyPair, error) {
+	return crypto.LoadKeyPairWithBackend(ks.ctx, filename, ks.backend)
+}
+
+func (ks *backendKeystore) rotate() error { return nil }
+
+func (ks *backendKeystore) save(filename string, keypair crypto.KeyPair) error {
+	return crypto.SaveKeyPairWithBackend(ks.ctx, filename, keypair, ks.backend)
+}
+
+// backupKeystore copies the keystore file filename to backup, which must not
+// exist.
+func backupKeystore(filename, backup string) error {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(backup, os.O_WRONLY|os.O_CREATE|os.O_EXCL|os.O_SYNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to back up %s: %w", filename, err)
+	}
+	if _, err := f.Write(bz); err != nil {
+		f.Close()
+		os.Remove(backup)
+		return fmt.Errorf("failed to back up %s: %w", filename, err)
+	}
+	return f.Close()
+}
+
+// replaceKeystore saves keypair with ks to a temporary file next to
+// filename, checks that it loads back, and renames it over filename.
+func replaceKeystore(ks keystore, filename string, keypair crypto.KeyPair) error {
+	tmp := filename + ".tmp"
+	if err := ks.save(tmp, keypair); err != nil {
+		return fmt.Errorf("failed to save %s: %w", tmp, err)
+	}
+	if _, err := ks.load(tmp); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to read back %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, filename); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to replace %s: %w", filename, err)
+	}
+	return nil
+}
+
+// readPassphrase reads a passphrase from the terminal without echoing it, or
+// a line from reader if the standard input is not a terminal.
+func readPassphrase(reader *bufio.Reader, prompt string) ([]byte, error) {
+	fd := int(os.Stdin.Fd())
+	if term.IsTerminal(fd) {
+		fmt.Fprint(os.Stderr, prompt)
+		passphrase, err := term.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		return passphrase, err
+	}
+	line, err := reader.ReadString('\n')
+	if err != nil && line == "" {
+		return nil, fmt.Errorf("failed to read passphrase: %w", err)
+	}
+	return []byte(strings.TrimRight(line, "\r\n")), nil
+}
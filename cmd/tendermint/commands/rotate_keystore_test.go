@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/fluentum-chain/fluentum/config"
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/kms"
+)
+
+// runRotateKeystore runs rotate-keystore on filename with the flags set as
+// given, reading the passphrases from a file standing in for the terminal.
+func runRotateKeystore(t *testing.T, filename string, newKey bool, algorithm string, passphrases ...string) error {
+	t.Helper()
+	stdin := filepath.Join(t.TempDir(), "stdin")
+	require.NoError(t, os.WriteFile(stdin, []byte(strings.Join(passphrases, "\n")+"\n"), 0o600))
+	f, err := os.Open(stdin)
+	require.NoError(t, err)
+	defer f.Close()
+
+	oldStdin := os.Stdin
+	os.Stdin = f
+	rotateNewKey, rotateKeyAlgorithm = newKey, algorithm
+	defer func() {
+		os.Stdin = oldStdin
+		rotateNewKey, rotateKeyAlgorithm = false, ""
+	}()
+	return rotateKeystore(RotateKeystoreCmd, []string{filename})
+}
+
+func TestRotateKeystore(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "quantum_key.json")
+	keypair, err := crypto.NewKeyPair(crypto.KeyAlgorithmDilithium3)
+	require.NoError(t, err)
+	require.NoError(t, crypto.SaveKeyPairToFile(filename, keypair, []byte("old")))
+	backup := filename + ".bak"
+
+	// a passphrase change keeps the key pair
+	require.NoError(t, runRotateKeystore(t, filename, false, "", "old", "new", "new"))
+	loaded, err := crypto.LoadKeyPairFromFile(filename, []byte("new"))
+	require.NoError(t, err)
+	assert.Equal(t, keypair, loaded)
+	_, err = crypto.LoadKeyPairFromFile(filename, []byte("old"))
+	assert.Error(t, err)
+	assert.NoFileExists(t, backup)
+
+	// the file is left unchanged by invalid invocations
+	before, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	err = runRotateKeystore(t, filename, false, crypto.KeyAlgorithmMLKEM768, "new", "newer", "newer")
+	assert.ErrorContains(t, err, "--algorithm")
+	err = runRotateKeystore(t, filename, false, "", "new", "newer", "other")
+	assert.ErrorContains(t, err, "don't match")
+	err = runRotateKeystore(t, filename, false, "", "wrong", "newer", "newer")
+	assert.Error(t, err)
+	after, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+
+	// --new-key generates a key pair of the same algorithm, and backs up the
+	// previous file
+	require.NoError(t, runRotateKeystore(t, filename, true, "", "new", "newer", "newer"))
+	rotated, err := crypto.LoadKeyPairFromFile(filename, []byte("newer"))
+	require.NoError(t, err)
+	assert.Equal(t, crypto.KeyAlgorithmDilithium3, rotated.Algorithm)
+	assert.NotEqual(t, keypair.PublicKey, rotated.PublicKey)
+	previous, err := crypto.LoadKeyPairFromFile(backup, []byte("new"))
+	require.NoError(t, err)
+	assert.Equal(t, keypair, previous)
+
+	// an existing backup is never overwritten
+	err = runRotateKeystore(t, filename, true, crypto.KeyAlgorithmMLKEM768, "newer", "newest", "newest")
+	assert.Error(t, err)
+	unchanged, err := crypto.LoadKeyPairFromFile(filename, []byte("newer"))
+	require.NoError(t, err)
+	assert.Equal(t, rotated, unchanged)
+	previous, err = crypto.LoadKeyPairFromFile(backup, []byte("new"))
+	require.NoError(t, err)
+	assert.Equal(t, keypair, previous)
+}
+
+func TestRotateKeystoreWithBackend(t *testing.T) {
+	oldConfig := config
+	config = cfg.TestConfig()
+	config.SetRoot(t.TempDir())
+	defer func() { config = oldConfig }()
+	require.NoError(t, kms.InitLocalKeyFile(config.Quantum.LocalKeyFilePath()))
+	backend, err := kms.NewBackend(config.Quantum)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	filename := filepath.Join(t.TempDir(), "quantum_key.json")
+	keypair, err := crypto.NewKeyPair(crypto.KeyAlgorithmDilithium3)
+	require.NoError(t, err)
+	require.NoError(t, crypto.SaveKeyPairWithBackend(ctx, filename, keypair, backend))
+	before, err := os.ReadFile(filename)
+	require.NoError(t, err)
+
+	// no passphrase is read, and the key pair is re-encrypted by the backend
+	require.NoError(t, runRotateKeystore(t, filename, false, ""))
+	after, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	assert.NotEqual(t, before, after)
+	loaded, err := crypto.LoadKeyPairWithBackend(ctx, filename, backend)
+	require.NoError(t, err)
+	assert.Equal(t, keypair, loaded)
+
+	require.NoError(t, runRotateKeystore(t, filename, true, ""))
+	rotated, err := crypto.LoadKeyPairWithBackend(ctx, filename, backend)
+	require.NoError(t, err)
+	assert.NotEqual(t, keypair.PublicKey, rotated.PublicKey)
+	previous, err := crypto.LoadKeyPairWithBackend(ctx, filename+".bak", backend)
+	require.NoError(t, err)
+	assert.Equal(t, keypair, previous)
+
+	// a file encrypted with another key than the configured one is rejected
+	config.Quantum.LocalKeyFile = filepath.Join(t.TempDir(), "other_kek")
+	require.NoError(t, kms.InitLocalKeyFile(config.Quantum.LocalKeyFilePath()))
+	assert.Error(t, runRotateKeystore(t, filename, false, ""))
+}
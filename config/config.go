@@ -50,6 +50,8 @@ var (
 	defaultNodeKeyName  = "node_key.json"
 	defaultAddrBookName = "addrbook.json"
 
+	defaultQuantumKeyEncryptionKeyName = "quantum_key_encryption_key"
+
 	defaultConfigFilePath   = filepath.Join(defaultConfigDir, defaultConfigFileName)
 	defaultGenesisJSONPath  = filepath.Join(defaultConfigDir, defaultGenesisJSONName)
 	defaultPrivValKeyPath   = filepath.Join(defaultConfigDir, defaultPrivValKeyName)
@@ -58,6 +60,8 @@ var (
 	defaultNodeKeyPath  = filepath.Join(defaultConfigDir, defaultNodeKeyName)
 	defaultAddrBookPath = filepath.Join(defaultConfigDir, defaultAddrBookName)
 
+	defaultQuantumKeyEncryptionKeyPath = filepath.Join(defaultConfigDir, defaultQuantumKeyEncryptionKeyName)
+
 	minSubscriptionBufferSize     = 100
 	defaultSubscriptionBufferSize = 200
 )
@@ -121,6 +125,7 @@ func (cfg *Config) SetRoot(root string) *Config {
 	cfg.P2P.RootDir = root
 	cfg.Mempool.RootDir = root
 	cfg.Consensus.RootDir = root
+	cfg.Quantum.RootDir = root
 	return cfg
 }
 
@@ -590,6 +595,11 @@ type P2PConfig struct { //nolint: maligned
 	HandshakeTimeout time.Duration `mapstructure:"handshake_timeout"`
 	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
 
+	// Offer a hybrid X25519+ML-KEM key exchange to peers, so that recorded
+	// traffic stays secret if X25519 is broken. Peers which don't support it
+	// fall back to X25519.
+	HybridKeyExchange bool `mapstructure:"hybrid_key_exchange"`
+
 	// Testing params.
 	// Force dial to fail
 	TestDialFail bool `mapstructure:"test_dial_fail"`
@@ -618,6 +628,7 @@ func DefaultP2PConfig() *P2PConfig {
 		AllowDuplicateIP:             false,
 		HandshakeTimeout:             20 * time.Second,
 		DialTimeout:                  3 * time.Second,
+		HybridKeyExchange:            false,
 		TestDialFail:                 false,
 		TestFuzz:                     false,
 		TestFuzzConfig:               DefaultFuzzConnConfig(),
@@ -1204,8 +1215,21 @@ func (cfg *InstrumentationConfig) ValidateBasic() error {
 //-----------------------------------------------------------------------------
 // QuantumConfig
 
+const (
+	// QuantumKeyBackendLocal encrypts quantum key pairs with a key stored in a
+	// local file.
+	QuantumKeyBackendLocal = "local"
+	// QuantumKeyBackendVault encrypts quantum key pairs with the transit
+	// secrets engine of a HashiCorp Vault compatible server.
+	QuantumKeyBackendVault = "vault"
+	// QuantumKeyBackendGCPKMS encrypts quantum key pairs with Google Cloud KMS.
+	QuantumKeyBackendGCPKMS = "gcp-kms"
+)
+
 // QuantumConfig defines the configuration for quantum cryptography features.
 type QuantumConfig struct {
+	RootDir string `mapstructure:"home"`
+
 	// Whether quantum cryptography features are enabled
 	Enabled bool `mapstructure:"enabled"`
 
@@ -1215,11 +1239,37 @@ type QuantumConfig struct {
 	// Quantum signature mode (e.g., "dilithium3", "dilithium5", "falcon512", etc.)
 	Mode string `mapstructure:"mode"`
 
-	// Feature flag: enable Dilithium signatures after governance vote
+	// Feature flag: enable Dilithium signatures locally. It does not change
+	// the validator key types accepted by consensus, which are set by the
+	// validator consensus params, and switched by governance at the
+	// validator.switch_height.
 	EnableDilithium bool `mapstructure:"enable_dilithium"`
 
 	// Red team simulation mode: monitor|active
 	RedteamMode string `mapstructure:"redteam_mode"`
+
+	// Backend encrypting the quantum key pairs: local|vault|gcp-kms
+	KeyBackend string `mapstructure:"key_backend"`
+
+	// Path to the key encryption key of the local backend, relative to the
+	// home directory. It is generated by tendermint init.
+	LocalKeyFile string `mapstructure:"local_key_file"`
+
+	// Address, transit mount path and key name of the Vault backend
+	VaultAddress string `mapstructure:"vault_address"`
+	VaultMount   string `mapstructure:"vault_mount"`
+	VaultKeyName string `mapstructure:"vault_key_name"`
+
+	// Path to a file holding the Vault token. If empty, the VAULT_TOKEN
+	// environment variable is used.
+	VaultTokenFile string `mapstructure:"vault_token_file"`
+
+	// Resource name of the key of the gcp-kms backend, i.e.
+	// projects/*/locations/*/keyRings/*/cryptoKeys/*
+	GCPKMSKey string `mapstructure:"gcp_kms_key"`
+
+	// Path to the credentials file of the gcp-kms backend
+	GCPCredentialsFile string `mapstructure:"gcp_credentials_file"`
 }
 
 // DefaultQuantumConfig returns a default configuration for quantum cryptography.
@@ -1230,6 +1280,9 @@ func DefaultQuantumConfig() *QuantumConfig {
 		Mode:            "dilithium3",
 		EnableDilithium: false,
 		RedteamMode:     "monitor",
+		KeyBackend:      QuantumKeyBackendLocal,
+		LocalKeyFile:    defaultQuantumKeyEncryptionKeyPath,
+		VaultMount:      "transit",
 	}
 }
 
@@ -1241,21 +1294,60 @@ func TestQuantumConfig() *QuantumConfig {
 		Mode:            "dilithium3",
 		EnableDilithium: true,
 		RedteamMode:     "active",
+		KeyBackend:      QuantumKeyBackendLocal,
+		LocalKeyFile:    defaultQuantumKeyEncryptionKeyPath,
+		VaultMount:      "transit",
 	}
 }
 
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *QuantumConfig) ValidateBasic() error {
-	if cfg.Enabled && cfg.LibPath == "" {
+	// nothing is used while quantum signing is disabled
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.LibPath == "" {
 		return errors.New("quantum.lib_path must be set when quantum.enabled is true")
 	}
-	if cfg.Enabled && cfg.Mode == "" {
+	if cfg.Mode == "" {
 		return errors.New("quantum.mode must be set when quantum.enabled is true")
 	}
+	switch cfg.KeyBackend {
+	case QuantumKeyBackendLocal:
+		if cfg.LocalKeyFile == "" {
+			return errors.New("local_key_file must be set for the local key_backend")
+		}
+	case QuantumKeyBackendVault:
+		if cfg.VaultAddress == "" || cfg.VaultMount == "" || cfg.VaultKeyName == "" {
+			return errors.New("vault_address, vault_mount and vault_key_name must be set for the vault key_backend")
+		}
+	case QuantumKeyBackendGCPKMS:
+		if cfg.GCPKMSKey == "" {
+			return errors.New("gcp_kms_key must be set for the gcp-kms key_backend")
+		}
+	default:
+		return fmt.Errorf("unknown key_backend %q, expected one of %s|%s|%s", cfg.KeyBackend,
+			QuantumKeyBackendLocal, QuantumKeyBackendVault, QuantumKeyBackendGCPKMS)
+	}
 	return nil
 }
 
+// LocalKeyFilePath returns the full path to the key encryption key of the
+// local backend.
+func (cfg *QuantumConfig) LocalKeyFilePath() string {
+	return rootify(cfg.LocalKeyFile, cfg.RootDir)
+}
+
+// VaultTokenFilePath returns the full path to the Vault token file, or an
+// empty string if it is not set.
+func (cfg *QuantumConfig) VaultTokenFilePath() string {
+	if cfg.VaultTokenFile == "" {
+		return ""
+	}
+	return rootify(cfg.VaultTokenFile, cfg.RootDir)
+}
+
 //-----------------------------------------------------------------------------
 // Utils
 
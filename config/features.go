@@ -40,8 +40,13 @@ type RegistryConfig struct {
 	// RemoteRegistry is the URL of the remote feature registry
 	RemoteRegistry string `mapstructure:"remote_registry"`
 
-	// InsecureSkipVerify skips TLS verification for the remote registry
+	// InsecureSkipVerify skips TLS verification for the remote registry.
+	// Feature manifests are verified regardless.
 	InsecureSkipVerify bool `mapstructure:"insecure_skip_verify"`
+
+	// TrustStore is the path of the file holding the publisher keys trusted
+	// to sign feature manifests
+	TrustStore string `mapstructure:"trust_store"`
 }
 
 // LoaderConfig holds configuration for the feature loader
@@ -56,16 +61,11 @@ type LoaderConfig struct {
 	IsolationEnabled bool `mapstructure:"isolation_enabled"`
 }
 
-// MetricsConfig holds configuration for feature metrics
+// MetricsConfig holds configuration for feature metrics. They're served with
+// the node metrics by the Prometheus server, see InstrumentationConfig.
 type MetricsConfig struct {
 	// Enabled enables feature metrics collection
 	Enabled bool `mapstructure:"enabled"`
-
-	// Endpoint is the address to serve metrics on
-	Endpoint string `mapstructure:"endpoint"`
-
-	// CollectionInterval is how often to collect metrics
-	CollectionInterval time.Duration `mapstructure:"collection_interval"`
 }
 
 // QMoEConfig holds configuration for the QMoE validator
@@ -121,6 +121,7 @@ func DefaultFeaturesConfig() *FeaturesConfig {
 			LocalPath:          "$HOME/.fluentum/features",
 			RemoteRegistry:     "https://features.fluentum.xyz",
 			InsecureSkipVerify: false,
+			TrustStore:         "$HOME/.fluentum/config/trusted_publishers.json",
 		},
 		Loader: LoaderConfig{
 			MaxConcurrentLoads: 5,
@@ -128,9 +129,7 @@ func DefaultFeaturesConfig() *FeaturesConfig {
 			IsolationEnabled:   true,
 		},
 		Metrics: MetricsConfig{
-			Enabled:            true,
-			Endpoint:           ":9090",
-			CollectionInterval: time.Minute,
+			Enabled: true,
 		},
 		QMoEValidator: QMoEConfig{
 			Enabled:             false,
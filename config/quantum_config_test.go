@@ -83,3 +83,41 @@ func TestConfigWithQuantum(t *testing.T) {
 		t.Errorf("Expected no validation error for valid quantum config, got %v", err)
 	}
 }
+
+func TestQuantumConfigKeyBackend(t *testing.T) {
+	quantumConfig := DefaultQuantumConfig()
+	if quantumConfig.KeyBackend != QuantumKeyBackendLocal {
+		t.Errorf("Expected default key_backend to be local, got %s", quantumConfig.KeyBackend)
+	}
+
+	// The key backend isn't checked while quantum signing is disabled
+	quantumConfig.KeyBackend = "hsm"
+	if err := quantumConfig.ValidateBasic(); err != nil {
+		t.Errorf("Expected no validation error for disabled quantum, got %v", err)
+	}
+	quantumConfig.Enabled = true
+	quantumConfig.LibPath = "/usr/local/lib/fluentum/quantum.so"
+
+	// Test vault backend with missing address
+	quantumConfig.KeyBackend = QuantumKeyBackendVault
+	quantumConfig.VaultKeyName = "fluentum"
+	if err := quantumConfig.ValidateBasic(); err == nil {
+		t.Error("Expected validation error for vault key_backend with missing vault_address")
+	}
+	quantumConfig.VaultAddress = "http://127.0.0.1:8200"
+	if err := quantumConfig.ValidateBasic(); err != nil {
+		t.Errorf("Expected no validation error for valid vault config, got %v", err)
+	}
+
+	// Test gcp-kms backend with missing key
+	quantumConfig.KeyBackend = QuantumKeyBackendGCPKMS
+	if err := quantumConfig.ValidateBasic(); err == nil {
+		t.Error("Expected validation error for gcp-kms key_backend with missing gcp_kms_key")
+	}
+
+	// Test unknown backend
+	quantumConfig.KeyBackend = "hsm"
+	if err := quantumConfig.ValidateBasic(); err == nil {
+		t.Error("Expected validation error for unknown key_backend")
+	}
+}
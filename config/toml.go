@@ -185,7 +185,7 @@ grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
 # 1024 - 40 - 10 - 50 = 924 = ~900
 grpc_max_open_connections = {{ .RPC.GRPCMaxOpenConnections }}
 
-# Activate unsafe RPC commands like /dial_seeds and /unsafe_flush_mempool
+# Activate unsafe RPC commands like /dial_seeds, /unsafe_flush_mempool and /quantum_reload
 unsafe = {{ .RPC.Unsafe }}
 
 # Maximum number of simultaneous connections (including WebSocket).
@@ -338,6 +338,11 @@ allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 handshake_timeout = "{{ .P2P.HandshakeTimeout }}"
 dial_timeout = "{{ .P2P.DialTimeout }}"
 
+# Offer a hybrid X25519+ML-KEM key exchange to peers, so that recorded traffic
+# stays secret if X25519 is broken. Peers which don't support it fall back to
+# X25519.
+hybrid_key_exchange = {{ .P2P.HybridKeyExchange }}
+
 #######################################################
 ###          Mempool Configuration Option          ###
 #######################################################
@@ -552,6 +557,34 @@ lib_path = "{{ .Quantum.LibPath }}"
 
 # Quantum signature mode (e.g., "dilithium3", "dilithium5", "falcon512", etc.)
 mode = "{{ .Quantum.Mode }}"
+
+# Backend encrypting the quantum key pairs. Options:
+#   1) "local" - a key encryption key stored in local_key_file, generated by
+#   "tendermint init" if quantum signing is enabled
+#   2) "vault" - the transit secrets engine of a HashiCorp Vault compatible
+#   server
+#   3) "gcp-kms" - Google Cloud KMS
+key_backend = "{{ .Quantum.KeyBackend }}"
+
+# Path to the key encryption key of the local backend, relative to the home
+# directory
+local_key_file = "{{ js .Quantum.LocalKeyFile }}"
+
+# Address, transit mount path and key name of the vault backend
+vault_address = "{{ .Quantum.VaultAddress }}"
+vault_mount = "{{ .Quantum.VaultMount }}"
+vault_key_name = "{{ .Quantum.VaultKeyName }}"
+
+# Path to a file holding the Vault token. If empty, the VAULT_TOKEN
+# environment variable is used.
+vault_token_file = "{{ js .Quantum.VaultTokenFile }}"
+
+# Resource name of the key of the gcp-kms backend, i.e.
+# projects/*/locations/*/keyRings/*/cryptoKeys/*
+gcp_kms_key = "{{ .Quantum.GCPKMSKey }}"
+
+# Path to the credentials file of the gcp-kms backend
+gcp_credentials_file = "{{ js .Quantum.GCPCredentialsFile }}"
 `
 
 /****** these are for test settings ***********/
@@ -18,7 +18,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	abcicli "github.com/fluentum-chain/fluentum/abci/client"
-	"github.com/fluentum-chain/fluentum/abci/example/counter"
+	counter "github.com/fluentum-chain/fluentum/abci/example/counterlib"
 	"github.com/fluentum-chain/fluentum/abci/example/kvstore"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cfg "github.com/fluentum-chain/fluentum/config"
@@ -33,6 +33,7 @@ import (
 	mempoolv1 "github.com/fluentum-chain/fluentum/mempool/v1"
 	"github.com/fluentum-chain/fluentum/p2p"
 	"github.com/fluentum-chain/fluentum/privval"
+	"github.com/fluentum-chain/fluentum/proxy"
 	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
 	sm "github.com/fluentum-chain/fluentum/state"
 	"github.com/fluentum-chain/fluentum/store"
@@ -392,8 +393,8 @@ func newStateWithConfigAndBlockStore(
 	// one for mempool, one for consensus
 	mtx := new(tmsync.Mutex)
 
-	proxyAppConnCon := abcicli.NewLocalClient(mtx, app)
-	proxyAppConnConMem := abcicli.NewLocalClient(mtx, app)
+	proxyAppConnCon := proxy.NewAppConnConsensus(abcicli.NewLocalClient(mtx, app))
+	proxyAppConnConMem := proxy.NewAppConnMempool(abcicli.NewLocalClient(mtx, app))
 	// Make Mempool
 	memplMetrics := mempl.NopMetrics()
 
@@ -733,8 +734,10 @@ func randConsensusNet(nValidators int, testName string, tickerFunc func() Timeou
 		}
 		ensureDir(filepath.Dir(thisConfig.Consensus.WalFile()), 0o700) // dir for wal
 		app := appFunc()
-		vals := types.TM2PB.ValidatorUpdates(state.Validators)
-		app.InitChain(abci.RequestInitChain{Validators: vals})
+		vals := abciValidatorUpdates(state.Validators)
+		if _, err := app.InitChain(context.Background(), &abci.RequestInitChain{Validators: vals}); err != nil {
+			panic(err)
+		}
 
 		css[i] = newStateWithConfigAndBlockStore(thisConfig, state, privVals[i], app, stateDB)
 		css[i].SetTimeoutTicker(tickerFunc())
@@ -789,12 +792,14 @@ func randConsensusNetWithPeers(
 		}
 
 		app := appFunc(path.Join(config.DBDir(), fmt.Sprintf("%s_%d", testName, i)))
-		vals := types.TM2PB.ValidatorUpdates(state.Validators)
+		vals := abciValidatorUpdates(state.Validators)
 		if _, ok := app.(*kvstore.PersistentKVStoreApplication); ok {
 			// simulate handshake, receive app version. If don't do this, replay test will fail
 			state.Version.Consensus.App = kvstore.ProtocolVersion
 		}
-		app.InitChain(abci.RequestInitChain{Validators: vals})
+		if _, err := app.InitChain(context.Background(), &abci.RequestInitChain{Validators: vals}); err != nil {
+			panic(err)
+		}
 		// sm.SaveState(stateDB,state)	//height 1's validatorsInfo already saved in LoadStateFromDBOrGenesisDoc above
 
 		css[i] = newStateWithConfig(thisConfig, state, privVal, app)
@@ -897,6 +902,10 @@ func (*mockTicker) SetLogger(log.Logger) {}
 
 //------------------------------------
 
+func newCounter() abci.Application {
+	return counter.NewApplication(true)
+}
+
 func newPersistentKVStore() abci.Application {
 	dir, err := os.MkdirTemp("", "persistent-kvstore")
 	if err != nil {
@@ -909,6 +918,16 @@ func newPersistentKVStoreWithPath(dbDir string) abci.Application {
 	return kvstore.NewPersistentKVStoreApplication(dbDir)
 }
 
+// abciValidatorUpdates returns the validators of vals as ABCI validator
+// updates.
+func abciValidatorUpdates(vals *types.ValidatorSet) []abci.ValidatorUpdate {
+	updates := make([]abci.ValidatorUpdate, len(vals.Validators))
+	for i, val := range vals.Validators {
+		updates[i] = abci.UpdateValidator(val.PubKey.Bytes(), val.VotingPower, val.PubKey.Type())
+	}
+	return updates
+}
+
 func signDataIsEqual(v1 *types.Vote, v2 *tmproto.Vote) bool {
 	if v1 == nil || v2 == nil {
 		return false
@@ -1,30 +1,50 @@
 package consensus
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/fluentum-chain/fluentum/libs/log"
+	tmsync "github.com/fluentum-chain/fluentum/libs/sync"
 	"github.com/fluentum-chain/fluentum/types"
 
 	"github.com/fluentum-chain/fluentum/libs/service"
-	"github.com/fluentum-chain/fluentum/state"
+	sm "github.com/fluentum-chain/fluentum/state"
 	"github.com/fluentum-chain/fluentum/store"
+	"github.com/fluentum-chain/fluentum/zkprover"
 )
 
 // HybridConsensus combines Tendermint's DPoS with ZK-Rollups and quantum-resistant signatures
+//
+// HybridConsensus is opt-in: node.NewNode runs the Tendermint consensus
+// reactor and never constructs it. A chain that agrees on blocks by other
+// means constructs it with NewHybridConsensus, from the block executor, the
+// block and state stores and the private validator the node would use, and
+// calls FinalizeBlock with each committed block instead of running
+// consensus.State on the same stores.
 type HybridConsensus struct {
 	service.BaseService
 	logger log.Logger
 
 	// Core components
-	blockExec     *state.BlockExecutor
+	blockExec     *sm.BlockExecutor
 	privValidator types.PrivValidator
 	blockStore    *store.BlockStore
-	stateStore    state.Store
+	stateStore    sm.Store
+	eventBus      *types.EventBus
+
+	// ZK proving of committed transactions, set when ZKEnabled
+	zkProver  zkprover.Prover
+	zkBatcher *zkBatcher
 
 	// Configuration
 	blockTime time.Duration
 	config    *Config
+
+	// mtx guards state, the last committed state of the chain.
+	mtx   tmsync.Mutex
+	state sm.State
 }
 
 // Config holds the configuration for the hybrid consensus
@@ -38,14 +58,25 @@ type Config struct {
 	ZKProofTimeout time.Duration
 }
 
+// HybridOption sets an optional parameter on the HybridConsensus.
+type HybridOption func(*HybridConsensus)
+
+// WithZKProver sets the prover that committed transaction batches are
+// submitted to when ZKEnabled is set. By default, batches are sent to
+// ZKProverURL, or proven by an in-process zkprover.MockProver if it is empty.
+func WithZKProver(prover zkprover.Prover) HybridOption {
+	return func(hc *HybridConsensus) { hc.zkProver = prover }
+}
+
 // NewHybridConsensus creates a new hybrid consensus instance
 func NewHybridConsensus(
 	config *Config,
-	blockExec *state.BlockExecutor,
+	blockExec *sm.BlockExecutor,
 	blockStore *store.BlockStore,
-	stateStore state.Store,
+	stateStore sm.Store,
 	privValidator types.PrivValidator,
 	logger log.Logger,
+	options ...HybridOption,
 ) *HybridConsensus {
 	hc := &HybridConsensus{
 		logger:        logger,
@@ -59,24 +90,170 @@ func NewHybridConsensus(
 
 	hc.BaseService = *service.NewBaseService(logger, "HybridConsensus", hc)
 
+	for _, option := range options {
+		option(hc)
+	}
+
 	return hc
 }
 
-// FinalizeBlock processes a block through the hybrid consensus mechanism
-func (hc *HybridConsensus) FinalizeBlock(block *types.Block) error {
-	// TODO: Implement proper block finalization
-	// For now, just log that the block was processed
-	hc.logger.Info("Block finalized", "height", block.Height, "hash", block.Hash())
+// SetEventBus sets the event bus used to publish NewBlock, Tx and
+// ValidatorSetUpdates events for finalized blocks.
+func (hc *HybridConsensus) SetEventBus(b *types.EventBus) {
+	hc.eventBus = b
+	hc.blockExec.SetEventBus(b)
+}
+
+// State returns a copy of the last committed state.
+func (hc *HybridConsensus) State() sm.State {
+	hc.mtx.Lock()
+	defer hc.mtx.Unlock()
+	return hc.state.Copy()
+}
+
+// FinalizeBlock validates the block against the last committed state, checks
+// that seenCommit carries +2/3 of the voting power for it, saves it to the
+// block store and applies it through the BlockExecutor, which executes the
+// block against the app, saves the new state and fires the block events.
+//
+// The block is saved before it is applied, as in State.finalizeCommit: if the
+// node crashes in between, the ABCI handshake replays the stored block on
+// restart.
+func (hc *HybridConsensus) FinalizeBlock(block *types.Block, seenCommit *types.Commit) error {
+	if block == nil {
+		return errors.New("nil block")
+	}
+	if seenCommit == nil {
+		return errors.New("nil seen commit")
+	}
+
+	hc.mtx.Lock()
+	defer hc.mtx.Unlock()
+
+	if hc.state.IsEmpty() {
+		return errors.New("hybrid consensus has no state; was it started?")
+	}
+
+	if err := hc.blockExec.ValidateBlock(hc.state, block); err != nil {
+		return fmt.Errorf("invalid block at height %d: %w", block.Height, err)
+	}
+
+	blockParts := block.MakePartSet(types.BlockPartSizeBytes)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
+
+	if err := hc.state.Validators.VerifyCommitLight(
+		hc.state.ChainID, blockID, block.Height, seenCommit,
+	); err != nil {
+		return fmt.Errorf("invalid seen commit for block at height %d: %w", block.Height, err)
+	}
+
+	if hc.blockStore.Height() < block.Height {
+		hc.blockStore.SaveBlock(block, blockParts, seenCommit)
+	} else {
+		// Happens during replay if we already saved the block but didn't commit
+		hc.logger.Debug("finalizing already stored block", "height", block.Height)
+	}
+
+	newState, retainHeight, err := hc.blockExec.ApplyBlock(hc.state.Copy(), blockID, block)
+	if err != nil {
+		return fmt.Errorf("failed to apply block at height %d: %w", block.Height, err)
+	}
+
+	if retainHeight > 0 {
+		pruned, err := hc.pruneBlocks(retainHeight)
+		if err != nil {
+			hc.logger.Error("failed to prune blocks", "retain_height", retainHeight, "err", err)
+		} else {
+			hc.logger.Debug("pruned blocks", "pruned", pruned, "retain_height", retainHeight)
+		}
+	}
+
+	hc.state = newState
+
+	if hc.zkBatcher != nil {
+		hc.zkBatcher.Notify(block.Height)
+	}
+
+	hc.logger.Info(
+		"finalized block",
+		"height", block.Height,
+		"hash", log.NewLazyBlockHash(block),
+		"app_hash", newState.AppHash,
+		"num_txs", len(block.Txs),
+	)
 	return nil
 }
 
-// OnStart implements service.Service
+func (hc *HybridConsensus) pruneBlocks(retainHeight int64) (uint64, error) {
+	base := hc.blockStore.Base()
+	if retainHeight <= base {
+		return 0, nil
+	}
+	pruned, err := hc.blockStore.PruneBlocks(retainHeight)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune block store: %w", err)
+	}
+	if err := hc.stateStore.PruneStates(base, retainHeight); err != nil {
+		return 0, fmt.Errorf("failed to prune state database: %w", err)
+	}
+	return pruned, nil
+}
+
+// OnStart implements service.Service. It loads the last committed state from
+// the state store.
 func (hc *HybridConsensus) OnStart() error {
-	hc.logger.Info("Hybrid consensus started")
+	state, err := hc.stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	if state.IsEmpty() {
+		return errors.New("state store is empty; the node must be initialized from genesis first")
+	}
+
+	hc.mtx.Lock()
+	hc.state = state
+	hc.mtx.Unlock()
+
+	if hc.config.ZKEnabled {
+		if err := hc.startZKBatcher(); err != nil {
+			return err
+		}
+	}
+
+	hc.logger.Info("Hybrid consensus started", "height", state.LastBlockHeight)
+	return nil
+}
+
+func (hc *HybridConsensus) startZKBatcher() error {
+	prover := hc.zkProver
+	if prover == nil {
+		if hc.config.ZKProverURL != "" {
+			prover = zkprover.NewHTTPProver(hc.config.ZKProverURL, nil)
+		} else {
+			prover = zkprover.NewMockProver()
+		}
+	}
+
+	zb, err := newZKBatcher(
+		prover, hc.blockStore, hc.config.MaxZKBatchSize, hc.config.ZKProofTimeout,
+		hc.logger.With("module", "zk_batcher"),
+	)
+	if err != nil {
+		return err
+	}
+	if err := zb.Start(); err != nil {
+		return fmt.Errorf("failed to start zk batcher: %w", err)
+	}
+	hc.zkBatcher = zb
 	return nil
 }
 
 // OnStop implements service.Service
 func (hc *HybridConsensus) OnStop() {
+	if hc.zkBatcher != nil {
+		if err := hc.zkBatcher.Stop(); err != nil {
+			hc.logger.Error("failed to stop zk batcher", "err", err)
+		}
+	}
 	hc.logger.Info("Hybrid consensus stopped")
 }
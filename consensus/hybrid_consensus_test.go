@@ -0,0 +1,207 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/fluentum-chain/fluentum/abci/types"
+	"github.com/fluentum-chain/fluentum/libs/log"
+	tmrand "github.com/fluentum-chain/fluentum/libs/rand"
+	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
+	"github.com/fluentum-chain/fluentum/proxy"
+	sm "github.com/fluentum-chain/fluentum/state"
+	"github.com/fluentum-chain/fluentum/store"
+	"github.com/fluentum-chain/fluentum/types"
+	tmtime "github.com/fluentum-chain/fluentum/types/time"
+	"github.com/fluentum-chain/fluentum/zkprover"
+)
+
+// finalizeRecorder records the transactions it executes.
+type finalizeRecorder struct {
+	abci.BaseApplication
+	txs [][]byte
+}
+
+func (app *finalizeRecorder) FinalizeBlock(
+	ctx context.Context,
+	req *abci.FinalizeBlockRequest,
+) (*abci.FinalizeBlockResponse, error) {
+	app.txs = req.Txs
+	return app.BaseApplication.FinalizeBlock(ctx, req)
+}
+
+// hybridGenesisState returns the genesis state of a single validator, saved
+// to a new store.
+func hybridGenesisState(t *testing.T) (sm.State, sm.Store, []types.PrivValidator) {
+	state, privVals := randGenesisState(1, false, 10)
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	require.NoError(t, stateStore.Save(state))
+	return state, stateStore, privVals
+}
+
+// HybridConsensus.FinalizeBlock saves committed blocks, applies them to the
+// app and the state, and fires the block events.
+func TestHybridConsensusFinalizeBlock(t *testing.T) {
+	logger := log.TestingLogger()
+
+	app := &finalizeRecorder{}
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	var height int64 = 1
+	state, stateStore, privVals := hybridGenesisState(t)
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	blockExec := sm.NewBlockExecutor(stateStore, logger, proxyApp.Consensus(),
+		emptyMempool{}, sm.EmptyEvidencePool{})
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop() //nolint:errcheck // ignore for tests
+	blockSub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryNewBlock)
+	require.NoError(t, err)
+
+	hc := NewHybridConsensus(&Config{MaxZKBatchSize: 10}, blockExec, blockStore, stateStore,
+		privVals[0], logger)
+	hc.SetEventBus(eventBus)
+	require.NoError(t, hc.Start())
+	defer hc.Stop() //nolint:errcheck // ignore for tests
+
+	block, seenCommit := committedBlock(t, state, privVals, types.Txs{{1}, {2}}, new(types.Commit))
+	blockID := seenCommit.BlockID
+
+	// the commit must be for the block
+	otherID := types.BlockID{Hash: tmrand.Bytes(32), PartSetHeader: blockID.PartSetHeader}
+	assert.Error(t, hc.FinalizeBlock(block, signedCommit(t, state, privVals, otherID)))
+	assert.EqualValues(t, 0, blockStore.Height())
+
+	require.NoError(t, hc.FinalizeBlock(block, seenCommit))
+
+	assert.EqualValues(t, height, blockStore.Height())
+	assert.Equal(t, block.Hash(), blockStore.LoadBlock(height).Hash())
+	assert.Equal(t, seenCommit.BlockID, blockStore.LoadSeenCommit(height).BlockID)
+
+	assert.Equal(t, [][]byte{{1}, {2}}, app.txs)
+	assert.EqualValues(t, height, hc.State().LastBlockHeight)
+	assert.Equal(t, blockID, hc.State().LastBlockID)
+	savedState, err := stateStore.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, height, savedState.LastBlockHeight)
+
+	select {
+	case msg := <-blockSub.Out():
+		assert.Equal(t, block.Hash(), msg.Data().(types.EventDataNewBlock).Block.Hash())
+	case <-time.After(time.Second):
+		t.Fatal("expected a NewBlock event")
+	}
+
+	// a finalized block can't be finalized again
+	assert.Error(t, hc.FinalizeBlock(block, seenCommit))
+}
+
+// flakyProver fails the first failures batches it's asked to prove.
+type flakyProver struct {
+	zkprover.MockProver
+	failures int32
+	calls    int32
+}
+
+func (p *flakyProver) ProveBatch(ctx context.Context, req zkprover.BatchRequest) (*zkprover.ZKBatch, error) {
+	if atomic.AddInt32(&p.calls, 1) <= p.failures {
+		return nil, errors.New("prover unavailable")
+	}
+	return p.MockProver.ProveBatch(ctx, req)
+}
+
+// The ZK batcher of HybridConsensus retries the blocks that failed to prove,
+// and resumes after the last proved block when started again.
+func TestHybridConsensusProvesBlocks(t *testing.T) {
+	logger := log.TestingLogger()
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(&finalizeRecorder{}))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateStore, privVals := hybridGenesisState(t)
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	blockExec := sm.NewBlockExecutor(stateStore, logger, proxyApp.Consensus(),
+		emptyMempool{}, sm.EmptyEvidencePool{})
+	startHybrid := func(zkEnabled bool, prover zkprover.Prover) *HybridConsensus {
+		hc := NewHybridConsensus(&Config{ZKEnabled: zkEnabled, MaxZKBatchSize: 1}, blockExec,
+			blockStore, stateStore, privVals[0], logger, WithZKProver(prover))
+		require.NoError(t, hc.Start())
+		return hc
+	}
+	provedHeight := func(height int64) func() bool {
+		return func() bool { return blockStore.ZKProvedHeight() == height }
+	}
+
+	// the first proof fails, and is retried
+	prover := &flakyProver{failures: 1}
+	hc := startHybrid(true, prover)
+	block, seenCommit := committedBlock(t, state, privVals, types.Txs{{1}, {2}}, new(types.Commit))
+	require.NoError(t, hc.FinalizeBlock(block, seenCommit))
+	require.Eventually(t, provedHeight(1), 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, blockStore.LoadZKBatches(1), 2)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&prover.calls))
+	require.NoError(t, hc.Stop())
+
+	// blocks committed without proving, such as while the batcher is stopped,
+	// stay scheduled
+	hc = startHybrid(false, nil)
+	block, seenCommit = committedBlock(t, hc.State(), privVals, types.Txs{{3}}, seenCommit)
+	require.NoError(t, hc.FinalizeBlock(block, seenCommit))
+	require.NoError(t, hc.Stop())
+	assert.EqualValues(t, 1, blockStore.ZKProvedHeight())
+
+	hc = startHybrid(true, &flakyProver{})
+	defer hc.Stop() //nolint:errcheck // ignore for tests
+	require.Eventually(t, provedHeight(2), 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, blockStore.LoadZKBatches(2), 1)
+
+	// blocks without transactions are proved without batches
+	block, seenCommit = committedBlock(t, hc.State(), privVals, nil, seenCommit)
+	require.NoError(t, hc.FinalizeBlock(block, seenCommit))
+	require.Eventually(t, provedHeight(3), 5*time.Second, 10*time.Millisecond)
+	assert.Nil(t, blockStore.LoadZKBatches(3))
+}
+
+// committedBlock makes the next block of state, with a commit signed by
+// privVals.
+func committedBlock(
+	t *testing.T,
+	state sm.State,
+	privVals []types.PrivValidator,
+	txs types.Txs,
+	lastCommit *types.Commit,
+) (*types.Block, *types.Commit) {
+	height := state.LastBlockHeight + 1
+	proposerAddr, _ := state.Validators.GetByIndex(0)
+	block, partSet := state.MakeBlock(height, txs, lastCommit, nil, proposerAddr)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+	return block, signedCommit(t, state, privVals, blockID)
+}
+
+// signedCommit returns a commit for blockID at the next height of state,
+// signed by privVals.
+func signedCommit(
+	t *testing.T,
+	state sm.State,
+	privVals []types.PrivValidator,
+	blockID types.BlockID,
+) *types.Commit {
+	height := state.LastBlockHeight + 1
+	voteSet := types.NewVoteSet(state.ChainID, height, 0, tmproto.PrecommitType, state.Validators)
+	commit, err := types.MakeCommit(blockID, height, 0, voteSet, privVals, tmtime.Now())
+	require.NoError(t, err)
+	return commit
+}
@@ -0,0 +1,214 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/fluentum-chain/fluentum/libs/log"
+	"github.com/fluentum-chain/fluentum/libs/service"
+	"github.com/fluentum-chain/fluentum/store"
+	"github.com/fluentum-chain/fluentum/zkprover"
+)
+
+const (
+	// defaultZKProofTimeout is used when Config.ZKProofTimeout is not set.
+	defaultZKProofTimeout = 30 * time.Second
+
+	// zkRetryMinBackoff and zkRetryMaxBackoff bound the delay before a block
+	// that failed to prove is retried. The delay doubles after each failure.
+	zkRetryMinBackoff = 500 * time.Millisecond
+	zkRetryMaxBackoff = time.Minute
+)
+
+// zkBatcher groups the transactions of committed blocks into batches of at
+// most maxBatchSize, submits each batch to a prover and records the resulting
+// proofs next to the block in the block store. Blocks are proven one at a time
+// in commit order, a failed block being retried with backoff until it is
+// proven.
+//
+// The block store is the queue: the batcher proves the blocks above the last
+// proved height it records there, up to the last committed height. Blocks
+// committed while the batcher is stopped, or waiting to be proven when it
+// stops, are proven once it is started again.
+type zkBatcher struct {
+	service.BaseService
+
+	prover       zkprover.Prover
+	blockStore   *store.BlockStore
+	maxBatchSize int
+	proofTimeout time.Duration
+
+	// committedHeight is the last committed height the batcher was notified of
+	committedHeight int64
+	notify          chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newZKBatcher(
+	prover zkprover.Prover,
+	blockStore *store.BlockStore,
+	maxBatchSize int,
+	proofTimeout time.Duration,
+	logger log.Logger,
+) (*zkBatcher, error) {
+	if maxBatchSize <= 0 {
+		return nil, fmt.Errorf("max ZK batch size must be positive, got %d", maxBatchSize)
+	}
+	if proofTimeout <= 0 {
+		proofTimeout = defaultZKProofTimeout
+	}
+	zb := &zkBatcher{
+		prover:       prover,
+		blockStore:   blockStore,
+		maxBatchSize: maxBatchSize,
+		proofTimeout: proofTimeout,
+		notify:       make(chan struct{}, 1),
+	}
+	zb.BaseService = *service.NewBaseService(logger, "ZKBatcher", zb)
+	return zb, nil
+}
+
+// OnStart implements service.Service. It resumes proving after the last
+// proved height recorded in the block store.
+func (zb *zkBatcher) OnStart() error {
+	zb.ctx, zb.cancel = context.WithCancel(context.Background())
+	zb.done = make(chan struct{})
+	zb.Notify(zb.blockStore.Height())
+	go zb.proveRoutine()
+	return nil
+}
+
+// OnStop implements service.Service. It aborts the proof in flight and waits
+// for the proving routine to exit. The blocks left to prove stay in the block
+// store, and are proven after the next start.
+func (zb *zkBatcher) OnStop() {
+	zb.cancel()
+	<-zb.done
+}
+
+// Notify schedules the committed blocks up to height for proving. It never
+// blocks.
+func (zb *zkBatcher) Notify(height int64) {
+	for {
+		committed := atomic.LoadInt64(&zb.committedHeight)
+		if height <= committed ||
+			atomic.CompareAndSwapInt64(&zb.committedHeight, committed, height) {
+			break
+		}
+	}
+	select {
+	case zb.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (zb *zkBatcher) proveRoutine() {
+	defer close(zb.done)
+
+	backoff := zkRetryMinBackoff
+	for {
+		height, ok := zb.nextHeight()
+		if !ok {
+			select {
+			case <-zb.notify:
+				continue
+			case <-zb.ctx.Done():
+				return
+			}
+		}
+
+		if err := zb.proveHeight(height); err != nil {
+			if zb.ctx.Err() != nil {
+				return
+			}
+			zb.Logger.Error("failed to prove block, retrying", "height", height, "retry_in", backoff, "err", err)
+			select {
+			case <-time.After(backoff):
+			case <-zb.ctx.Done():
+				return
+			}
+			backoff *= 2
+			if backoff > zkRetryMaxBackoff {
+				backoff = zkRetryMaxBackoff
+			}
+			continue
+		}
+		backoff = zkRetryMinBackoff
+	}
+}
+
+// nextHeight returns the height of the next block to prove, if any.
+func (zb *zkBatcher) nextHeight() (int64, bool) {
+	height := zb.blockStore.ZKProvedHeight() + 1
+	if base := zb.blockStore.Base(); height < base {
+		zb.Logger.Error("blocks pruned before they were proven", "from", height, "to", base-1)
+		height = base
+	}
+	if height > atomic.LoadInt64(&zb.committedHeight) || height > zb.blockStore.Height() {
+		return 0, false
+	}
+	return height, true
+}
+
+// proveHeight proves the block at height and records its batches.
+func (zb *zkBatcher) proveHeight(height int64) error {
+	block := zb.blockStore.LoadBlock(height)
+	if block == nil {
+		return fmt.Errorf("no block at height %d", height)
+	}
+	batches, err := zb.proveBlock(height, block.Txs.ToSliceOfBytes())
+	if err != nil {
+		return err
+	}
+	if err := zb.blockStore.SaveZKBatches(height, batches); err != nil {
+		return fmt.Errorf("failed to save zk batches: %w", err)
+	}
+	if len(batches) > 0 {
+		zb.Logger.Info("proved block", "height", height, "batches", len(batches))
+	}
+	return nil
+}
+
+// proveBlock proves every batch of the block. The batches of a block are
+// recorded together, so a single failed or timed out batch fails the block.
+// Blocks without transactions have no batches.
+func (zb *zkBatcher) proveBlock(height int64, txs [][]byte) ([]zkprover.ZKBatch, error) {
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	split, err := zkprover.SplitBatches(txs, zb.maxBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	batches := make([]zkprover.ZKBatch, 0, len(split))
+	for i, batchTxs := range split {
+		req := zkprover.BatchRequest{Height: height, Index: i, Txs: batchTxs}
+		batch, err := zb.proveBatch(req)
+		if err != nil {
+			return nil, fmt.Errorf("batch %d: %w", i, err)
+		}
+		batches = append(batches, *batch)
+	}
+	return batches, nil
+}
+
+func (zb *zkBatcher) proveBatch(req zkprover.BatchRequest) (*zkprover.ZKBatch, error) {
+	ctx, cancel := context.WithTimeout(zb.ctx, zb.proofTimeout)
+	defer cancel()
+
+	batch, err := zb.prover.ProveBatch(ctx, req)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("proof not ready after %v: %w", zb.proofTimeout, err)
+		}
+		return nil, err
+	}
+	return batch, nil
+}
@@ -36,6 +36,7 @@ import (
 	p2pmock "github.com/fluentum-chain/fluentum/p2p/mock"
 	tmcons "github.com/fluentum-chain/fluentum/proto/tendermint/consensus"
 	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
+	"github.com/fluentum-chain/fluentum/proxy"
 	sm "github.com/fluentum-chain/fluentum/state"
 	statemocks "github.com/fluentum-chain/fluentum/state/mocks"
 	"github.com/fluentum-chain/fluentum/store"
@@ -148,8 +149,9 @@ func TestReactorWithEvidence(t *testing.T) {
 		defer os.RemoveAll(thisConfig.RootDir)
 		ensureDir(path.Dir(thisConfig.Consensus.WalFile()), 0700) // dir for wal
 		app := appFunc()
-		vals := types.TM2PB.ValidatorUpdates(state.Validators)
-		app.InitChain(abci.RequestInitChain{Validators: vals})
+		vals := abciValidatorUpdates(state.Validators)
+		_, err := app.InitChain(context.Background(), &abci.RequestInitChain{Validators: vals})
+		require.NoError(t, err)
 
 		pv := privVals[i]
 		// duplicate code from:
@@ -161,8 +163,8 @@ func TestReactorWithEvidence(t *testing.T) {
 		mtx := new(tmsync.Mutex)
 		memplMetrics := mempl.NopMetrics()
 		// one for mempool, one for consensus
-		proxyAppConnCon := abcicli.NewLocalClient(mtx, app)
-		proxyAppConnConMem := abcicli.NewLocalClient(mtx, app)
+		proxyAppConnCon := proxy.NewAppConnConsensus(abcicli.NewLocalClient(mtx, app))
+		proxyAppConnConMem := proxy.NewAppConnMempool(abcicli.NewLocalClient(mtx, app))
 
 		// Make Mempool
 		var mempool mempl.Mempool
@@ -209,7 +211,7 @@ func TestReactorWithEvidence(t *testing.T) {
 
 		eventBus := types.NewEventBus()
 		eventBus.SetLogger(log.TestingLogger().With("module", "events"))
-		err := eventBus.Start()
+		err = eventBus.Start()
 		require.NoError(t, err)
 		cs.SetEventBus(eventBus)
 
@@ -60,34 +60,34 @@ func (emptyMempool) CloseWAL()      {}
 // the real app.
 
 func newMockProxyApp(appHash []byte, abciResponses *tmstate.ABCIResponses) proxy.AppConnConsensus {
-	// TODO: Fix mockProxyApp interface compatibility issues
-	// clientCreator := proxy.NewLocalClientCreator(&mockProxyApp{
-	// 	appHash:       appHash,
-	// 	abciResponses: abciResponses,
-	// })
-	// cli, _ := clientCreator.NewABCIClient()
-	// err := cli.Start()
-	// if err != nil {
-	// 	panic(err)
-	// }
-	// return proxy.NewAppConnConsensus(cli)
-
-	// Return a simple mock for now
-	return &mockAppConnConsensus{}
+	return &mockAppConnConsensus{
+		appHash:       appHash,
+		abciResponses: abciResponses,
+	}
 }
 
-type mockAppConnConsensus struct{}
+type mockAppConnConsensus struct {
+	appHash       []byte
+	abciResponses *tmstate.ABCIResponses
+}
 
+// FinalizeBlock returns the saved response to the block, with the app hash
+// of the app.
 func (m *mockAppConnConsensus) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
-	return &abci.FinalizeBlockResponse{}, nil
+	res := new(abci.FinalizeBlockResponse)
+	if err := res.Unmarshal(m.abciResponses.FinalizeBlock); err != nil {
+		return nil, err
+	}
+	res.AppHash = m.appHash
+	return res, nil
 }
 
 func (m *mockAppConnConsensus) PrepareProposal(ctx context.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
-	return &abci.PrepareProposalResponse{}, nil
+	return &abci.PrepareProposalResponse{Txs: req.Txs}, nil
 }
 
 func (m *mockAppConnConsensus) ProcessProposal(ctx context.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
-	return &abci.ProcessProposalResponse{}, nil
+	return &abci.ProcessProposalResponse{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 }
 
 func (m *mockAppConnConsensus) ExtendVote(ctx context.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
@@ -1149,6 +1149,7 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	p := proposal.ToProto()
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err == nil {
 		proposal.Signature = p.Signature
+		proposal.QuantumSignature = p.QuantumSignature
 
 		// send proposal and block parts on internal msg queue
 		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, ""})
@@ -1275,6 +1276,20 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		return
 	}
 
+	// The application may reject the proposal, e.g. for breaking an ordering
+	// invariant.
+	accepted, err := cs.blockExec.ProcessProposal(cs.ProposalBlock, cs.state)
+	if err != nil {
+		logger.Error("prevote step: failed to process ProposalBlock", "err", err)
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+	if !accepted {
+		logger.Error("prevote step: ProposalBlock was rejected by the application")
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1825,8 +1840,10 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 
 	p := proposal.ToProto()
 	// Verify signature
-	if !cs.Validators.GetProposer().PubKey.VerifySignature(
-		types.ProposalSignBytes(cs.state.ChainID, p), proposal.Signature,
+	if !types.VerifySignatures(
+		cs.Validators.GetProposer().PubKey,
+		types.ProposalSignBytes(cs.state.ChainID, p),
+		proposal.Signature, proposal.QuantumSignature,
 	) {
 		return ErrInvalidProposalSignature
 	}
@@ -2198,6 +2215,7 @@ func (cs *State) signVote(
 	v := vote.ToProto()
 	err := cs.privValidator.SignVote(cs.state.ChainID, v)
 	vote.Signature = v.Signature
+	vote.QuantumSignature = v.QuantumSignature
 	vote.Timestamp = v.Timestamp
 
 	return vote, err
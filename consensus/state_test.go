@@ -10,7 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/fluentum-chain/fluentum/abci/example/counter"
+	counter "github.com/fluentum-chain/fluentum/abci/example/counterlib"
 	cstypes "github.com/fluentum-chain/fluentum/consensus/types"
 	"github.com/fluentum-chain/fluentum/crypto/tmhash"
 	"github.com/fluentum-chain/fluentum/libs/log"
@@ -0,0 +1,38 @@
+package batch
+
+import (
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
+)
+
+// CreateBatchVerifier checks if a key type implements the batch verifier interface.
+// Ed25519 keys are verified with a batched verification equation, while
+// Dilithium and hybrid keys, which have no such equation, are verified in
+// parallel by a pool of workers.
+func CreateBatchVerifier(pk crypto.PubKey) (crypto.BatchVerifier, bool) {
+	switch pk.Type() {
+	case ed25519.KeyType:
+		return ed25519.NewBatchVerifier(), true
+	case dilithium.KeyType, hybrid.KeyType:
+		return NewParallelVerifier(0), true
+	}
+
+	// case where the key does not support batch verification
+	return nil, false
+}
+
+// SupportsBatchVerifier checks if a key type implements the batch verifier
+// interface.
+func SupportsBatchVerifier(pk crypto.PubKey) bool {
+	if pk == nil {
+		return false
+	}
+	switch pk.Type() {
+	case ed25519.KeyType, dilithium.KeyType, hybrid.KeyType:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+package batch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/batch"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
+	"github.com/fluentum-chain/fluentum/crypto/secp256k1"
+)
+
+func TestCreateBatchVerifier(t *testing.T) {
+	for _, privKey := range []crypto.PrivKey{
+		ed25519.GenPrivKey(),
+		dilithium.GenPrivKeyWithMode(dilithium.Mode2),
+		hybrid.GenPrivKeyWithMode(dilithium.Mode2),
+	} {
+		pubKey := privKey.PubKey()
+		require.True(t, batch.SupportsBatchVerifier(pubKey), pubKey.Type())
+
+		bv, ok := batch.CreateBatchVerifier(pubKey)
+		require.True(t, ok, pubKey.Type())
+		for i := 0; i < 4; i++ {
+			msg := crypto.CRandBytes(32)
+			sig, err := privKey.Sign(msg)
+			require.NoError(t, err)
+			require.NoError(t, bv.Add(pubKey, msg, sig))
+		}
+		ok, valid := bv.Verify()
+		assert.True(t, ok, pubKey.Type())
+		assert.Equal(t, []bool{true, true, true, true}, valid)
+	}
+
+	assert.False(t, batch.SupportsBatchVerifier(secp256k1.GenPrivKey().PubKey()))
+	assert.False(t, batch.SupportsBatchVerifier(nil))
+}
+
+func TestParallelVerifier(t *testing.T) {
+	v := batch.NewParallelVerifier(3)
+	privKey := dilithium.GenPrivKeyWithMode(dilithium.Mode2)
+	pubKey := privKey.PubKey()
+
+	for i := 0; i < 10; i++ {
+		msg := crypto.CRandBytes(32)
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		if i == 7 {
+			sig[0] ^= 0x01
+		}
+		require.NoError(t, v.Add(pubKey, msg, sig))
+	}
+
+	ok, valid := v.Verify()
+	assert.False(t, ok)
+	for i, sigOK := range valid {
+		assert.Equal(t, i != 7, sigOK, i)
+	}
+}
@@ -0,0 +1,75 @@
+package batch
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+)
+
+var _ crypto.BatchVerifier = (*ParallelVerifier)(nil)
+
+type parallelEntry struct {
+	key       crypto.PubKey
+	message   []byte
+	signature []byte
+}
+
+// ParallelVerifier verifies the signatures added to it one by one, spread
+// over a pool of workers. It works with any key type, and is used for the
+// schemes whose single verification is too expensive to be done serially,
+// like Dilithium.
+type ParallelVerifier struct {
+	workers int
+	entries []parallelEntry
+}
+
+// NewParallelVerifier returns a verifier using the given number of workers,
+// or runtime.GOMAXPROCS(0) workers if workers is not positive.
+func NewParallelVerifier(workers int) *ParallelVerifier {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return &ParallelVerifier{workers: workers}
+}
+
+// Add implements crypto.BatchVerifier.
+func (v *ParallelVerifier) Add(key crypto.PubKey, message, signature []byte) error {
+	v.entries = append(v.entries, parallelEntry{key: key, message: message, signature: signature})
+	return nil
+}
+
+// Verify implements crypto.BatchVerifier.
+func (v *ParallelVerifier) Verify() (bool, []bool) {
+	valid := make([]bool, len(v.entries))
+
+	workers := v.workers
+	if workers > len(v.entries) {
+		workers = len(v.entries)
+	}
+	indexes := make(chan int, len(v.entries))
+	for i := range v.entries {
+		indexes <- i
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				e := v.entries[i]
+				valid[i] = e.key.VerifySignature(e.message, e.signature)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, ok := range valid {
+		if !ok {
+			return false, valid
+		}
+	}
+	return true, valid
+}
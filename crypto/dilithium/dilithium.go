@@ -0,0 +1,247 @@
+// Package dilithium implements crypto.PubKey and crypto.PrivKey for the
+// CRYSTALS-Dilithium post-quantum signature scheme, at security levels 2, 3
+// and 5. Keys are the packed keys of the scheme; their level is determined
+// by their size.
+package dilithium
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+
+	"github.com/cloudflare/circl/sign/dilithium"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/tmhash"
+	tmjson "github.com/fluentum-chain/fluentum/libs/json"
+)
+
+//-------------------------------------
+
+var (
+	_ crypto.PrivKey = PrivKey{}
+	_ crypto.PubKey  = PubKey{}
+)
+
+const (
+	PrivKeyName = "tendermint/PrivKeyDilithium"
+	PubKeyName  = "tendermint/PubKeyDilithium"
+
+	KeyType = "dilithium"
+)
+
+func init() {
+	tmjson.RegisterType(PubKey{}, PubKeyName)
+	tmjson.RegisterType(PrivKey{}, PrivKeyName)
+}
+
+// Mode is a security level of Dilithium.
+type Mode int
+
+const (
+	// Mode2 is Dilithium2, NIST security level 2.
+	Mode2 Mode = 2
+	// Mode3 is Dilithium3, NIST security level 3.
+	Mode3 Mode = 3
+	// Mode5 is Dilithium5, NIST security level 5.
+	Mode5 Mode = 5
+
+	// DefaultMode is the mode of keys generated by GenPrivKey.
+	DefaultMode = Mode3
+)
+
+// Modes are the supported modes, from the lowest security level.
+var Modes = []Mode{Mode2, Mode3, Mode5}
+
+func (m Mode) scheme() dilithium.Mode {
+	switch m {
+	case Mode2:
+		return dilithium.Mode2
+	case Mode3:
+		return dilithium.Mode3
+	case Mode5:
+		return dilithium.Mode5
+	default:
+		return nil
+	}
+}
+
+// IsValid reports whether m is a supported mode.
+func (m Mode) IsValid() bool {
+	return m.scheme() != nil
+}
+
+func (m Mode) String() string {
+	return fmt.Sprintf("Dilithium%d", int(m))
+}
+
+// PubKeySize returns the size, in bytes, of public keys of mode m.
+func (m Mode) PubKeySize() int {
+	return m.scheme().PublicKeySize()
+}
+
+// PrivKeySize returns the size, in bytes, of private keys of mode m.
+func (m Mode) PrivKeySize() int {
+	return m.scheme().PrivateKeySize()
+}
+
+// SignatureSize returns the size, in bytes, of signatures of mode m.
+func (m Mode) SignatureSize() int {
+	return m.scheme().SignatureSize()
+}
+
+// ModeFromPubKeySize returns the mode of public keys of the given size.
+func ModeFromPubKeySize(size int) (Mode, bool) {
+	for _, m := range Modes {
+		if m.PubKeySize() == size {
+			return m, true
+		}
+	}
+	return 0, false
+}
+
+func modeFromPrivKeySize(size int) (Mode, bool) {
+	for _, m := range Modes {
+		if m.PrivKeySize() == size {
+			return m, true
+		}
+	}
+	return 0, false
+}
+
+// MaxSignatureSize is the size of the largest signatures, those of Mode5.
+var MaxSignatureSize = Mode5.SignatureSize()
+
+//-------------------------------------
+
+// PrivKey implements crypto.PrivKey. It is a packed Dilithium private key.
+type PrivKey []byte
+
+// Bytes returns the privkey byte format.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// Mode returns the mode of the key. It panics if the key has an invalid
+// size.
+func (privKey PrivKey) Mode() Mode {
+	m, ok := modeFromPrivKeySize(len(privKey))
+	if !ok {
+		panic(fmt.Sprintf("invalid Dilithium private key size %d", len(privKey)))
+	}
+	return m
+}
+
+// Sign produces a deterministic signature on the provided message.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	m, ok := modeFromPrivKeySize(len(privKey))
+	if !ok {
+		return nil, fmt.Errorf("invalid Dilithium private key size %d", len(privKey))
+	}
+	scheme := m.scheme()
+	return scheme.Sign(scheme.PrivateKeyFromBytes(privKey), msg), nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+//
+// Panics if the private key has an invalid size.
+func (privKey PrivKey) PubKey() crypto.PubKey {
+	sk := privKey.Mode().scheme().PrivateKeyFromBytes(privKey)
+	return PubKey(sk.Public().(dilithium.PublicKey).Bytes())
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other crypto.PrivKey) bool {
+	if otherKey, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey, otherKey) == 1
+	}
+	return false
+}
+
+func (privKey PrivKey) Type() string {
+	return KeyType
+}
+
+// GenPrivKey generates a new private key of the DefaultMode.
+func GenPrivKey() PrivKey {
+	return GenPrivKeyWithMode(DefaultMode)
+}
+
+// GenPrivKeyWithMode generates a new private key of mode m, using OS
+// randomness.
+func GenPrivKeyWithMode(m Mode) PrivKey {
+	return genPrivKey(m, crypto.CReader())
+}
+
+func genPrivKey(m Mode, rand io.Reader) PrivKey {
+	scheme := m.scheme()
+	if scheme == nil {
+		panic(fmt.Sprintf("invalid Dilithium mode %d", int(m)))
+	}
+	seed := make([]byte, scheme.SeedSize())
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		panic(err)
+	}
+	_, sk := scheme.NewKeyFromSeed(seed)
+	return PrivKey(sk.Bytes())
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses that 32 byte
+// output as the seed of a private key of mode m.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(m Mode, secret []byte) PrivKey {
+	seed := sha256.Sum256(secret)
+	return genPrivKey(m, bytes.NewReader(seed[:]))
+}
+
+//-------------------------------------
+
+// PubKey implements crypto.PubKey. It is a packed Dilithium public key.
+type PubKey []byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKey) Address() crypto.Address {
+	return crypto.Address(tmhash.SumTruncated(pubKey))
+}
+
+// Bytes returns the PubKey byte format.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+// Mode returns the mode of the key, and false if the key has an invalid
+// size.
+func (pubKey PubKey) Mode() (Mode, bool) {
+	return ModeFromPubKeySize(len(pubKey))
+}
+
+// VerifySignature verifies a signature of the mode of the key.
+func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	m, ok := pubKey.Mode()
+	if !ok || len(sig) != m.SignatureSize() {
+		return false
+	}
+	scheme := m.scheme()
+	return scheme.Verify(scheme.PublicKeyFromBytes(pubKey), msg, sig)
+}
+
+// String shows the address rather than the key, which is a few kilobytes.
+func (pubKey PubKey) String() string {
+	m, _ := pubKey.Mode()
+	return fmt.Sprintf("PubKey%s{%X}", m, []byte(pubKey.Address()))
+}
+
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+func (pubKey PubKey) Equals(other crypto.PubKey) bool {
+	if otherKey, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey, otherKey)
+	}
+	return false
+}
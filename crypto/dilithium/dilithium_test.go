@@ -0,0 +1,97 @@
+package dilithium_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	cryptoenc "github.com/fluentum-chain/fluentum/crypto/encoding"
+	tmjson "github.com/fluentum-chain/fluentum/libs/json"
+	protocrypto "github.com/fluentum-chain/fluentum/proto/tendermint/crypto"
+)
+
+func TestSignAndValidateDilithium(t *testing.T) {
+	for _, mode := range dilithium.Modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			privKey := dilithium.GenPrivKeyWithMode(mode)
+			require.Len(t, privKey, mode.PrivKeySize())
+			assert.Equal(t, mode, privKey.Mode())
+
+			pubKey := privKey.PubKey().(dilithium.PubKey)
+			require.Len(t, pubKey, mode.PubKeySize())
+			m, ok := pubKey.Mode()
+			require.True(t, ok)
+			assert.Equal(t, mode, m)
+
+			msg := crypto.CRandBytes(128)
+			sig, err := privKey.Sign(msg)
+			require.NoError(t, err)
+			require.Len(t, sig, mode.SignatureSize())
+			assert.True(t, pubKey.VerifySignature(msg, sig))
+
+			// signatures are deterministic
+			again, err := privKey.Sign(msg)
+			require.NoError(t, err)
+			assert.Equal(t, sig, again)
+
+			// Mutate the signature, just one bit.
+			sig[7] ^= byte(0x01)
+			assert.False(t, pubKey.VerifySignature(msg, sig))
+			assert.False(t, pubKey.VerifySignature(msg, sig[:len(sig)-1]))
+		})
+	}
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	a := dilithium.GenPrivKeyFromSecret(dilithium.Mode2, []byte("secret"))
+	b := dilithium.GenPrivKeyFromSecret(dilithium.Mode2, []byte("secret"))
+	c := dilithium.GenPrivKeyFromSecret(dilithium.Mode2, []byte("other secret"))
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+	assert.True(t, a.PubKey().Equals(b.PubKey()))
+}
+
+func TestPubKeyMismatchedModes(t *testing.T) {
+	msg := []byte("vote")
+	sig, err := dilithium.GenPrivKeyWithMode(dilithium.Mode3).Sign(msg)
+	require.NoError(t, err)
+
+	// a Mode2 key can't verify a Mode3 signature, nor can a key of no mode
+	other := dilithium.GenPrivKeyWithMode(dilithium.Mode2).PubKey()
+	assert.False(t, other.VerifySignature(msg, sig))
+	assert.False(t, dilithium.PubKey(make([]byte, 100)).VerifySignature(msg, sig))
+
+	_, err = dilithium.PrivKey(make([]byte, 100)).Sign(msg)
+	assert.Error(t, err)
+}
+
+func TestPubKeyProtoAndJSON(t *testing.T) {
+	pubKey := dilithium.GenPrivKeyWithMode(dilithium.Mode5).PubKey()
+
+	pb, err := cryptoenc.PubKeyToProto(pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(pubKey.(dilithium.PubKey)), pb.GetDilithium())
+
+	bz, err := pb.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, pb.Unmarshal(bz))
+	decoded, err := cryptoenc.PubKeyFromProto(pb)
+	require.NoError(t, err)
+	assert.True(t, pubKey.Equals(decoded))
+	assert.Equal(t, pubKey.Address(), decoded.Address())
+
+	_, err = cryptoenc.PubKeyToProto(dilithium.PubKey(make([]byte, 100)))
+	assert.Error(t, err)
+	pb.Sum = &protocrypto.PublicKey_Dilithium{Dilithium: make([]byte, 100)}
+	_, err = cryptoenc.PubKeyFromProto(pb)
+	assert.Error(t, err)
+
+	jsonBz, err := tmjson.Marshal(pubKey)
+	require.NoError(t, err)
+	var fromJSON crypto.PubKey
+	require.NoError(t, tmjson.Unmarshal(jsonBz, &fromJSON))
+	assert.True(t, pubKey.Equals(fromJSON))
+}
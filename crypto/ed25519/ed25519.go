@@ -3,9 +3,12 @@ package ed25519
 import (
 	"bytes"
 	"crypto/subtle"
+	"errors"
 	"fmt"
 	"io"
 
+	voied25519 "github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
+	"github.com/oasisprotocol/curve25519-voi/primitives/ed25519/extra/cache"
 	"golang.org/x/crypto/ed25519"
 
 	"github.com/fluentum-chain/fluentum/crypto"
@@ -15,7 +18,21 @@ import (
 
 //-------------------------------------
 
-var _ crypto.PrivKey = PrivKey{}
+var (
+	_ crypto.PrivKey       = PrivKey{}
+	_ crypto.BatchVerifier = &BatchVerifier{}
+
+	// Batches are verified with curve25519-voi, using the cofactored
+	// verification equation of ZIP-215, the only one batch verification
+	// supports. Small order points and non-canonical encodings are rejected,
+	// so that a batch only passes when its signatures are also valid under
+	// the single verification rules. Failed batches are verified one by one.
+	batchVerifyOptions = &voied25519.Options{
+		Verify: &voied25519.VerifyOptions{},
+	}
+
+	cachingVerifier = cache.NewVerifier(cache.NewLRUCache(cacheSize))
+)
 
 const (
 	PrivKeyName = "tendermint/PrivKeyEd25519"
@@ -32,6 +49,10 @@ const (
 	SeedSize = 32
 
 	KeyType = "ed25519"
+
+	// cacheSize is the number of public keys that will be cached in
+	// an expanded format for repeated batch verification.
+	cacheSize = 4096
 )
 
 func init() {
@@ -169,3 +190,71 @@ func (pubKey PubKey) Equals(other crypto.PubKey) bool {
 
 	return false
 }
+
+//-------------------------------------
+
+// BatchVerifier implements batch verification for ed25519. When a batch
+// fails, its signatures are verified with VerifySignature, so the result is
+// the one of single verification.
+type BatchVerifier struct {
+	*voied25519.BatchVerifier
+
+	entries []batchEntry
+}
+
+type batchEntry struct {
+	pubKey    PubKey
+	msg       []byte
+	signature []byte
+}
+
+// NewBatchVerifier returns an empty ed25519 batch verifier.
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{BatchVerifier: voied25519.NewBatchVerifier()}
+}
+
+// Add implements crypto.BatchVerifier.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	pkEd, ok := key.(PubKey)
+	if !ok {
+		return fmt.Errorf("pubkey is not Ed25519")
+	}
+
+	pkBytes := pkEd.Bytes()
+
+	if l := len(pkBytes); l != PubKeySize {
+		return fmt.Errorf("pubkey size is incorrect; expected: %d, got %d", PubKeySize, l)
+	}
+
+	// check that the signature is the correct length
+	if len(signature) != SignatureSize {
+		return errors.New("invalid signature")
+	}
+
+	cachingVerifier.AddWithOptions(b.BatchVerifier, voied25519.PublicKey(pkBytes), msg, signature, batchVerifyOptions)
+	b.entries = append(b.entries, batchEntry{pubKey: pkEd, msg: msg, signature: signature})
+
+	return nil
+}
+
+// Verify implements crypto.BatchVerifier.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	if len(b.entries) == 0 {
+		return false, nil
+	}
+	if b.BatchVerifier.VerifyBatchOnly(crypto.CReader()) {
+		valid := make([]bool, len(b.entries))
+		for i := range valid {
+			valid[i] = true
+		}
+		return true, valid
+	}
+
+	allValid := true
+	valid := make([]bool, len(b.entries))
+	for i, entry := range b.entries {
+		valid[i] = entry.pubKey.VerifySignature(entry.msg, entry.signature)
+		allValid = allValid && valid[i]
+	}
+	return allValid, valid
+}
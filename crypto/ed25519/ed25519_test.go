@@ -28,3 +28,79 @@ func TestSignAndValidateEd25519(t *testing.T) {
 
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
+
+func TestBatchSafe(t *testing.T) {
+	v := ed25519.NewBatchVerifier()
+
+	for i := 0; i <= 38; i++ {
+		priv := ed25519.GenPrivKey()
+		pub := priv.PubKey()
+
+		var msg []byte
+		if i%2 == 0 {
+			msg = []byte("easter")
+		} else {
+			msg = []byte("egg")
+		}
+
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+
+		err = v.Add(pub, msg, sig)
+		require.NoError(t, err)
+	}
+
+	ok, _ := v.Verify()
+	require.True(t, ok)
+}
+
+func TestBatchMatchesSingleVerification(t *testing.T) {
+	// identity is the canonical encoding of the identity point, and
+	// nonCanonicalIdentity its encoding with y = p + 1.
+	identity := make([]byte, 32)
+	identity[0] = 0x01
+	nonCanonicalIdentity := []byte{
+		0xee, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+	}
+	zero := make([]byte, 32)
+
+	type entry struct {
+		pubKey crypto.PubKey
+		msg    []byte
+		sig    []byte
+	}
+	entries := make([]entry, 0, 6)
+	for i := 0; i < 4; i++ {
+		priv := ed25519.GenPrivKey()
+		msg := crypto.CRandBytes(32)
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		entries = append(entries, entry{priv.PubKey(), msg, sig})
+	}
+	// a mutated signature
+	entries[1].sig[7] ^= byte(0x01)
+	// small order public key and R, accepted by single verification
+	entries = append(entries, entry{ed25519.PubKey(identity), []byte("egg"), append(append([]byte{}, identity...), zero...)})
+	// non-canonical R, only accepted by ZIP-215
+	entries = append(entries, entry{ed25519.PubKey(identity), []byte("egg"), append(append([]byte{}, nonCanonicalIdentity...), zero...)})
+
+	v := ed25519.NewBatchVerifier()
+	expected := make([]bool, len(entries))
+	for i, e := range entries {
+		require.NoError(t, v.Add(e.pubKey, e.msg, e.sig))
+		expected[i] = e.pubKey.VerifySignature(e.msg, e.sig)
+	}
+	require.Equal(t, []bool{true, false, true, true, true, false}, expected)
+
+	ok, valid := v.Verify()
+	require.False(t, ok)
+	require.Equal(t, expected, valid)
+
+	// a batch of signatures only ZIP-215 accepts fails as well
+	v = ed25519.NewBatchVerifier()
+	require.NoError(t, v.Add(entries[5].pubKey, entries[5].msg, entries[5].sig))
+	ok, valid = v.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{false}, valid)
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
 	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
 	"github.com/fluentum-chain/fluentum/crypto/secp256k1"
 	"github.com/fluentum-chain/fluentum/libs/json"
 	protocrypto "github.com/fluentum-chain/fluentum/proto/tendermint/crypto"
@@ -14,6 +16,8 @@ func init() {
 	json.RegisterType((*protocrypto.PublicKey)(nil), "tendermint.crypto.PublicKey")
 	json.RegisterType((*protocrypto.PublicKey_Ed25519)(nil), "tendermint.crypto.PublicKey_Ed25519")
 	json.RegisterType((*protocrypto.PublicKey_Secp256K1)(nil), "tendermint.crypto.PublicKey_Secp256K1")
+	json.RegisterType((*protocrypto.PublicKey_Dilithium)(nil), "tendermint.crypto.PublicKey_Dilithium")
+	json.RegisterType((*protocrypto.PublicKey_Hybrid)(nil), "tendermint.crypto.PublicKey_Hybrid")
 }
 
 // PubKeyToProto takes crypto.PubKey and transforms it to a protobuf Pubkey
@@ -32,6 +36,24 @@ func PubKeyToProto(k crypto.PubKey) (protocrypto.PublicKey, error) {
 				Secp256K1: k,
 			},
 		}
+	case dilithium.PubKey:
+		if _, ok := k.Mode(); !ok {
+			return kp, fmt.Errorf("toproto: invalid size for PubKeyDilithium. Got %d", len(k))
+		}
+		kp = protocrypto.PublicKey{
+			Sum: &protocrypto.PublicKey_Dilithium{
+				Dilithium: k,
+			},
+		}
+	case hybrid.PubKey:
+		if _, ok := k.Mode(); !ok {
+			return kp, fmt.Errorf("toproto: invalid size for PubKeyHybrid. Got %d", len(k))
+		}
+		kp = protocrypto.PublicKey{
+			Sum: &protocrypto.PublicKey_Hybrid{
+				Hybrid: k,
+			},
+		}
 	default:
 		return kp, fmt.Errorf("toproto: key type %v is not supported", k)
 	}
@@ -57,6 +79,22 @@ func PubKeyFromProto(k protocrypto.PublicKey) (crypto.PubKey, error) {
 		pk := make(secp256k1.PubKey, secp256k1.PubKeySize)
 		copy(pk, k.Secp256K1)
 		return pk, nil
+	case *protocrypto.PublicKey_Dilithium:
+		if _, ok := dilithium.ModeFromPubKeySize(len(k.Dilithium)); !ok {
+			return nil, fmt.Errorf("invalid size for PubKeyDilithium. Got %d, expected one of %d, %d or %d",
+				len(k.Dilithium), dilithium.Mode2.PubKeySize(), dilithium.Mode3.PubKeySize(), dilithium.Mode5.PubKeySize())
+		}
+		pk := make(dilithium.PubKey, len(k.Dilithium))
+		copy(pk, k.Dilithium)
+		return pk, nil
+	case *protocrypto.PublicKey_Hybrid:
+		pk := make(hybrid.PubKey, len(k.Hybrid))
+		copy(pk, k.Hybrid)
+		if _, ok := pk.Mode(); !ok {
+			return nil, fmt.Errorf("invalid size for PubKeyHybrid. Got %d, expected %d plus the size of a PubKeyDilithium",
+				len(k.Hybrid), ed25519.PubKeySize)
+		}
+		return pk, nil
 	default:
 		return nil, fmt.Errorf("fromproto: key type %v is not supported", k)
 	}
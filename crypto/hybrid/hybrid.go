@@ -0,0 +1,219 @@
+// Package hybrid implements crypto.PubKey and crypto.PrivKey for keys pairing
+// an Ed25519 key with a CRYSTALS-Dilithium key. A hybrid signature is valid
+// only if both the Ed25519 and the Dilithium signatures are, so it stays
+// secure as long as either scheme is.
+//
+// Keys and signatures are the Ed25519 ones followed by the Dilithium ones.
+// The address of a hybrid key is the address of its Ed25519 key, which lets a
+// validator rotate from an Ed25519 key to a hybrid key built on top of it
+// without changing its address.
+package hybrid
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	tmjson "github.com/fluentum-chain/fluentum/libs/json"
+)
+
+//-------------------------------------
+
+var (
+	_ crypto.PrivKey = PrivKey{}
+	_ crypto.PubKey  = PubKey{}
+)
+
+const (
+	PrivKeyName = "tendermint/PrivKeyHybrid"
+	PubKeyName  = "tendermint/PubKeyHybrid"
+
+	KeyType = "hybrid"
+
+	// ClassicSignatureSize is the size of the Ed25519 half of signatures.
+	ClassicSignatureSize = ed25519.SignatureSize
+)
+
+func init() {
+	tmjson.RegisterType(PubKey{}, PubKeyName)
+	tmjson.RegisterType(PrivKey{}, PrivKeyName)
+}
+
+// SplitSignature splits a hybrid signature into its Ed25519 and Dilithium
+// signatures. Signatures too short to hold both are returned whole as the
+// classic signature.
+func SplitSignature(sig []byte) (classic, quantum []byte) {
+	if len(sig) <= ClassicSignatureSize {
+		return sig, nil
+	}
+	return sig[:ClassicSignatureSize], sig[ClassicSignatureSize:]
+}
+
+//-------------------------------------
+
+// PrivKey implements crypto.PrivKey. It is an Ed25519 private key followed by
+// a packed Dilithium private key.
+type PrivKey []byte
+
+// NewPrivKey pairs an Ed25519 and a Dilithium private key.
+func NewPrivKey(classic ed25519.PrivKey, quantum dilithium.PrivKey) PrivKey {
+	privKey := make(PrivKey, 0, len(classic)+len(quantum))
+	privKey = append(privKey, classic...)
+	return append(privKey, quantum...)
+}
+
+// Bytes returns the privkey byte format.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// ClassicKey returns the Ed25519 key of privKey.
+func (privKey PrivKey) ClassicKey() ed25519.PrivKey {
+	if len(privKey) < ed25519.PrivateKeySize {
+		return nil
+	}
+	return ed25519.PrivKey(privKey[:ed25519.PrivateKeySize])
+}
+
+// QuantumKey returns the Dilithium key of privKey.
+func (privKey PrivKey) QuantumKey() dilithium.PrivKey {
+	if len(privKey) < ed25519.PrivateKeySize {
+		return nil
+	}
+	return dilithium.PrivKey(privKey[ed25519.PrivateKeySize:])
+}
+
+// Sign signs msg with both keys, and returns the Ed25519 signature followed
+// by the Dilithium signature. Signatures are deterministic.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	if len(privKey) <= ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid hybrid private key size %d", len(privKey))
+	}
+	classic, err := privKey.ClassicKey().Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+	quantum, err := privKey.QuantumKey().Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+	return append(classic, quantum...), nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+//
+// Panics if the private key has an invalid size.
+func (privKey PrivKey) PubKey() crypto.PubKey {
+	if len(privKey) <= ed25519.PrivateKeySize {
+		panic(fmt.Sprintf("invalid hybrid private key size %d", len(privKey)))
+	}
+	return NewPubKey(
+		privKey.ClassicKey().PubKey().(ed25519.PubKey),
+		privKey.QuantumKey().PubKey().(dilithium.PubKey),
+	)
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other crypto.PrivKey) bool {
+	if otherKey, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey, otherKey) == 1
+	}
+	return false
+}
+
+func (privKey PrivKey) Type() string {
+	return KeyType
+}
+
+// GenPrivKey generates a new private key with a Dilithium key of the
+// dilithium.DefaultMode.
+func GenPrivKey() PrivKey {
+	return GenPrivKeyWithMode(dilithium.DefaultMode)
+}
+
+// GenPrivKeyWithMode generates a new private key with a Dilithium key of
+// mode m, using OS randomness.
+func GenPrivKeyWithMode(m dilithium.Mode) PrivKey {
+	return NewPrivKey(ed25519.GenPrivKey(), dilithium.GenPrivKeyWithMode(m))
+}
+
+//-------------------------------------
+
+// PubKey implements crypto.PubKey. It is an Ed25519 public key followed by a
+// packed Dilithium public key.
+type PubKey []byte
+
+// NewPubKey pairs an Ed25519 and a Dilithium public key.
+func NewPubKey(classic ed25519.PubKey, quantum dilithium.PubKey) PubKey {
+	pubKey := make(PubKey, 0, len(classic)+len(quantum))
+	pubKey = append(pubKey, classic...)
+	return append(pubKey, quantum...)
+}
+
+// ClassicKey returns the Ed25519 key of pubKey.
+func (pubKey PubKey) ClassicKey() ed25519.PubKey {
+	if len(pubKey) < ed25519.PubKeySize {
+		return nil
+	}
+	return ed25519.PubKey(pubKey[:ed25519.PubKeySize])
+}
+
+// QuantumKey returns the Dilithium key of pubKey.
+func (pubKey PubKey) QuantumKey() dilithium.PubKey {
+	if len(pubKey) < ed25519.PubKeySize {
+		return nil
+	}
+	return dilithium.PubKey(pubKey[ed25519.PubKeySize:])
+}
+
+// Address is the address of the Ed25519 key.
+func (pubKey PubKey) Address() crypto.Address {
+	return pubKey.ClassicKey().Address()
+}
+
+// Bytes returns the PubKey byte format.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+// Mode returns the mode of the Dilithium key, and false if the key has an
+// invalid size.
+func (pubKey PubKey) Mode() (dilithium.Mode, bool) {
+	if len(pubKey) < ed25519.PubKeySize {
+		return 0, false
+	}
+	return pubKey.QuantumKey().Mode()
+}
+
+// VerifySignature verifies a hybrid signature. Both the Ed25519 and the
+// Dilithium signatures must be valid.
+func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	if _, ok := pubKey.Mode(); !ok {
+		return false
+	}
+	classic, quantum := SplitSignature(sig)
+	return pubKey.ClassicKey().VerifySignature(msg, classic) &&
+		pubKey.QuantumKey().VerifySignature(msg, quantum)
+}
+
+// String shows the Ed25519 key rather than the whole key, which is a few
+// kilobytes.
+func (pubKey PubKey) String() string {
+	m, _ := pubKey.Mode()
+	return fmt.Sprintf("PubKeyHybrid%s{%X}", m, []byte(pubKey.ClassicKey()))
+}
+
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+func (pubKey PubKey) Equals(other crypto.PubKey) bool {
+	if otherKey, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey, otherKey)
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package hybrid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	cryptoenc "github.com/fluentum-chain/fluentum/crypto/encoding"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
+	tmjson "github.com/fluentum-chain/fluentum/libs/json"
+	protocrypto "github.com/fluentum-chain/fluentum/proto/tendermint/crypto"
+)
+
+func TestSignAndValidateHybrid(t *testing.T) {
+	privKey := hybrid.GenPrivKeyWithMode(dilithium.Mode2)
+	pubKey := privKey.PubKey().(hybrid.PubKey)
+	m, ok := pubKey.Mode()
+	require.True(t, ok)
+	assert.Equal(t, dilithium.Mode2, m)
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, ed25519.SignatureSize+dilithium.Mode2.SignatureSize())
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+
+	classic, quantum := hybrid.SplitSignature(sig)
+	assert.True(t, pubKey.ClassicKey().VerifySignature(msg, classic))
+	assert.True(t, pubKey.QuantumKey().VerifySignature(msg, quantum))
+
+	// either half alone is not enough
+	assert.False(t, pubKey.VerifySignature(msg, classic))
+	assert.False(t, pubKey.VerifySignature(msg, append(make([]byte, ed25519.SignatureSize), quantum...)))
+
+	// Mutate the signature of each scheme, just one bit.
+	for _, i := range []int{7, ed25519.SignatureSize + 7} {
+		mutated := append([]byte{}, sig...)
+		mutated[i] ^= byte(0x01)
+		assert.False(t, pubKey.VerifySignature(msg, mutated))
+	}
+}
+
+func TestAddressIsClassicAddress(t *testing.T) {
+	classic := ed25519.GenPrivKey()
+	privKey := hybrid.NewPrivKey(classic, dilithium.GenPrivKeyWithMode(dilithium.Mode2))
+
+	assert.Equal(t, classic.PubKey().Address(), privKey.PubKey().Address())
+	assert.True(t, classic.Equals(privKey.ClassicKey()))
+	assert.False(t, classic.PubKey().Equals(privKey.PubKey()))
+}
+
+func TestPubKeyProtoAndJSON(t *testing.T) {
+	pubKey := hybrid.GenPrivKey().PubKey()
+
+	pb, err := cryptoenc.PubKeyToProto(pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(pubKey.(hybrid.PubKey)), pb.GetHybrid())
+
+	bz, err := pb.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, pb.Unmarshal(bz))
+	decoded, err := cryptoenc.PubKeyFromProto(pb)
+	require.NoError(t, err)
+	assert.True(t, pubKey.Equals(decoded))
+
+	_, err = cryptoenc.PubKeyToProto(hybrid.PubKey(make([]byte, ed25519.PubKeySize)))
+	assert.Error(t, err)
+	pb.Sum = &protocrypto.PublicKey_Hybrid{Hybrid: make([]byte, 100)}
+	_, err = cryptoenc.PubKeyFromProto(pb)
+	assert.Error(t, err)
+
+	jsonBz, err := tmjson.Marshal(pubKey)
+	require.NoError(t, err)
+	var fromJSON crypto.PubKey
+	require.NoError(t, tmjson.Unmarshal(jsonBz, &fromJSON))
+	assert.True(t, pubKey.Equals(fromJSON))
+}
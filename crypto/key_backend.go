@@ -0,0 +1,117 @@
+package crypto
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/fluentum-chain/fluentum/libs/tempfile"
+)
+
+// KeyEncryptionBackend encrypts and decrypts key pairs with a key encryption
+// key it holds, e.g. in a KMS, so that key pair files can be saved without a
+// passphrase. Implementations are in the crypto/kms package.
+type KeyEncryptionBackend interface {
+	// Name returns the name of the backend, which is recorded in the files it
+	// encrypts.
+	Name() string
+	// Encrypt returns plaintext encrypted with the key encryption key.
+	Encrypt(ctx context.Context, plaintext []byte) ([]byte, error)
+	// Decrypt returns the plaintext of a ciphertext returned by Encrypt.
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// wrappedKeyFile is the format of the key pair files encrypted by a
+// KeyEncryptionBackend. Files written before the format was versioned hold
+// only the ciphertext.
+type wrappedKeyFile struct {
+	Version    int    `json:"version"`
+	Algorithm  string `json:"algorithm,omitempty"`
+	Backend    string `json:"backend"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// SaveKeyPairWithBackend encrypts the keypair with backend, and saves it to
+// filename. The file is replaced atomically.
+func SaveKeyPairWithBackend(
+	ctx context.Context,
+	filename string,
+	keypair KeyPair,
+	backend KeyEncryptionBackend,
+) error {
+	if keypair.Algorithm == "" {
+		keypair.Algorithm = inferKeyAlgorithm(keypair)
+	}
+	plaintext, err := json.Marshal(keypair)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := backend.Encrypt(ctx, plaintext)
+	if err != nil {
+		return fmt.Errorf("%s backend: %w", backend.Name(), err)
+	}
+	bz, err := json.MarshalIndent(wrappedKeyFile{
+		Version:    KeystoreVersion,
+		Algorithm:  keypair.Algorithm,
+		Backend:    backend.Name(),
+		Ciphertext: ciphertext,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filename, bz, 0o600)
+}
+
+// KeyFileBackend returns the name of the backend that encrypted the key pair
+// file filename, or an empty string if it is not encrypted by a backend, e.g.
+// a keystore file encrypted with a password. Legacy files are not recognized.
+func KeyFileBackend(filename string) (string, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	var wrapped wrappedKeyFile
+	if err := json.Unmarshal(bz, &wrapped); err != nil || wrapped.Version == 0 {
+		return "", nil
+	}
+	return wrapped.Backend, nil
+}
+
+// LoadKeyPairWithBackend loads the keypair saved in filename, and decrypts it
+// with backend.
+func LoadKeyPairWithBackend(ctx context.Context, filename string, backend KeyEncryptionBackend) (KeyPair, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return KeyPair{}, err
+	}
+
+	var wrapped wrappedKeyFile
+	if err := json.Unmarshal(bz, &wrapped); err != nil || wrapped.Version == 0 {
+		// legacy file, holding only the ciphertext
+		wrapped = wrappedKeyFile{Backend: backend.Name(), Ciphertext: bz}
+	} else if wrapped.Version != KeystoreVersion {
+		return KeyPair{}, fmt.Errorf("%w: %d", ErrKeystoreVersion, wrapped.Version)
+	}
+	if wrapped.Backend != backend.Name() {
+		return KeyPair{}, fmt.Errorf("%s was encrypted by the %s backend, not %s",
+			filename, wrapped.Backend, backend.Name())
+	}
+
+	plaintext, err := backend.Decrypt(ctx, wrapped.Ciphertext)
+	if err != nil {
+		return KeyPair{}, fmt.Errorf("%s backend: %w", backend.Name(), err)
+	}
+	var keypair KeyPair
+	if err := json.Unmarshal(plaintext, &keypair); err != nil {
+		return KeyPair{}, err
+	}
+	if keypair.Algorithm == "" {
+		keypair.Algorithm = inferKeyAlgorithm(keypair)
+	}
+	if wrapped.Version != 0 && keypair.Algorithm != wrapped.Algorithm {
+		return KeyPair{}, fmt.Errorf("file algorithm %q doesn't match key pair algorithm %q",
+			wrapped.Algorithm, keypair.Algorithm)
+	}
+	return keypair, nil
+}
@@ -0,0 +1,363 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cloudflare/circl/sign/dilithium"
+	"golang.org/x/crypto/argon2"
+
+	"github.com/fluentum-chain/fluentum/libs/tempfile"
+)
+
+// Key pair files are stored in a versioned JSON keystore format:
+//
+//	{
+//	  "version": 1,
+//	  "algorithm": "ml-kem-768",
+//	  "kdf": {"name": "argon2id", "salt": "...", "time": 3, "memory": 65536, "threads": 4},
+//	  "cipher": {"name": "aes-256-gcm", "nonce": "..."},
+//	  "ciphertext": "..."
+//	}
+//
+// The AES key is derived from the password with Argon2id, using the stored
+// salt and parameters. Everything but the ciphertext is authenticated as the
+// additional data of the AES-GCM encryption.
+//
+// Files written before the keystore was versioned hold the raw nonce and
+// ciphertext, encrypted with the password used directly as the AES key. They
+// are still read by LoadKeyPairFromFile, and MigrateKeyPairFile converts them
+// to the current version.
+
+const (
+	// KeystoreVersion is the version of the keystore files written by
+	// SaveKeyPairToFile. Legacy files have version 0.
+	KeystoreVersion = 1
+
+	// KeystoreKDFArgon2id is the name of the Argon2id key derivation function.
+	KeystoreKDFArgon2id = "argon2id"
+	// KeystoreCipherAES256GCM is the name of the AES-256-GCM cipher.
+	KeystoreCipherAES256GCM = "aes-256-gcm"
+
+	// KeyAlgorithmMLKEM768 is the algorithm of key pairs generated by
+	// GenerateKeyPair.
+	KeyAlgorithmMLKEM768 = "ml-kem-768"
+	// KeyAlgorithmDilithium3 is the algorithm of key pairs used by
+	// QuantumResistantSign.
+	KeyAlgorithmDilithium3 = "dilithium3"
+
+	keystoreSaltSize = 16
+	keystoreKeySize  = 32
+	// maxArgon2Memory caps the memory, in KiB, that loading a keystore can
+	// require, so that a crafted file cannot exhaust the memory of the node.
+	maxArgon2Memory = 4 * 1024 * 1024
+)
+
+var (
+	// ErrKeystoreVersion is returned when loading a keystore of an unknown
+	// version.
+	ErrKeystoreVersion = errors.New("unsupported keystore version")
+	// ErrKeystoreDecrypt is returned when a keystore cannot be decrypted,
+	// either because the password is wrong or because the file is corrupted.
+	ErrKeystoreDecrypt = errors.New("wrong password or corrupted keystore")
+)
+
+// KeyAlgorithms lists the algorithms of the key pairs NewKeyPair generates.
+var KeyAlgorithms = []string{KeyAlgorithmMLKEM768, KeyAlgorithmDilithium3}
+
+// Argon2Params are the Argon2id parameters used to derive the keystore
+// encryption key from a password.
+type Argon2Params struct {
+	// Number of passes over the memory.
+	Time uint32 `json:"time"`
+	// Memory used, in KiB.
+	Memory uint32 `json:"memory"`
+	// Degree of parallelism.
+	Threads uint8 `json:"threads"`
+}
+
+// DefaultArgon2Params returns the Argon2id parameters recommended by RFC 9106
+// for memory constrained environments.
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}
+}
+
+// ValidateBasic performs basic validation.
+func (p Argon2Params) ValidateBasic() error {
+	if p.Time == 0 {
+		return errors.New("argon2 time must be positive")
+	}
+	if p.Threads == 0 {
+		return errors.New("argon2 threads must be positive")
+	}
+	if p.Memory < 8*uint32(p.Threads) {
+		return fmt.Errorf("argon2 memory must be at least 8 KiB per thread, got %d KiB for %d threads",
+			p.Memory, p.Threads)
+	}
+	if p.Memory > maxArgon2Memory {
+		return fmt.Errorf("argon2 memory can't be greater than %d KiB, got %d KiB", maxArgon2Memory, p.Memory)
+	}
+	return nil
+}
+
+type keystoreKDF struct {
+	Name string `json:"name"`
+	Salt []byte `json:"salt"`
+	Argon2Params
+}
+
+type keystoreCipher struct {
+	Name  string `json:"name"`
+	Nonce []byte `json:"nonce"`
+}
+
+type keystoreFile struct {
+	Version    int            `json:"version"`
+	Algorithm  string         `json:"algorithm,omitempty"`
+	KDF        keystoreKDF    `json:"kdf"`
+	Cipher     keystoreCipher `json:"cipher"`
+	Ciphertext []byte         `json:"ciphertext,omitempty"`
+}
+
+// additionalData returns the data authenticated along with the ciphertext.
+func (ks keystoreFile) additionalData() ([]byte, error) {
+	ks.Ciphertext = nil
+	return json.Marshal(ks)
+}
+
+func (ks keystoreFile) gcm(password []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey(password, ks.KDF.Salt, ks.KDF.Time, ks.KDF.Memory, ks.KDF.Threads, keystoreKeySize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+type keystoreOptions struct {
+	argon2 Argon2Params
+}
+
+// KeystoreOption sets an optional parameter of the keystore files written.
+type KeystoreOption func(*keystoreOptions)
+
+// KeystoreArgon2Params sets the Argon2id parameters of the keystore files
+// written. The default is DefaultArgon2Params.
+func KeystoreArgon2Params(params Argon2Params) KeystoreOption {
+	return func(o *keystoreOptions) { o.argon2 = params }
+}
+
+// SaveKeyPairToFile encrypts the keypair with a key derived from password,
+// and saves it to filename in the current keystore version. The file is
+// replaced atomically. If the keypair has no algorithm, it is inferred from
+// its key sizes.
+func SaveKeyPairToFile(filename string, keypair KeyPair, password []byte, options ...KeystoreOption) error {
+	opts := keystoreOptions{argon2: DefaultArgon2Params()}
+	for _, option := range options {
+		option(&opts)
+	}
+	if err := opts.argon2.ValidateBasic(); err != nil {
+		return err
+	}
+	if keypair.Algorithm == "" {
+		keypair.Algorithm = inferKeyAlgorithm(keypair)
+	}
+
+	ks := keystoreFile{
+		Version:   KeystoreVersion,
+		Algorithm: keypair.Algorithm,
+		KDF: keystoreKDF{
+			Name:         KeystoreKDFArgon2id,
+			Salt:         make([]byte, keystoreSaltSize),
+			Argon2Params: opts.argon2,
+		},
+		Cipher: keystoreCipher{Name: KeystoreCipherAES256GCM},
+	}
+	if _, err := io.ReadFull(rand.Reader, ks.KDF.Salt); err != nil {
+		return err
+	}
+	gcm, err := ks.gcm(password)
+	if err != nil {
+		return err
+	}
+	ks.Cipher.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, ks.Cipher.Nonce); err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(keypair)
+	if err != nil {
+		return err
+	}
+	ad, err := ks.additionalData()
+	if err != nil {
+		return err
+	}
+	ks.Ciphertext = gcm.Seal(nil, ks.Cipher.Nonce, plaintext, ad)
+
+	bz, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filename, bz, 0o600)
+}
+
+// LoadKeyPairFromFile loads and decrypts the keypair saved in filename, in
+// any keystore version.
+func LoadKeyPairFromFile(filename string, password []byte) (KeyPair, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	ks, ok := parseKeystore(bz)
+	if !ok {
+		return loadLegacyKeyPair(bz, password)
+	}
+	if ks.Version != KeystoreVersion {
+		return KeyPair{}, fmt.Errorf("%w: %d", ErrKeystoreVersion, ks.Version)
+	}
+	if ks.KDF.Name != KeystoreKDFArgon2id {
+		return KeyPair{}, fmt.Errorf("unsupported keystore kdf %q", ks.KDF.Name)
+	}
+	if err := ks.KDF.Argon2Params.ValidateBasic(); err != nil {
+		return KeyPair{}, fmt.Errorf("invalid keystore kdf params: %w", err)
+	}
+	if ks.Cipher.Name != KeystoreCipherAES256GCM {
+		return KeyPair{}, fmt.Errorf("unsupported keystore cipher %q", ks.Cipher.Name)
+	}
+
+	gcm, err := ks.gcm(password)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	if len(ks.Cipher.Nonce) != gcm.NonceSize() {
+		return KeyPair{}, fmt.Errorf("invalid keystore nonce size %d", len(ks.Cipher.Nonce))
+	}
+	ad, err := ks.additionalData()
+	if err != nil {
+		return KeyPair{}, err
+	}
+	plaintext, err := gcm.Open(nil, ks.Cipher.Nonce, ks.Ciphertext, ad)
+	if err != nil {
+		return KeyPair{}, ErrKeystoreDecrypt
+	}
+
+	var keypair KeyPair
+	if err := json.Unmarshal(plaintext, &keypair); err != nil {
+		return KeyPair{}, err
+	}
+	if keypair.Algorithm != ks.Algorithm {
+		return KeyPair{}, fmt.Errorf("keystore algorithm %q doesn't match key pair algorithm %q",
+			ks.Algorithm, keypair.Algorithm)
+	}
+	return keypair, nil
+}
+
+// KeystoreFileVersion returns the keystore version of filename, which is 0
+// for legacy files.
+func KeystoreFileVersion(filename string) (int, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return 0, err
+	}
+	ks, ok := parseKeystore(bz)
+	if !ok {
+		return 0, nil
+	}
+	return ks.Version, nil
+}
+
+// MigrateKeyPairFile loads the keypair saved in filename with password, and
+// saves it back in the current keystore version, encrypted with newPassword.
+// Calling it with the same password for both only migrates the file.
+func MigrateKeyPairFile(filename string, password, newPassword []byte, options ...KeystoreOption) error {
+	keypair, err := LoadKeyPairFromFile(filename, password)
+	if err != nil {
+		return err
+	}
+	return SaveKeyPairToFile(filename, keypair, newPassword, options...)
+}
+
+// NewKeyPair generates a new key pair of the given algorithm, which must be
+// one of KeyAlgorithms.
+func NewKeyPair(algorithm string) (KeyPair, error) {
+	switch algorithm {
+	case KeyAlgorithmMLKEM768:
+		pub, priv, err := GenerateKeyPair()
+		if err != nil {
+			return KeyPair{}, err
+		}
+		return KeyPair{Algorithm: algorithm, PublicKey: pub, PrivateKey: priv}, nil
+	case KeyAlgorithmDilithium3:
+		pub, priv, err := dilithium.Mode3.GenerateKey(rand.Reader)
+		if err != nil {
+			return KeyPair{}, err
+		}
+		return KeyPair{Algorithm: algorithm, PublicKey: pub.Bytes(), PrivateKey: priv.Bytes()}, nil
+	default:
+		return KeyPair{}, fmt.Errorf("unknown key algorithm %q, expected one of %v", algorithm, KeyAlgorithms)
+	}
+}
+
+// parseKeystore parses a versioned keystore, and returns false if bz is not
+// one, i.e. if it is a legacy file.
+func parseKeystore(bz []byte) (keystoreFile, bool) {
+	var ks keystoreFile
+	if err := json.Unmarshal(bz, &ks); err != nil || ks.Version == 0 {
+		return keystoreFile{}, false
+	}
+	return ks, true
+}
+
+// loadLegacyKeyPair decrypts a legacy file, whose password is the AES key.
+func loadLegacyKeyPair(ciphertext []byte, password []byte) (KeyPair, error) {
+	block, err := aes.NewCipher(password)
+	if err != nil {
+		return KeyPair{}, fmt.Errorf("legacy keystore: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	nonceSize := gcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return KeyPair{}, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return KeyPair{}, ErrKeystoreDecrypt
+	}
+	var keypair KeyPair
+	if err := json.Unmarshal(plaintext, &keypair); err != nil {
+		return KeyPair{}, err
+	}
+	if keypair.Algorithm == "" {
+		keypair.Algorithm = inferKeyAlgorithm(keypair)
+	}
+	return keypair, nil
+}
+
+// inferKeyAlgorithm returns the algorithm of keypair from its key sizes, or
+// an empty string if they match no known algorithm.
+func inferKeyAlgorithm(keypair KeyPair) string {
+	switch {
+	case len(keypair.PublicKey) == KEMPublicKeySize && len(keypair.PrivateKey) == KEMPrivateKeySize:
+		return KeyAlgorithmMLKEM768
+	case len(keypair.PublicKey) == dilithium.Mode3.PublicKeySize() &&
+		len(keypair.PrivateKey) == dilithium.Mode3.PrivateKeySize():
+		return KeyAlgorithmDilithium3
+	default:
+		return ""
+	}
+}
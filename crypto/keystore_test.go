@@ -0,0 +1,134 @@
+package crypto_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+)
+
+// testArgon2 keeps the key derivation fast in tests.
+var testArgon2 = crypto.KeystoreArgon2Params(crypto.Argon2Params{Time: 1, Memory: 64, Threads: 1})
+
+func TestKeystoreRoundTrip(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "key.json")
+	password := []byte("correct horse battery staple")
+
+	for _, algorithm := range crypto.KeyAlgorithms {
+		keypair, err := crypto.NewKeyPair(algorithm)
+		require.NoError(t, err)
+
+		require.NoError(t, crypto.SaveKeyPairToFile(filename, keypair, password, testArgon2))
+
+		version, err := crypto.KeystoreFileVersion(filename)
+		require.NoError(t, err)
+		assert.Equal(t, crypto.KeystoreVersion, version)
+		backend, err := crypto.KeyFileBackend(filename)
+		require.NoError(t, err)
+		assert.Empty(t, backend)
+
+		loaded, err := crypto.LoadKeyPairFromFile(filename, password)
+		require.NoError(t, err)
+		assert.Equal(t, keypair, loaded)
+
+		_, err = crypto.LoadKeyPairFromFile(filename, []byte("wrong password"))
+		assert.ErrorIs(t, err, crypto.ErrKeystoreDecrypt)
+	}
+}
+
+func TestKeystoreInfersAlgorithm(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "key.json")
+	password := []byte("password")
+
+	pub, priv, err := crypto.GenerateKeyPair()
+	require.NoError(t, err)
+	require.NoError(t, crypto.SaveKeyPairToFile(filename,
+		crypto.KeyPair{PublicKey: pub, PrivateKey: priv}, password, testArgon2))
+
+	loaded, err := crypto.LoadKeyPairFromFile(filename, password)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.KeyAlgorithmMLKEM768, loaded.Algorithm)
+}
+
+func TestKeystoreTamperedMetadata(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "key.json")
+	password := []byte("password")
+
+	keypair, err := crypto.NewKeyPair(crypto.KeyAlgorithmMLKEM768)
+	require.NoError(t, err)
+	require.NoError(t, crypto.SaveKeyPairToFile(filename, keypair, password, testArgon2))
+
+	bz, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	var ks map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &ks))
+
+	// the metadata is authenticated
+	ks["algorithm"] = crypto.KeyAlgorithmDilithium3
+	bz, err = json.Marshal(ks)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filename, bz, 0o600))
+	_, err = crypto.LoadKeyPairFromFile(filename, password)
+	assert.ErrorIs(t, err, crypto.ErrKeystoreDecrypt)
+
+	ks["version"] = 2
+	bz, err = json.Marshal(ks)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filename, bz, 0o600))
+	_, err = crypto.LoadKeyPairFromFile(filename, password)
+	assert.ErrorIs(t, err, crypto.ErrKeystoreVersion)
+}
+
+func TestKeystoreMigrateLegacy(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "key.json")
+	legacyPassword := []byte("0123456789abcdef0123456789abcdef")
+	newPassword := []byte("a normal passphrase")
+
+	pub, priv, err := crypto.GenerateKeyPair()
+	require.NoError(t, err)
+	writeLegacyKeyPair(t, filename, crypto.KeyPair{PublicKey: pub, PrivateKey: priv}, legacyPassword)
+
+	version, err := crypto.KeystoreFileVersion(filename)
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+
+	loaded, err := crypto.LoadKeyPairFromFile(filename, legacyPassword)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.KeyAlgorithmMLKEM768, loaded.Algorithm)
+	assert.Equal(t, pub, loaded.PublicKey)
+
+	require.NoError(t, crypto.MigrateKeyPairFile(filename, legacyPassword, newPassword, testArgon2))
+
+	version, err = crypto.KeystoreFileVersion(filename)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.KeystoreVersion, version)
+
+	migrated, err := crypto.LoadKeyPairFromFile(filename, newPassword)
+	require.NoError(t, err)
+	assert.Equal(t, loaded, migrated)
+	_, err = crypto.LoadKeyPairFromFile(filename, legacyPassword)
+	assert.ErrorIs(t, err, crypto.ErrKeystoreDecrypt)
+}
+
+// writeLegacyKeyPair writes keypair in the unversioned format, with password
+// used as the AES key.
+func writeLegacyKeyPair(t *testing.T, filename string, keypair crypto.KeyPair, password []byte) {
+	plaintext, err := json.Marshal(keypair)
+	require.NoError(t, err)
+	block, err := aes.NewCipher(password)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filename, gcm.Seal(nonce, nonce, plaintext, nil), 0o600))
+}
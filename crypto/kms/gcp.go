@@ -0,0 +1,72 @@
+package kms
+
+import (
+	"context"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	kmspb "cloud.google.com/go/kms/apiv1/kmspb"
+	"google.golang.org/api/option"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+)
+
+// GCPBackendName is the name of the Google Cloud KMS backend.
+const GCPBackendName = "gcp-kms"
+
+var _ crypto.KeyEncryptionBackend = (*GCPBackend)(nil)
+
+// GCPBackend encrypts with a symmetric key of Google Cloud KMS.
+type GCPBackend struct {
+	keyResource string
+	options     []option.ClientOption
+}
+
+// NewGCPBackend returns a backend using the key keyResource, i.e.
+// projects/*/locations/*/keyRings/*/cryptoKeys/*. If credsFile is empty, the
+// application default credentials are used.
+func NewGCPBackend(keyResource, credsFile string) *GCPBackend {
+	b := &GCPBackend{keyResource: keyResource}
+	if credsFile != "" {
+		b.options = append(b.options, option.WithCredentialsFile(credsFile))
+	}
+	return b
+}
+
+// Name implements crypto.KeyEncryptionBackend.
+func (b *GCPBackend) Name() string { return GCPBackendName }
+
+// Encrypt implements crypto.KeyEncryptionBackend.
+func (b *GCPBackend) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	client, err := kms.NewKeyManagementClient(ctx, b.options...)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	resp, err := client.Encrypt(ctx, &kmspb.EncryptRequest{
+		Name:      b.keyResource,
+		Plaintext: plaintext,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Ciphertext, nil
+}
+
+// Decrypt implements crypto.KeyEncryptionBackend.
+func (b *GCPBackend) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	client, err := kms.NewKeyManagementClient(ctx, b.options...)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	resp, err := client.Decrypt(ctx, &kmspb.DecryptRequest{
+		Name:       b.keyResource,
+		Ciphertext: ciphertext,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Plaintext, nil
+}
@@ -0,0 +1,52 @@
+// Package kms implements the crypto.KeyEncryptionBackend interface, used to
+// save quantum key pairs encrypted by a key that never leaves a key management
+// service, or a local key file.
+package kms
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	cfg "github.com/fluentum-chain/fluentum/config"
+	"github.com/fluentum-chain/fluentum/crypto"
+)
+
+// NewBackend returns the key encryption backend selected by config.
+func NewBackend(config *cfg.QuantumConfig) (crypto.KeyEncryptionBackend, error) {
+	switch config.KeyBackend {
+	case cfg.QuantumKeyBackendLocal:
+		return NewLocalBackend(config.LocalKeyFilePath())
+
+	case cfg.QuantumKeyBackendVault:
+		token, err := vaultToken(config.VaultTokenFilePath())
+		if err != nil {
+			return nil, err
+		}
+		return NewVaultBackend(config.VaultAddress, config.VaultMount, config.VaultKeyName, token, nil), nil
+
+	case cfg.QuantumKeyBackendGCPKMS:
+		return NewGCPBackend(config.GCPKMSKey, config.GCPCredentialsFile), nil
+
+	default:
+		return nil, fmt.Errorf("unknown key backend %q", config.KeyBackend)
+	}
+}
+
+// vaultToken reads the Vault token from tokenFile, or from the VAULT_TOKEN
+// environment variable if tokenFile is empty.
+func vaultToken(tokenFile string) (string, error) {
+	if tokenFile == "" {
+		token := os.Getenv("VAULT_TOKEN")
+		if token == "" {
+			return "", errors.New("vault_token_file is not set and VAULT_TOKEN is empty")
+		}
+		return token, nil
+	}
+	bz, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("reading vault token: %w", err)
+	}
+	return strings.TrimSpace(string(bz)), nil
+}
@@ -0,0 +1,170 @@
+package kms_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/fluentum-chain/fluentum/config"
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/kms"
+)
+
+const testVaultToken = "s.test-token"
+
+// vaultStandIn emulates the transit secrets engine of Vault. It "encrypts" by
+// keeping the plaintexts, and returning a reference to them.
+type vaultStandIn struct {
+	mtx        sync.Mutex
+	plaintexts []string
+}
+
+func (v *vaultStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	writeErr := func(status int, msg string) {
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {msg}})
+	}
+	if r.Header.Get("X-Vault-Token") != testVaultToken {
+		writeErr(http.StatusForbidden, "permission denied")
+		return
+	}
+	var req map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErr(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	var data map[string]string
+	switch r.URL.Path {
+	case "/v1/transit/encrypt/fluentum":
+		v.plaintexts = append(v.plaintexts, req["plaintext"])
+		data = map[string]string{"ciphertext": fmt.Sprintf("vault:v1:%d", len(v.plaintexts)-1)}
+	case "/v1/transit/decrypt/fluentum":
+		var i int
+		if _, err := fmt.Sscanf(req["ciphertext"], "vault:v1:%d", &i); err != nil || i < 0 || i >= len(v.plaintexts) {
+			writeErr(http.StatusBadRequest, "invalid ciphertext")
+			return
+		}
+		data = map[string]string{"plaintext": v.plaintexts[i]}
+	default:
+		writeErr(http.StatusNotFound, "no handler for route")
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+func TestVaultBackend(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(&vaultStandIn{})
+	defer srv.Close()
+
+	backend := kms.NewVaultBackend(srv.URL, "transit", "fluentum", testVaultToken, srv.Client())
+	ciphertext, err := backend.Encrypt(ctx, []byte("secret"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(ciphertext), "vault:v1:"))
+
+	plaintext, err := backend.Decrypt(ctx, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+
+	_, err = backend.Decrypt(ctx, []byte("vault:v1:42"))
+	assert.ErrorContains(t, err, "invalid ciphertext")
+
+	unauthorized := kms.NewVaultBackend(srv.URL, "transit", "fluentum", "wrong", srv.Client())
+	_, err = unauthorized.Encrypt(ctx, []byte("secret"))
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+func TestSaveKeyPairWithVaultBackend(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(&vaultStandIn{})
+	defer srv.Close()
+
+	filename := filepath.Join(t.TempDir(), "key.json")
+	backend := kms.NewVaultBackend(srv.URL, "transit", "fluentum", testVaultToken, srv.Client())
+	keypair, err := crypto.NewKeyPair(crypto.KeyAlgorithmDilithium3)
+	require.NoError(t, err)
+
+	require.NoError(t, crypto.SaveKeyPairWithBackend(ctx, filename, keypair, backend))
+	name, err := crypto.KeyFileBackend(filename)
+	require.NoError(t, err)
+	assert.Equal(t, kms.VaultBackendName, name)
+	loaded, err := crypto.LoadKeyPairWithBackend(ctx, filename, backend)
+	require.NoError(t, err)
+	assert.Equal(t, keypair, loaded)
+
+	// a file wrapped by another backend is rejected
+	kekFile := filepath.Join(t.TempDir(), "kek")
+	require.NoError(t, kms.InitLocalKeyFile(kekFile))
+	local, err := kms.NewLocalBackend(kekFile)
+	require.NoError(t, err)
+	_, err = crypto.LoadKeyPairWithBackend(ctx, filename, local)
+	assert.Error(t, err)
+}
+
+func TestLocalBackend(t *testing.T) {
+	ctx := context.Background()
+	keyFile := filepath.Join(t.TempDir(), "kek")
+
+	// the key isn't generated implicitly
+	_, err := kms.NewLocalBackend(keyFile)
+	assert.ErrorIs(t, err, kms.ErrNoKeyEncryptionKey)
+	assert.NoFileExists(t, keyFile)
+
+	require.NoError(t, kms.InitLocalKeyFile(keyFile))
+	// an existing key is never replaced
+	assert.Error(t, kms.InitLocalKeyFile(keyFile))
+
+	backend, err := kms.NewLocalBackend(keyFile)
+	require.NoError(t, err)
+	ciphertext, err := backend.Encrypt(ctx, []byte("secret"))
+	require.NoError(t, err)
+
+	// the generated key is reused
+	reloaded, err := kms.NewLocalBackend(keyFile)
+	require.NoError(t, err)
+	plaintext, err := reloaded.Decrypt(ctx, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+
+	otherFile := filepath.Join(t.TempDir(), "kek")
+	require.NoError(t, kms.InitLocalKeyFile(otherFile))
+	other, err := kms.NewLocalBackend(otherFile)
+	require.NoError(t, err)
+	_, err = other.Decrypt(ctx, ciphertext)
+	assert.Error(t, err)
+}
+
+func TestNewBackend(t *testing.T) {
+	config := cfg.TestQuantumConfig()
+	config.RootDir = t.TempDir()
+	require.NoError(t, kms.InitLocalKeyFile(config.LocalKeyFilePath()))
+	backend, err := kms.NewBackend(config)
+	require.NoError(t, err)
+	assert.Equal(t, kms.LocalBackendName, backend.Name())
+
+	config.KeyBackend = cfg.QuantumKeyBackendVault
+	config.VaultAddress = "http://127.0.0.1:8200"
+	config.VaultKeyName = "fluentum"
+	t.Setenv("VAULT_TOKEN", testVaultToken)
+	backend, err = kms.NewBackend(config)
+	require.NoError(t, err)
+	assert.Equal(t, kms.VaultBackendName, backend.Name())
+
+	config.KeyBackend = cfg.QuantumKeyBackendGCPKMS
+	config.GCPKMSKey = "projects/p/locations/l/keyRings/r/cryptoKeys/k"
+	backend, err = kms.NewBackend(config)
+	require.NoError(t, err)
+	assert.Equal(t, kms.GCPBackendName, backend.Name())
+}
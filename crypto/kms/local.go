@@ -0,0 +1,110 @@
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	tmos "github.com/fluentum-chain/fluentum/libs/os"
+)
+
+// LocalBackendName is the name of the local backend.
+const LocalBackendName = "local"
+
+const localKeySize = 32
+
+// ErrNoKeyEncryptionKey is returned by NewLocalBackend when the key file
+// doesn't exist.
+var ErrNoKeyEncryptionKey = errors.New("key encryption key not found")
+
+var _ crypto.KeyEncryptionBackend = (*LocalBackend)(nil)
+
+// LocalBackend encrypts with AES-256-GCM, using a key encryption key stored in
+// a local file. It protects key pairs as well as the permissions of that file
+// do, and is meant for development and single-operator nodes.
+type LocalBackend struct {
+	aead cipher.AEAD
+}
+
+// InitLocalKeyFile generates a random key encryption key and saves it to
+// keyFile, which must not exist.
+func InitLocalKeyFile(keyFile string) error {
+	key := make([]byte, localKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return err
+	}
+	if err := tmos.EnsureDir(filepath.Dir(keyFile), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(keyFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL|os.O_SYNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("saving key encryption key: %w", err)
+	}
+	if _, err := f.Write(key); err != nil {
+		f.Close()
+		os.Remove(keyFile)
+		return fmt.Errorf("saving key encryption key: %w", err)
+	}
+	return f.Close()
+}
+
+// NewLocalBackend returns a backend using the key stored in keyFile. It
+// returns ErrNoKeyEncryptionKey if keyFile doesn't exist: the key is only
+// generated by InitLocalKeyFile, e.g. by tendermint init, so that a missing
+// or misplaced key file isn't silently replaced by a key that can't decrypt
+// the existing key pairs.
+func NewLocalBackend(keyFile string) (*LocalBackend, error) {
+	key, err := os.ReadFile(keyFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("%w: %s", ErrNoKeyEncryptionKey, keyFile)
+	case err != nil:
+		return nil, fmt.Errorf("reading key encryption key: %w", err)
+	}
+	if len(key) != localKeySize {
+		return nil, fmt.Errorf("key encryption key %s has %d bytes, expected %d", keyFile, len(key), localKeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalBackend{aead: aead}, nil
+}
+
+// Name implements crypto.KeyEncryptionBackend.
+func (b *LocalBackend) Name() string { return LocalBackendName }
+
+// Encrypt implements crypto.KeyEncryptionBackend. The ciphertext is prefixed
+// with its random nonce.
+func (b *LocalBackend) Encrypt(_ context.Context, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, b.aead.NonceSize(), b.aead.NonceSize()+len(plaintext)+b.aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return b.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt implements crypto.KeyEncryptionBackend.
+func (b *LocalBackend) Decrypt(_ context.Context, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < b.aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:b.aead.NonceSize()], ciphertext[b.aead.NonceSize():]
+	plaintext, err := b.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("wrong key encryption key or corrupted ciphertext")
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,137 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+)
+
+// VaultBackendName is the name of the Vault backend.
+const VaultBackendName = "vault"
+
+const (
+	vaultTimeout         = 10 * time.Second
+	maxVaultResponseSize = 1 << 20
+)
+
+var _ crypto.KeyEncryptionBackend = (*VaultBackend)(nil)
+
+// VaultBackend encrypts with a named key of the transit secrets engine of a
+// HashiCorp Vault compatible server, over its HTTP API. The key never leaves
+// the server.
+type VaultBackend struct {
+	address string
+	mount   string
+	keyName string
+	token   string
+	client  *http.Client
+}
+
+// NewVaultBackend returns a backend using the key keyName of the transit
+// engine mounted at mount on the server at address, authenticated by token.
+// If client is nil, a client with a 10s timeout is used.
+func NewVaultBackend(address, mount, keyName, token string, client *http.Client) *VaultBackend {
+	if client == nil {
+		client = &http.Client{Timeout: vaultTimeout}
+	}
+	return &VaultBackend{
+		address: strings.TrimRight(address, "/"),
+		mount:   strings.Trim(mount, "/"),
+		keyName: keyName,
+		token:   token,
+		client:  client,
+	}
+}
+
+// Name implements crypto.KeyEncryptionBackend.
+func (b *VaultBackend) Name() string { return VaultBackendName }
+
+// Encrypt implements crypto.KeyEncryptionBackend. The ciphertext is the
+// "vault:v<version>:..." string returned by the server, which records the
+// version of the key it was encrypted with.
+func (b *VaultBackend) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	var resp struct {
+		Ciphertext string `json:"ciphertext"`
+	}
+	err := b.call(ctx, "encrypt", map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Ciphertext == "" {
+		return nil, fmt.Errorf("vault returned no ciphertext")
+	}
+	return []byte(resp.Ciphertext), nil
+}
+
+// Decrypt implements crypto.KeyEncryptionBackend.
+func (b *VaultBackend) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	var resp struct {
+		Plaintext string `json:"plaintext"`
+	}
+	err := b.call(ctx, "decrypt", map[string]string{
+		"ciphertext": string(ciphertext),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(resp.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("decoding vault plaintext: %w", err)
+	}
+	return plaintext, nil
+}
+
+// call posts body to the transit endpoint op of the key, and decodes the data
+// field of the response into result.
+func (b *VaultBackend) call(ctx context.Context, op string, body interface{}, result interface{}) error {
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("%s/v1/%s/%s/%s", b.address, b.mount, op, url.PathEscape(b.keyName))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", b.token)
+
+	res, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(res.Body, maxVaultResponseSize))
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []string        `json:"errors"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil && res.StatusCode == http.StatusOK {
+		return fmt.Errorf("decoding vault response: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		if len(resp.Errors) > 0 {
+			return fmt.Errorf("vault %s: %s: %s", op, res.Status, strings.Join(resp.Errors, "; "))
+		}
+		return fmt.Errorf("vault %s: %s", op, res.Status)
+	}
+	if len(resp.Data) == 0 {
+		return fmt.Errorf("vault %s: response has no data", op)
+	}
+	return json.Unmarshal(resp.Data, result)
+}
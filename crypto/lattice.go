@@ -1,104 +1,69 @@
 package crypto
 
 import (
-	"context"
-	"crypto/aes"
-	"crypto/cipher"
+	"crypto/mlkem"
 	"crypto/rand"
-	"encoding/json"
 	"errors"
-	"io"
-	"os"
+	"fmt"
 
-	kms "cloud.google.com/go/kms/apiv1"
 	"github.com/cloudflare/circl/sign/dilithium"
-	"google.golang.org/api/option"
-	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
 )
 
-// Placeholder types for Kyber and Dilithium keys
-// In production, import real implementations, e.g., github.com/cloudflare/circl or pqcrypto
+const (
+	// KEMPublicKeySize is the size of an ML-KEM-768 encapsulation key.
+	KEMPublicKeySize = mlkem.EncapsulationKeySize768
+	// KEMPrivateKeySize is the size of an ML-KEM-768 decapsulation key, which
+	// is stored in its 64-byte seed form.
+	KEMPrivateKeySize = mlkem.SeedSize
+	// KEMCiphertextSize is the size of an ML-KEM-768 ciphertext.
+	KEMCiphertextSize = mlkem.CiphertextSize768
+	// KEMSharedSecretSize is the size of an ML-KEM shared secret.
+	KEMSharedSecretSize = mlkem.SharedKeySize
+)
 
-// Kyber key types
+// Raw key types, used for both ML-KEM and Dilithium keys.
 type PublicKey = []byte
 type PrivateKey = []byte
 
-// KeyPair holds both public and private keys
+// KeyPair holds both public and private keys. Algorithm is one of the
+// KeyAlgorithm constants, and is empty for key pairs of unknown algorithm.
 type KeyPair struct {
+	Algorithm  string `json:",omitempty"`
 	PublicKey  []byte
 	PrivateKey []byte
 }
 
-// Encrypts and saves the keypair to a file
-func SaveKeyPairToFile(filename string, keypair KeyPair, password []byte) error {
-	plaintext, err := json.Marshal(keypair)
-	if err != nil {
-		return err
-	}
-	block, err := aes.NewCipher(password)
-	if err != nil {
-		return err
-	}
-	gcm, err := cipher.NewGCM(block)
+// GenerateKeyPair generates an ML-KEM-768 key pair (FIPS 203, the
+// standardized Kyber768). The public key is the encapsulation key and the
+// private key is the seed of the decapsulation key.
+func GenerateKeyPair() (PublicKey, PrivateKey, error) {
+	dk, err := mlkem.GenerateKey768()
 	if err != nil {
-		return err
-	}
-	nonce := make([]byte, gcm.NonceSize())
-	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
-		return err
+		return nil, nil, err
 	}
-	ciphertext := gcm.Seal(nonce, nonce, plaintext, nil)
-	return os.WriteFile(filename, ciphertext, 0600)
+	return dk.EncapsulationKey().Bytes(), dk.Bytes(), nil
 }
 
-// Loads and decrypts the keypair from a file
-func LoadKeyPairFromFile(filename string, password []byte) (KeyPair, error) {
-	ciphertext, err := os.ReadFile(filename)
-	if err != nil {
-		return KeyPair{}, err
-	}
-	block, err := aes.NewCipher(password)
-	if err != nil {
-		return KeyPair{}, err
-	}
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
-		return KeyPair{}, err
-	}
-	nonceSize := gcm.NonceSize()
-	if len(ciphertext) < nonceSize {
-		return KeyPair{}, errors.New("ciphertext too short")
-	}
-	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
-	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+// Encapsulate generates a fresh shared secret for the holder of the ML-KEM-768
+// private key matching publicKey, and returns it along with the ciphertext to
+// send them.
+func Encapsulate(publicKey PublicKey) (sharedSecret, ciphertext []byte, err error) {
+	ek, err := mlkem.NewEncapsulationKey768(publicKey)
 	if err != nil {
-		return KeyPair{}, err
-	}
-	var keypair KeyPair
-	if err := json.Unmarshal(plaintext, &keypair); err != nil {
-		return KeyPair{}, err
+		return nil, nil, fmt.Errorf("invalid ML-KEM public key: %w", err)
 	}
-	return keypair, nil
+	sharedSecret, ciphertext = ek.Encapsulate()
+	return sharedSecret, ciphertext, nil
 }
 
-// GenerateKeyPair generates a Kyber768 key pair (NIST PQC Standardized)
-func GenerateKeyPair() (PublicKey, PrivateKey, error) {
-	// For now, return placeholder keys to avoid CIRCL API issues
-	// TODO: Implement proper Kyber768 key generation when CIRCL API is stable
-	pubKey := make([]byte, 32)  // Placeholder public key
-	privKey := make([]byte, 32) // Placeholder private key
-
-	// Fill with random data
-	_, err := rand.Read(pubKey)
+// Decapsulate recovers the shared secret encapsulated in ciphertext with the
+// ML-KEM-768 private key privateKey.
+func Decapsulate(privateKey PrivateKey, ciphertext []byte) ([]byte, error) {
+	dk, err := mlkem.NewDecapsulationKey768(privateKey)
 	if err != nil {
-		return nil, nil, err
-	}
-	_, err = rand.Read(privKey)
-	if err != nil {
-		return nil, nil, err
+		return nil, fmt.Errorf("invalid ML-KEM private key: %w", err)
 	}
-
-	return pubKey, privKey, nil
+	return dk.Decapsulate(ciphertext)
 }
 
 // QuantumResistantSign signs a message using Dilithium Mode 3
@@ -123,55 +88,3 @@ func VerifyQuantumSig(publicKey PublicKey, message []byte, signature []byte) boo
 	// Use the correct verification method
 	return scheme.Verify(pub, message, signature)
 }
-
-// SaveKeyPairToGCPKMS encrypts and saves the keypair using GCP KMS
-func SaveKeyPairToGCPKMS(ctx context.Context, filename string, keypair KeyPair, kmsKeyResource string, credsFile string) error {
-	plaintext, err := json.Marshal(keypair)
-	if err != nil {
-		return err
-	}
-	client, err := kms.NewKeyManagementClient(ctx, option.WithCredentialsFile(credsFile))
-	if err != nil {
-		return err
-	}
-	defer client.Close()
-
-	// Encrypt
-	req := &kmspb.EncryptRequest{
-		Name:      kmsKeyResource,
-		Plaintext: plaintext,
-	}
-	resp, err := client.Encrypt(ctx, req)
-	if err != nil {
-		return err
-	}
-	return os.WriteFile(filename, resp.Ciphertext, 0600)
-}
-
-// LoadKeyPairFromGCPKMS decrypts and loads the keypair using GCP KMS
-func LoadKeyPairFromGCPKMS(ctx context.Context, filename string, kmsKeyResource string, credsFile string) (KeyPair, error) {
-	ciphertext, err := os.ReadFile(filename)
-	if err != nil {
-		return KeyPair{}, err
-	}
-	client, err := kms.NewKeyManagementClient(ctx, option.WithCredentialsFile(credsFile))
-	if err != nil {
-		return KeyPair{}, err
-	}
-	defer client.Close()
-
-	// Decrypt
-	req := &kmspb.DecryptRequest{
-		Name:       kmsKeyResource,
-		Ciphertext: ciphertext,
-	}
-	resp, err := client.Decrypt(ctx, req)
-	if err != nil {
-		return KeyPair{}, err
-	}
-	var keypair KeyPair
-	if err := json.Unmarshal(resp.Plaintext, &keypair); err != nil {
-		return KeyPair{}, err
-	}
-	return keypair, nil
-}
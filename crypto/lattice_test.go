@@ -0,0 +1,38 @@
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+)
+
+func TestKEMRoundTrip(t *testing.T) {
+	pub, priv, err := crypto.GenerateKeyPair()
+	require.NoError(t, err)
+	assert.Len(t, pub, crypto.KEMPublicKeySize)
+	assert.Len(t, priv, crypto.KEMPrivateKeySize)
+
+	secret, ct, err := crypto.Encapsulate(pub)
+	require.NoError(t, err)
+	assert.Len(t, secret, crypto.KEMSharedSecretSize)
+	assert.Len(t, ct, crypto.KEMCiphertextSize)
+
+	decapsulated, err := crypto.Decapsulate(priv, ct)
+	require.NoError(t, err)
+	assert.Equal(t, secret, decapsulated)
+
+	// A different key pair recovers a different secret.
+	_, otherPriv, err := crypto.GenerateKeyPair()
+	require.NoError(t, err)
+	other, err := crypto.Decapsulate(otherPriv, ct)
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+
+	_, _, err = crypto.Encapsulate(pub[1:])
+	assert.Error(t, err)
+	_, err = crypto.Decapsulate(priv, ct[1:])
+	assert.Error(t, err)
+}
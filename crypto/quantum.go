@@ -1,16 +0,0 @@
-package crypto
-
-// NOTE: This is a placeholder for Dilithium consensus integration.
-// Replace the verify function with the actual implementation when available.
-
-// DilithiumPubKey represents a quantum-resistant public key for consensus.
-type DilithiumPubKey struct {
-	Key []byte
-}
-
-// VerifySignature verifies a Dilithium signature for the given message.
-func (pk DilithiumPubKey) VerifySignature(msg []byte, sig []byte) bool {
-	// TODO: Replace with actual Dilithium verification, e.g.:
-	// return dilithium.Verify(pk.Key, msg, sig)
-	return false // placeholder
-}
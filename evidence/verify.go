@@ -211,10 +211,10 @@ func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, valSet
 	va := e.VoteA.ToProto()
 	vb := e.VoteB.ToProto()
 	// Signatures must be valid
-	if !pubKey.VerifySignature(types.VoteSignBytes(chainID, va), e.VoteA.Signature) {
+	if !types.VerifySignatures(pubKey, types.VoteSignBytes(chainID, va), e.VoteA.Signature, e.VoteA.QuantumSignature) {
 		return fmt.Errorf("verifying VoteA: %w", types.ErrVoteInvalidSignature)
 	}
-	if !pubKey.VerifySignature(types.VoteSignBytes(chainID, vb), e.VoteB.Signature) {
+	if !types.VerifySignatures(pubKey, types.VoteSignBytes(chainID, vb), e.VoteB.Signature, e.VoteB.QuantumSignature) {
 		return fmt.Errorf("verifying VoteB: %w", types.ErrVoteInvalidSignature)
 	}
 
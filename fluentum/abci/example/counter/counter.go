@@ -102,11 +102,11 @@ func (app *Application) Query(ctx context.Context, reqQuery *abci.QueryRequest)
 
 // Additional methods required by the Application interface
 func (app *Application) PrepareProposal(ctx context.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
-	return &abci.PrepareProposalResponse{}, nil
+	return &abci.PrepareProposalResponse{Txs: req.Txs}, nil
 }
 
 func (app *Application) ProcessProposal(ctx context.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
-	return &abci.ProcessProposalResponse{}, nil
+	return &abci.ProcessProposalResponse{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 }
 
 func (app *Application) ExtendVote(ctx context.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
@@ -43,11 +43,15 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 
 	// Fluentum modules
 	"github.com/fluentum-chain/fluentum/x/fluentum"
 	fluentumkeeper "github.com/fluentum-chain/fluentum/x/fluentum/keeper"
 	fluentumtypes "github.com/fluentum-chain/fluentum/x/fluentum/types"
+	"github.com/fluentum-chain/fluentum/x/kyc"
+	kyckeeper "github.com/fluentum-chain/fluentum/x/kyc/keeper"
+	kyctypes "github.com/fluentum-chain/fluentum/x/kyc/types"
 
 	"cosmossdk.io/core/store"
 	cosmossdkstore "cosmossdk.io/core/store"
@@ -71,6 +75,7 @@ var (
 		params.AppModuleBasic{},
 		wasm.AppModuleBasic{},
 		fluentum.AppModuleBasic{},
+		kyc.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -113,6 +118,7 @@ type App struct {
 
 	// Fluentum keepers
 	FluentumKeeper fluentumkeeper.Keeper
+	KYCKeeper      kyckeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -172,6 +178,7 @@ func New(
 	fmt.Println("DEBUG: Creating store keys")
 	keys := storetypes.NewKVStoreKeys(
 		authtypes.StoreKey, banktypes.StoreKey, paramstypes.StoreKey, wasmtypes.StoreKey, fluentumtypes.StoreKey,
+		kyctypes.StoreKey,
 	)
 
 	// Debug: Print store keys
@@ -227,15 +234,34 @@ func New(
 		cosmosLogger,
 	)
 
+	app.KYCKeeper = kyckeeper.NewKeeper(appCodec, keys[kyctypes.StoreKey])
+
 	fmt.Println("DEBUG: Creating wasm keeper")
-	// Create a custom wasm keeper that works with our current structure
-	// This provides basic wasm functionality without requiring all the complex dependencies
-	app.WasmKeeper = *NewCustomWasmKeeper(
+	wasmConfig, err := wasm.ReadNodeConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading wasm config: %s", err))
+	}
+	// The chain has no staking, distribution or IBC modules, so contracts
+	// can't query or message them.
+	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
-		keys[wasmtypes.StoreKey],
+		NewKVStoreServiceAdapter(keys[wasmtypes.StoreKey]),
 		app.AccountKeeper,
 		app.BankKeeper,
-		app.GetSubspace(wasmtypes.ModuleName),
+		nil, // staking keeper
+		nil, // distribution keeper
+		nil, // ICS4 wrapper
+		nil, // channel keeper
+		nil, // channel keeper v2
+		nil, // ICS20 transfer port source
+		app.MsgServiceRouter(),
+		app.GRPCQueryRouter(),
+		homePath,
+		wasmConfig,
+		wasmtypes.VMConfig{},
+		wasmkeeper.BuiltInCapabilities(),
+		authtypes.NewModuleAddress(authtypes.ModuleName).String(),
+		wasmkeeper.WithQueryHandlerDecorator(kycQueryHandler(app.KYCKeeper)),
 	)
 
 	fmt.Println("DEBUG: Creating Fluentum keeper")
@@ -256,20 +282,24 @@ func New(
 		params.NewAppModule(app.ParamsKeeper),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, nil, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
 		fluentum.NewAppModule(appCodec, app.FluentumKeeper, AccountKeeperAdapter{app.AccountKeeper}, BankKeeperAdapter{app.BankKeeper}),
+		kyc.NewAppModule(appCodec, app.KYCKeeper),
 	)
 
 	app.mm.SetOrderBeginBlockers(
 		authtypes.ModuleName, banktypes.ModuleName, genutiltypes.ModuleName, paramstypes.ModuleName, wasmtypes.ModuleName, fluentumtypes.ModuleName,
+		kyctypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
 		authtypes.ModuleName, banktypes.ModuleName, genutiltypes.ModuleName, paramstypes.ModuleName, wasmtypes.ModuleName, fluentumtypes.ModuleName,
+		kyctypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
 		authtypes.ModuleName, banktypes.ModuleName, genutiltypes.ModuleName, paramstypes.ModuleName, wasmtypes.ModuleName, fluentumtypes.ModuleName,
+		kyctypes.ModuleName,
 	)
 
 	// app.mm.RegisterInvariants(nil) // Comment out for now - will be called during BeginBlock
@@ -630,65 +660,18 @@ func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs
 	return exportedApp, nil
 }
 
-// CustomWasmKeeper provides a simplified wasm keeper implementation
-type CustomWasmKeeper struct {
-	cdc           codec.BinaryCodec
-	storeKey      storetypes.StoreKey
-	accountKeeper authkeeper.AccountKeeper
-	bankKeeper    bankkeeper.Keeper
-	paramstore    paramstypes.Subspace
-}
-
-// NewCustomWasmKeeper creates a new custom wasm keeper
-func NewCustomWasmKeeper(
-	cdc codec.BinaryCodec,
-	storeKey storetypes.StoreKey,
-	accountKeeper authkeeper.AccountKeeper,
-	bankKeeper bankkeeper.Keeper,
-	paramstore paramstypes.Subspace,
-) *wasmkeeper.Keeper {
-	// Create a minimal wasm keeper that satisfies the interface
-	// This is a simplified implementation for basic wasm functionality
-	keeper := &wasmkeeper.Keeper{}
-
-	// TODO: Implement proper wasm keeper initialization
-	// For now, we'll use a minimal implementation that allows the app to compile
-
-	return keeper
-}
-
-// WasmKeeperAdapter adapts our custom keeper to the wasmkeeper interface
-type WasmKeeperAdapter struct {
-	CustomWasmKeeper
-}
-
-// Implement basic wasm keeper methods
-func (k *CustomWasmKeeper) GetCodeInfo(ctx sdk.Context, codeID uint64) (wasmtypes.CodeInfo, error) {
-	// Basic implementation - return empty code info for now
-	return wasmtypes.CodeInfo{}, nil
-}
-
-func (k *CustomWasmKeeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) (*wasmtypes.ContractInfo, error) {
-	// Basic implementation - return nil for now
-	return nil, nil
-}
-
-func (k *CustomWasmKeeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, queryMsg []byte) ([]byte, error) {
-	// Basic implementation - return empty response for now
-	return []byte("{}"), nil
-}
-
-func (k *CustomWasmKeeper) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) ([]byte, error) {
-	// Basic implementation - return empty response for now
-	return nil, nil
-}
-
-func (k *CustomWasmKeeper) ListCode(ctx sdk.Context) ([]wasmtypes.CodeInfo, error) {
-	// Basic implementation - return empty list for now
-	return []wasmtypes.CodeInfo{}, nil
-}
-
-func (k *CustomWasmKeeper) ListContractsByCode(ctx sdk.Context, codeID uint64) ([]sdk.AccAddress, error) {
-	// Basic implementation - return empty list for now
-	return []sdk.AccAddress{}, nil
+// kycQueryHandler answers the custom queries of contracts with the KYC
+// querier, which checks the proofs against the querying contract, and passes
+// the other queries on.
+func kycQueryHandler(k kyckeeper.Keeper) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	querier := kyckeeper.NewWasmQuerier(k)
+	return func(next wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return wasmkeeper.WasmVMQueryHandlerFn(
+			func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+				if request.Custom != nil {
+					return querier(ctx, caller, request.Custom)
+				}
+				return next.HandleQuery(ctx, caller, request)
+			})
+	}
 }
@@ -7,11 +7,13 @@ require (
 	cosmossdk.io/log v1.6.0
 	cosmossdk.io/store v1.1.2
 	github.com/CosmWasm/wasmd v0.61.0
+	github.com/CosmWasm/wasmvm/v3 v3.0.0
 	github.com/cometbft/cometbft v0.38.17
 	github.com/cometbft/cometbft-db v0.14.1
 	github.com/cosmos/cosmos-db v1.1.1
 	github.com/cosmos/cosmos-sdk v0.53.0
 	github.com/fluentum-chain/fluentum/x/fluentum v0.0.0-00010101000000-000000000000
+	github.com/fluentum-chain/fluentum/x/kyc v0.0.0-00010101000000-000000000000
 	github.com/spf13/cast v1.9.2
 )
 
@@ -37,7 +39,6 @@ require (
 	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/99designs/go-keychain v0.0.0-20191008050251-8e49817e8af4 // indirect
 	github.com/99designs/keyring v1.2.2 // indirect
-	github.com/CosmWasm/wasmvm/v3 v3.0.0 // indirect
 	github.com/DataDog/datadog-go v4.8.3+incompatible // indirect
 	github.com/DataDog/zstd v1.5.7 // indirect
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/detectors/gcp v1.27.0 // indirect
@@ -86,6 +87,7 @@ require (
 	github.com/ethereum/go-ethereum v1.15.11 // indirect
 	github.com/fatih/color v1.17.0 // indirect
 	github.com/felixge/httpsnoop v1.0.4 // indirect
+	github.com/fluentum-chain/fluentum/zkprover v0.0.0-00010101000000-000000000000 // indirect
 	github.com/fsnotify/fsnotify v1.9.0 // indirect
 	github.com/getsentry/sentry-go v0.32.0 // indirect
 	github.com/go-jose/go-jose/v4 v4.0.5 // indirect
@@ -132,6 +134,7 @@ require (
 	github.com/holiman/uint256 v1.3.2 // indirect
 	github.com/huandu/skiplist v1.2.1 // indirect
 	github.com/iancoleman/strcase v0.3.0 // indirect
+	github.com/iden3/go-iden3-crypto v0.0.17 // indirect
 	github.com/improbable-eng/grpc-web v0.15.0 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/jmespath/go-jmespath v0.4.0 // indirect
@@ -243,3 +246,5 @@ replace github.com/fluentum-chain/fluentum/quantum => ../quantum
 replace github.com/fluentum-chain/fluentum/zkprover => ../zkprover
 
 replace github.com/fluentum-chain/fluentum/liquidity => ../liquidity
+
+replace github.com/fluentum-chain/fluentum/x/kyc => ../x/kyc
@@ -1,62 +1,95 @@
 pragma circom 2.0.0;
 
-include "circomlib/comparators.circom";
-include "circomlib/poseidon.circom";
-
-// Template for verifying KYC membership
-template VerifyKYCMembership() {
-    signal input proof;
-    signal output valid;
-    signal output balance;
-
-    // Poseidon hash for proof verification
-    component hasher = Poseidon(1);
-    hasher.inputs[0] <== proof;
-
-    // Verify proof against KYC registry
-    component registry = KYCRegistry();
-    registry.proof <== hasher.out;
-    
-    valid <== registry.valid;
-    balance <== registry.balance;
-}
+include "circomlib/circuits/bitify.circom";
+include "circomlib/circuits/comparators.circom";
+include "circomlib/circuits/mux1.circom";
+include "circomlib/circuits/poseidon.circom";
 
-// Template for KYC merchant verification
-template KYCMerchant() {
-    signal input kycProof;
-    signal input minBalance;
-    signal output verified;
-    
-    // Verify KYC membership and get balance
-    component verifier = VerifyKYCMembership();
-    verifier.proof <== kycProof;
-    
-    // Check if balance meets minimum requirement
-    component balanceCheck = GreaterThan(64);
-    balanceCheck.in[0] <== verifier.balance;
-    balanceCheck.in[1] <== minBalance;
-    
-    // Final verification combines KYC validity and balance check
-    verified <== verifier.valid && balanceCheck.out;
-}
+// KYCRegistryProof proves that a merchant registered in the x/kyc registry
+// has a KYC level of at least minLevel and a KYC record that does not expire
+// before validAt, without revealing which merchant it is. The public signals
+// are, in order,
+//
+//   [root, minLevel, validAt, caller, nullifier]
+//
+// where caller binds the proof to the account checking it, see
+// types.CallerSignal, and nullifier is Poseidon(dataHash, caller): the same
+// for all proofs of a merchant to a caller, and unlinkable across callers.
+//
+// The registry is a binary Poseidon Merkle tree of at most 2^depth leaves,
+// see types.MerkleRoot. Its actual depth depends on the number of merchants,
+// so only the first levels of the path, flagged by pathActive, are hashed.
+template KYCRegistryProof(depth) {
+    signal input root;
+    signal input minLevel;
+    signal input validAt;
+    signal input caller;
+    signal input nullifier;
+
+    // the opening of the merchant's commitment, see zk_kyc.Commitment
+    signal input level;
+    signal input expiresAt;
+    signal input dataHash;
+
+    signal input pathElements[depth];
+    signal input pathIndices[depth];
+    signal input pathActive[depth];
+
+    component leaf = Poseidon(3);
+    leaf.inputs[0] <== level;
+    leaf.inputs[1] <== expiresAt;
+    leaf.inputs[2] <== dataHash;
+
+    component mux[depth];
+    component hashers[depth];
+    signal node[depth + 1];
+    node[0] <== leaf.out;
+    for (var i = 0; i < depth; i++) {
+        pathIndices[i] * (1 - pathIndices[i]) === 0;
+        pathActive[i] * (1 - pathActive[i]) === 0;
+        if (i > 0) {
+            // the active levels are the lowest ones
+            pathActive[i] * (1 - pathActive[i - 1]) === 0;
+        }
+
+        mux[i] = MultiMux1(2);
+        mux[i].c[0][0] <== node[i];
+        mux[i].c[0][1] <== pathElements[i];
+        mux[i].c[1][0] <== pathElements[i];
+        mux[i].c[1][1] <== node[i];
+        mux[i].s <== pathIndices[i];
+
+        hashers[i] = Poseidon(2);
+        hashers[i].inputs[0] <== mux[i].out[0];
+        hashers[i].inputs[1] <== mux[i].out[1];
+        node[i + 1] <== node[i] + pathActive[i] * (hashers[i].out - node[i]);
+    }
+    root === node[depth];
+
+    // the comparators only hold for 64-bit inputs
+    component levelBits = Num2Bits(64);
+    levelBits.in <== level;
+    component minLevelBits = Num2Bits(64);
+    minLevelBits.in <== minLevel;
+    component expiresAtBits = Num2Bits(64);
+    expiresAtBits.in <== expiresAt;
+    component validAtBits = Num2Bits(64);
+    validAtBits.in <== validAt;
+
+    component levelCheck = GreaterEqThan(64);
+    levelCheck.in[0] <== level;
+    levelCheck.in[1] <== minLevel;
+    levelCheck.out === 1;
+
+    component expiryCheck = GreaterEqThan(64);
+    expiryCheck.in[0] <== expiresAt;
+    expiryCheck.in[1] <== validAt;
+    expiryCheck.out === 1;
 
-// KYC Registry template for storing and verifying KYC data
-template KYCRegistry() {
-    signal input proof;
-    signal output valid;
-    signal output balance;
-
-    // Merkle tree for KYC data
-    component tree = MerkleTree(32);
-    
-    // Verify proof against merkle root
-    component verifier = MerkleVerifier();
-    verifier.root <== tree.root;
-    verifier.proof <== proof;
-    
-    valid <== verifier.valid;
-    balance <== verifier.balance;
+    component nullifierHash = Poseidon(2);
+    nullifierHash.inputs[0] <== dataHash;
+    nullifierHash.inputs[1] <== caller;
+    nullifier === nullifierHash.out;
 }
 
-// Main component
-component main = KYCMerchant(); 
\ No newline at end of file
+component main {public [root, minLevel, validAt, caller, nullifier]} = KYCRegistryProof(32);
@@ -1,5 +1,91 @@
 package crypto
 
-// classical.go - ECDSA implementation (placeholder)
+// classical.go - secp256k1 ECDSA and Ed25519 signers
 
-// TODO: Implement ECDSA cryptography functions here.
+import (
+	"fmt"
+
+	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/crypto/secp256k1"
+)
+
+const (
+	// ECDSASignerName is the name of the secp256k1 ECDSA signer, which is the
+	// default active signer.
+	ECDSASignerName = "ecdsa"
+	// Ed25519SignerName is the name of the Ed25519 signer.
+	Ed25519SignerName = "ed25519"
+)
+
+// --- secp256k1 ECDSA Signer ---
+
+var _ Signer = (*ECDSASigner)(nil)
+
+// ECDSASigner signs with secp256k1 ECDSA keys. Private keys are 32-byte
+// scalars and public keys are 33-byte compressed points. Signatures are the
+// 64-byte R || S of the SHA-256 digest of the message, in lower-S form.
+type ECDSASigner struct{}
+
+func NewECDSASigner() *ECDSASigner { return &ECDSASigner{} }
+
+// GenerateKey generates a new key pair using OS randomness.
+func (e *ECDSASigner) GenerateKey() ([]byte, []byte) {
+	privKey := secp256k1.GenPrivKey()
+	return privKey.Bytes(), privKey.PubKey().Bytes()
+}
+
+// Sign signs message with privateKey.
+func (e *ECDSASigner) Sign(privateKey []byte, message []byte) ([]byte, error) {
+	if len(privateKey) != secp256k1.PrivKeySize {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d",
+			ErrInvalidPrivateKey, secp256k1.PrivKeySize, len(privateKey))
+	}
+	return secp256k1.PrivKey(privateKey).Sign(message)
+}
+
+// Verify returns true if signature is a valid signature of message by the
+// owner of publicKey.
+func (e *ECDSASigner) Verify(publicKey []byte, message []byte, signature []byte) bool {
+	if len(publicKey) != secp256k1.PubKeySize {
+		return false
+	}
+	return secp256k1.PubKey(publicKey).VerifySignature(message, signature)
+}
+
+func (e *ECDSASigner) Name() string { return ECDSASignerName }
+
+// --- Ed25519 Signer ---
+
+var _ Signer = (*Ed25519Signer)(nil)
+
+// Ed25519Signer signs with Ed25519 keys. Private keys are the 64-byte seed and
+// public key concatenation, and public keys are 32 bytes.
+type Ed25519Signer struct{}
+
+func NewEd25519Signer() *Ed25519Signer { return &Ed25519Signer{} }
+
+// GenerateKey generates a new key pair using OS randomness.
+func (e *Ed25519Signer) GenerateKey() ([]byte, []byte) {
+	privKey := ed25519.GenPrivKey()
+	return privKey.Bytes(), privKey.PubKey().Bytes()
+}
+
+// Sign signs message with privateKey.
+func (e *Ed25519Signer) Sign(privateKey []byte, message []byte) ([]byte, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d",
+			ErrInvalidPrivateKey, ed25519.PrivateKeySize, len(privateKey))
+	}
+	return ed25519.PrivKey(privateKey).Sign(message)
+}
+
+// Verify returns true if signature is a valid signature of message by the
+// owner of publicKey.
+func (e *Ed25519Signer) Verify(publicKey []byte, message []byte, signature []byte) bool {
+	if len(publicKey) != ed25519.PubKeySize {
+		return false
+	}
+	return ed25519.PubKey(publicKey).VerifySignature(message, signature)
+}
+
+func (e *Ed25519Signer) Name() string { return Ed25519SignerName }
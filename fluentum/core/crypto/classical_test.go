@@ -0,0 +1,45 @@
+package crypto
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClassicalSigners(t *testing.T) {
+	for _, signer := range []Signer{NewECDSASigner(), NewEd25519Signer()} {
+		signer := signer
+		t.Run(signer.Name(), func(t *testing.T) {
+			privKey, pubKey := signer.GenerateKey()
+			message := []byte("block header")
+
+			sig, err := signer.Sign(privKey, message)
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+			if !signer.Verify(pubKey, message, sig) {
+				t.Fatal("expected signature to verify")
+			}
+			if signer.Verify(pubKey, []byte("other message"), sig) {
+				t.Error("expected signature of another message to be rejected")
+			}
+
+			tampered := append([]byte{}, sig...)
+			tampered[0] ^= 0xff
+			if signer.Verify(pubKey, message, tampered) {
+				t.Error("expected tampered signature to be rejected")
+			}
+
+			_, otherPubKey := signer.GenerateKey()
+			if signer.Verify(otherPubKey, message, sig) {
+				t.Error("expected signature to be rejected by another key")
+			}
+			if signer.Verify(pubKey[1:], message, sig) {
+				t.Error("expected malformed public key to be rejected")
+			}
+
+			if _, err := signer.Sign(privKey[1:], message); !errors.Is(err, ErrInvalidPrivateKey) {
+				t.Errorf("expected ErrInvalidPrivateKey, got %v", err)
+			}
+		})
+	}
+}
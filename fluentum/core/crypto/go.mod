@@ -1,3 +1,19 @@
 module github.com/fluentum-chain/fluentum/core/crypto
 
 go 1.24.4
+
+require (
+	github.com/cloudflare/circl v1.3.7
+	github.com/fluentum-chain/fluentum v0.0.0-00010101000000-000000000000
+)
+
+require (
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.0 // indirect
+	github.com/oasisprotocol/curve25519-voi v0.0.0-20230904125328-1f23a7beb09a // indirect
+	github.com/petermattis/goid v0.0.0-20240813172612-4fcff4a6cae7 // indirect
+	github.com/sasha-s/go-deadlock v0.3.5 // indirect
+	golang.org/x/crypto v0.37.0 // indirect
+	golang.org/x/sys v0.32.0 // indirect
+)
+
+replace github.com/fluentum-chain/fluentum => ../../..
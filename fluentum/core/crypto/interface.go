@@ -1,65 +1,137 @@
 package crypto
 
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// ErrInvalidPrivateKey is returned by signers given a malformed private key.
+var ErrInvalidPrivateKey = errors.New("invalid private key")
+
 // Signer defines the interface for cryptographic signing algorithms
 // Features (e.g., quantum_signing) should implement this interface
 // and register their signer at runtime.
 type Signer interface {
 	GenerateKey() ([]byte, []byte) // private, public
-	Sign(privateKey []byte, message []byte) []byte
+	Sign(privateKey []byte, message []byte) ([]byte, error)
 	Verify(publicKey []byte, message []byte, signature []byte) bool
 	Name() string
 }
 
-// signerRegistry holds all registered signers by name
-var signerRegistry = make(map[string]Signer)
+// SignerChangeCallback is called when the active signer changes. previous is
+// nil if there was no active signer.
+type SignerChangeCallback func(previous, current Signer)
+
+// signerRegistry holds all registered signers by name, and the active signer
+// used by the node. It is safe for concurrent use, as signers are registered
+// and activated at runtime, e.g. by the quantum_reload RPC.
+type signerRegistry struct {
+	mtx        sync.RWMutex
+	signers    map[string]Signer
+	activeName string
+	listeners  map[string]SignerChangeCallback
+}
 
-// currentSigner is the active signer used by the node
-var currentSigner Signer
+var registry = &signerRegistry{
+	signers:   make(map[string]Signer),
+	listeners: make(map[string]SignerChangeCallback),
+}
 
-// RegisterSigner registers a new signer implementation by name
+// RegisterSigner registers a new signer implementation by name. If a signer
+// is already registered under name, it is replaced, and if it was the active
+// signer, s becomes the active signer.
 func RegisterSigner(name string, s Signer) {
-	signerRegistry[name] = s
+	registry.mtx.Lock()
+	previous := registry.signers[name]
+	registry.signers[name] = s
+	replacedActive := name == registry.activeName
+	listeners := registry.listenersLocked()
+	registry.mtx.Unlock()
+
+	if replacedActive {
+		notifySignerChange(listeners, previous, s)
+	}
 }
 
-// SetActiveSigner sets the active signer by name
+// SetActiveSigner sets the active signer by name, and returns false if no
+// signer is registered under name.
 func SetActiveSigner(name string) bool {
-	s, ok := signerRegistry[name]
-	if ok {
-		currentSigner = s
+	registry.mtx.Lock()
+	s, ok := registry.signers[name]
+	if !ok {
+		registry.mtx.Unlock()
+		return false
+	}
+	previousName := registry.activeName
+	previous := registry.signers[previousName]
+	registry.activeName = name
+	listeners := registry.listenersLocked()
+	registry.mtx.Unlock()
+
+	if previousName != name {
+		notifySignerChange(listeners, previous, s)
 	}
-	return ok
+	return true
 }
 
 // GetSigner returns the currently active signer
 func GetSigner() Signer {
-	return currentSigner
+	registry.mtx.RLock()
+	defer registry.mtx.RUnlock()
+	return registry.signers[registry.activeName]
 }
 
-// ListSigners returns the names of all registered signers
+// ListSigners returns the sorted names of all registered signers
 func ListSigners() []string {
-	names := make([]string, 0, len(signerRegistry))
-	for name := range signerRegistry {
+	registry.mtx.RLock()
+	defer registry.mtx.RUnlock()
+	names := make([]string, 0, len(registry.signers))
+	for name := range registry.signers {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 	return names
 }
 
-// --- Default ECDSA Signer (example stub) ---
-// In production, replace with actual ECDSA implementation
+// AddSignerChangeListener registers cb to be called every time the active
+// signer changes, replacing any callback registered under listenerID.
+// Callbacks are called synchronously, in no particular order, by the
+// goroutine changing the signer, after the registry is unlocked.
+func AddSignerChangeListener(listenerID string, cb SignerChangeCallback) {
+	registry.mtx.Lock()
+	defer registry.mtx.Unlock()
+	registry.listeners[listenerID] = cb
+}
 
-type ECDSASigner struct{}
+// RemoveSignerChangeListener removes the callback registered under
+// listenerID.
+func RemoveSignerChangeListener(listenerID string) {
+	registry.mtx.Lock()
+	defer registry.mtx.Unlock()
+	delete(registry.listeners, listenerID)
+}
 
-func NewECDSASigner() *ECDSASigner { return &ECDSASigner{} }
+// listenersLocked returns a copy of the listeners. The caller must hold mtx.
+func (r *signerRegistry) listenersLocked() []SignerChangeCallback {
+	listeners := make([]SignerChangeCallback, 0, len(r.listeners))
+	for _, cb := range r.listeners {
+		listeners = append(listeners, cb)
+	}
+	return listeners
+}
 
-func (e *ECDSASigner) GenerateKey() ([]byte, []byte)                                  { return []byte("priv"), []byte("pub") }
-func (e *ECDSASigner) Sign(privateKey []byte, message []byte) []byte                  { return []byte("sig") }
-func (e *ECDSASigner) Verify(publicKey []byte, message []byte, signature []byte) bool { return true }
-func (e *ECDSASigner) Name() string                                                   { return "ecdsa" }
+func notifySignerChange(listeners []SignerChangeCallback, previous, current Signer) {
+	for _, cb := range listeners {
+		cb(previous, current)
+	}
+}
 
-// --- Example: Register default signer at init ---
+// --- Register default signers at init ---
 func init() {
-	RegisterSigner("ecdsa", NewECDSASigner())
-	currentSigner = signerRegistry["ecdsa"]
+	RegisterSigner(ECDSASignerName, NewECDSASigner())
+	RegisterSigner(Ed25519SignerName, NewEd25519Signer())
+	SetActiveSigner(ECDSASignerName)
 }
 
 /*
@@ -71,4 +143,9 @@ import "github.com/fluentum-chain/fluentum/core/crypto"
 
 crypto.RegisterSigner("dilithium", NewDilithiumSigner())
 crypto.SetActiveSigner("dilithium") // To activate
+
+// Get notified when the active signer changes:
+crypto.AddSignerChangeListener("my-feature", func(previous, current crypto.Signer) {
+	...
+})
 */
@@ -0,0 +1,67 @@
+package crypto
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSignerRegistry(t *testing.T) {
+	defer SetActiveSigner(ECDSASignerName)
+
+	if s := GetSigner(); s == nil || s.Name() != ECDSASignerName {
+		t.Fatalf("expected ecdsa to be the default signer, got %v", s)
+	}
+	if names := ListSigners(); !reflect.DeepEqual(names, []string{ECDSASignerName, Ed25519SignerName}) {
+		t.Errorf("unexpected signers %v", names)
+	}
+	if SetActiveSigner("unknown") {
+		t.Error("expected activating an unknown signer to fail")
+	}
+
+	var changes [][2]string
+	AddSignerChangeListener("test", func(previous, current Signer) {
+		changes = append(changes, [2]string{previous.Name(), current.Name()})
+	})
+	defer RemoveSignerChangeListener("test")
+
+	if !SetActiveSigner(Ed25519SignerName) {
+		t.Fatal("expected ed25519 to be activated")
+	}
+	// Activating the active signer again is not a change.
+	SetActiveSigner(Ed25519SignerName)
+	// Replacing the active signer is.
+	RegisterSigner(Ed25519SignerName, NewEd25519Signer())
+	SetActiveSigner(ECDSASignerName)
+
+	want := [][2]string{
+		{ECDSASignerName, Ed25519SignerName},
+		{Ed25519SignerName, Ed25519SignerName},
+		{Ed25519SignerName, ECDSASignerName},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got changes %v, want %v", changes, want)
+	}
+}
+
+func TestSignerRegistryConcurrency(t *testing.T) {
+	defer SetActiveSigner(ECDSASignerName)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			SetActiveSigner(Ed25519SignerName)
+			SetActiveSigner(ECDSASignerName)
+		}()
+		go func() {
+			defer wg.Done()
+			if GetSigner() == nil {
+				t.Error("expected an active signer")
+			}
+			_ = ListSigners()
+		}()
+	}
+	wg.Wait()
+}
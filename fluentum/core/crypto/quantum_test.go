@@ -0,0 +1,53 @@
+package crypto
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDilithiumSigner(t *testing.T) {
+	for _, mode := range []int{2, 3, 5} {
+		signer, err := NewDilithiumSigner(mode)
+		if err != nil {
+			t.Fatalf("NewDilithiumSigner(%d): %v", mode, err)
+		}
+		privKey, pubKey := signer.GenerateKey()
+		message := []byte("block header")
+
+		sig, err := signer.Sign(privKey, message)
+		if err != nil {
+			t.Fatalf("mode %d: Sign: %v", mode, err)
+		}
+		if !signer.Verify(pubKey, message, sig) {
+			t.Fatalf("mode %d: expected signature to verify", mode)
+		}
+		if signer.Verify(pubKey, []byte("other message"), sig) {
+			t.Errorf("mode %d: expected signature of another message to be rejected", mode)
+		}
+
+		tampered := append([]byte{}, sig...)
+		tampered[0] ^= 0xff
+		if signer.Verify(pubKey, message, tampered) {
+			t.Errorf("mode %d: expected tampered signature to be rejected", mode)
+		}
+		if signer.Verify(pubKey, message, sig[1:]) {
+			t.Errorf("mode %d: expected truncated signature to be rejected", mode)
+		}
+
+		_, otherPubKey := signer.GenerateKey()
+		if signer.Verify(otherPubKey, message, sig) {
+			t.Errorf("mode %d: expected signature to be rejected by another key", mode)
+		}
+		if signer.Verify(pubKey[1:], message, sig) {
+			t.Errorf("mode %d: expected malformed public key to be rejected", mode)
+		}
+
+		if _, err := signer.Sign(privKey[1:], message); !errors.Is(err, ErrInvalidPrivateKey) {
+			t.Errorf("mode %d: expected ErrInvalidPrivateKey, got %v", mode, err)
+		}
+	}
+
+	if _, err := NewDilithiumSigner(1); err == nil {
+		t.Error("expected mode 1 to be rejected")
+	}
+}
@@ -23,6 +23,16 @@ type FeatureConfig struct {
 			MaxLatencyMs   int  `toml:"max_latency_ms"`
 		} `toml:"quantum_signing"`
 
+		AIValidation struct {
+			Enabled             bool    `toml:"enabled"`
+			PluginPath          string  `toml:"plugin_path"`
+			ModelPath           string  `toml:"model_path"`
+			UseGPU              bool    `toml:"use_gpu"`
+			MaxBatchSize        int     `toml:"max_batch_size"`
+			ConfidenceThreshold float64 `toml:"confidence_threshold"`
+			EnableLogging       bool    `toml:"enable_logging"`
+		} `toml:"ai_validation"`
+
 		StateSync struct {
 			Enabled        bool `toml:"enabled"`
 			FastSync       bool `toml:"fast_sync"`
@@ -61,12 +71,17 @@ type FeatureLoader struct {
 	configPath     string
 }
 
-// NewFeatureLoader creates a new feature loader
-func NewFeatureLoader(configPath string, nodeVersion string) *FeatureLoader {
-	return &FeatureLoader{
-		configPath:     configPath,
-		featureManager: NewFeatureManager(nodeVersion),
+// NewFeatureLoader creates a new feature loader for the node in homeDir,
+// configured by config/features.toml
+func NewFeatureLoader(homeDir string, nodeVersion string) (*FeatureLoader, error) {
+	featureManager, err := NewFeatureManager(nodeVersion, homeDir)
+	if err != nil {
+		return nil, err
 	}
+	return &FeatureLoader{
+		configPath:     filepath.Join(homeDir, "config", "features.toml"),
+		featureManager: featureManager,
+	}, nil
 }
 
 // LoadConfiguration loads the feature configuration from file
@@ -118,6 +133,17 @@ quantum_headers = true
 enable_metrics = true
 max_latency_ms = 50
 
+# AI Validation Feature Configuration
+# When enabled, the blocks proposed by the node are ordered by the AI plugin
+[features.ai_validation]
+enabled = false
+plugin_path = ""
+model_path = ""
+use_gpu = false
+max_batch_size = 32
+confidence_threshold = 0.9
+enable_logging = true
+
 # State Sync Feature Configuration
 [features.state_sync]
 enabled = false
@@ -156,25 +182,16 @@ api_version = "v1.0.0"
 	return fl.LoadConfiguration()
 }
 
-// InitializeFeatures initializes all features based on configuration
+// InitializeFeatures configures all features based on configuration
 func (fl *FeatureLoader) InitializeFeatures() error {
 	if fl.config == nil {
 		return fmt.Errorf("configuration not loaded")
 	}
 
-	// Load all available features
-	if err := fl.featureManager.LoadFeatures(); err != nil {
-		return fmt.Errorf("failed to load features: %w", err)
-	}
-
-	// Configure features based on loaded configuration
+	// Configure features based on loaded configuration. Features are
+	// initialized with it when they're started.
 	fl.configureFeatures()
 
-	// Initialize features
-	if err := fl.featureManager.InitializeFeatures(); err != nil {
-		return fmt.Errorf("failed to initialize features: %w", err)
-	}
-
 	return nil
 }
 
@@ -211,7 +228,8 @@ func (fl *FeatureLoader) configureFeatures() {
 	fl.featureManager.SetFeatureConfig("zk_rollup", zkRollupConfig)
 }
 
-// StartFeatures starts all enabled features
+// StartFeatures initializes all features, and starts the enabled ones in
+// dependency order
 func (fl *FeatureLoader) StartFeatures() error {
 	return fl.featureManager.StartFeatures()
 }
@@ -235,6 +253,11 @@ func (fl *FeatureLoader) ReloadFeatures() error {
 	return fl.featureManager.ReloadAllFeatures()
 }
 
+// GetConfig returns the loaded feature configuration
+func (fl *FeatureLoader) GetConfig() *FeatureConfig {
+	return fl.config
+}
+
 // GetFeatureManager returns the feature manager
 func (fl *FeatureLoader) GetFeatureManager() *FeatureManager {
 	return fl.featureManager
@@ -263,6 +286,11 @@ func (fl *FeatureLoader) ValidateConfiguration() error {
 		}
 	}
 
+	// Validate AI validation configuration
+	if fl.config.Features.AIValidation.Enabled && fl.config.Features.AIValidation.PluginPath == "" {
+		return fmt.Errorf("AI validation is enabled but plugin_path is not set")
+	}
+
 	// Validate state sync configuration
 	if fl.config.Features.StateSync.Enabled {
 		if fl.config.Features.StateSync.ChunkSize <= 0 {
@@ -58,6 +58,22 @@ type AIValidatorPlugin interface {
 	GetConfig() map[string]interface{}
 }
 
+// FixedPointOne is 1.0 in the Q16.16 fixed point format of FixedPointScorer.
+const FixedPointOne = 1 << 16
+
+// FixedPointScorer is implemented by AI validator plugins able to run their
+// model with integer arithmetic only. Unlike PredictBatch, its results don't
+// depend on the floating point behavior of the host, so two nodes with the
+// same model weights always compute the same scores.
+type FixedPointScorer interface {
+	// ScoreTransactions returns one Q16.16 score per transaction. Higher
+	// scores are ordered first.
+	ScoreTransactions(transactions []Transaction) ([]int64, error)
+
+	// ModelHash returns the SHA-256 hash of the model weights.
+	ModelHash() []byte
+}
+
 // BatchPrediction represents the AI model's prediction for optimal batch composition
 type BatchPrediction struct {
 	OptimalBatch   []Transaction            `json:"optimal_batch"`
@@ -0,0 +1,443 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pluginproto "github.com/fluentum-chain/fluentum/proto/fluentum/plugin"
+)
+
+// NewSignerClient returns a SignerPlugin calling the signer served by the
+// plugin process p.
+func NewSignerClient(p *Process) SignerPlugin {
+	return &signerClient{process: p}
+}
+
+// NewAIValidatorClient returns an AIValidatorPlugin calling the AI validator
+// served by the plugin process p. It also implements FixedPointScorer, whose
+// methods fail if the plugin doesn't. The last configuration and weights are
+// reapplied to the processes restarting the plugin.
+func NewAIValidatorClient(p *Process) AIValidatorPlugin {
+	c := &aiValidatorClient{process: p}
+	p.AddRestartHook(c.restore)
+	return c
+}
+
+// signerClient is a SignerPlugin served by a plugin process.
+type signerClient struct {
+	process *Process
+}
+
+var _ SignerPlugin = (*signerClient)(nil)
+
+func (c *signerClient) client() (pluginproto.SignerClient, error) {
+	conn, err := c.process.Conn()
+	if err != nil {
+		return nil, err
+	}
+	return pluginproto.NewSignerClient(conn), nil
+}
+
+// info returns the info of the signer, or an empty info if the plugin can't
+// be reached.
+func (c *signerClient) info() *pluginproto.SignerInfoResponse {
+	client, err := c.client()
+	if err != nil {
+		return &pluginproto.SignerInfoResponse{}
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.SignerInfo(ctx, &pluginproto.SignerInfoRequest{})
+	if err != nil {
+		return &pluginproto.SignerInfoResponse{}
+	}
+	return res
+}
+
+func (c *signerClient) GenerateKeyPair() ([]byte, []byte, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.GenerateKeyPair(ctx, &pluginproto.GenerateKeyPairRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return res.PublicKey, res.PrivateKey, nil
+}
+
+func (c *signerClient) Sign(privateKey []byte, message []byte) ([]byte, error) {
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	return c.SignAsync(ctx, privateKey, message)
+}
+
+func (c *signerClient) SignAsync(ctx context.Context, privateKey []byte, message []byte) ([]byte, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Sign(ctx, &pluginproto.SignRequest{PrivateKey: privateKey, Message: message})
+	if err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
+
+func (c *signerClient) Verify(publicKey []byte, message []byte, signature []byte) (bool, error) {
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	return c.VerifyAsync(ctx, publicKey, message, signature)
+}
+
+func (c *signerClient) VerifyAsync(ctx context.Context, publicKey []byte, message []byte, signature []byte) (bool, error) {
+	client, err := c.client()
+	if err != nil {
+		return false, err
+	}
+	res, err := client.Verify(ctx, &pluginproto.VerifyRequest{
+		PublicKey: publicKey,
+		Message:   message,
+		Signature: signature,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Valid, nil
+}
+
+func (c *signerClient) BatchVerify(publicKeys [][]byte, messages [][]byte, signatures [][]byte) ([]bool, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.BatchVerify(ctx, &pluginproto.BatchVerifyRequest{
+		PublicKeys: publicKeys,
+		Messages:   messages,
+		Signatures: signatures,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Valid) != len(signatures) {
+		return nil, fmt.Errorf("plugin returned %d results for %d signatures", len(res.Valid), len(signatures))
+	}
+	return res.Valid, nil
+}
+
+func (c *signerClient) SignatureSize() int       { return int(c.info().SignatureSize) }
+func (c *signerClient) PublicKeySize() int       { return int(c.info().PublicKeySize) }
+func (c *signerClient) PrivateKeySize() int      { return int(c.info().PrivateKeySize) }
+func (c *signerClient) AlgorithmName() string    { return c.info().AlgorithmName }
+func (c *signerClient) SecurityLevel() string    { return c.info().SecurityLevel }
+func (c *signerClient) IsQuantumResistant() bool { return c.info().QuantumResistant }
+
+func (c *signerClient) PerformanceMetrics() map[string]float64 {
+	client, err := c.client()
+	if err != nil {
+		return nil
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.PerformanceMetrics(ctx, &pluginproto.MetricsRequest{})
+	if err != nil {
+		return nil
+	}
+	return res.Metrics
+}
+
+func (c *signerClient) ResetMetrics() {
+	client, err := c.client()
+	if err != nil {
+		return
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	_, _ = client.ResetMetrics(ctx, &pluginproto.ResetMetricsRequest{})
+}
+
+// aiValidatorClient is an AIValidatorPlugin served by a plugin process.
+type aiValidatorClient struct {
+	process *Process
+
+	// mtx guards the state restored on restarted processes
+	mtx         sync.Mutex
+	config      []byte
+	weightsPath string
+}
+
+var (
+	_ AIValidatorPlugin = (*aiValidatorClient)(nil)
+	_ FixedPointScorer  = (*aiValidatorClient)(nil)
+)
+
+func (c *aiValidatorClient) client() (pluginproto.AIValidatorClient, error) {
+	conn, err := c.process.Conn()
+	if err != nil {
+		return nil, err
+	}
+	return pluginproto.NewAIValidatorClient(conn), nil
+}
+
+func toProtoTxs(txs []Transaction) []*pluginproto.Transaction {
+	pbTxs := make([]*pluginproto.Transaction, len(txs))
+	for i, tx := range txs {
+		pbTxs[i] = &pluginproto.Transaction{Data: tx.GetData(), Hash: tx.GetHash()}
+	}
+	return pbTxs
+}
+
+func (c *aiValidatorClient) Initialize(config map[string]interface{}) error {
+	bz, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	if _, err := client.Initialize(ctx, &pluginproto.InitializeRequest{Config: bz}); err != nil {
+		return err
+	}
+	c.mtx.Lock()
+	c.config = bz
+	c.mtx.Unlock()
+	return nil
+}
+
+func (c *aiValidatorClient) PredictBatch(transactions []Transaction) (*BatchPrediction, error) {
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	return c.PredictBatchAsync(ctx, transactions)
+}
+
+// PredictBatchAsync implements AIValidatorPlugin. The transactions of the
+// prediction are the given ones, so callers can map them back to their own
+// types.
+func (c *aiValidatorClient) PredictBatchAsync(ctx context.Context, transactions []Transaction) (*BatchPrediction, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.PredictBatch(ctx, &pluginproto.TransactionsRequest{Transactions: toProtoTxs(transactions)})
+	if err != nil {
+		return nil, err
+	}
+
+	fromIndices := func(indices []uint32) ([]Transaction, error) {
+		group := make([]Transaction, len(indices))
+		for i, index := range indices {
+			if int(index) >= len(transactions) {
+				return nil, fmt.Errorf("plugin returned transaction index %d of %d", index, len(transactions))
+			}
+			group[i] = transactions[index]
+		}
+		return group, nil
+	}
+
+	prediction := &BatchPrediction{
+		Confidence:     res.Confidence,
+		EstimatedGas:   res.EstimatedGas,
+		ExecutionTime:  time.Duration(res.ExecutionTime),
+		GasSavings:     res.GasSavings,
+		PriorityGroups: make(map[int][]Transaction, len(res.PriorityGroups)),
+		PatternGroups:  make(map[string][]Transaction, len(res.PatternGroups)),
+	}
+	if prediction.OptimalBatch, err = fromIndices(res.OptimalBatch); err != nil {
+		return nil, err
+	}
+	for priority, group := range res.PriorityGroups {
+		if prediction.PriorityGroups[int(priority)], err = fromIndices(group.Indices); err != nil {
+			return nil, err
+		}
+	}
+	for pattern, group := range res.PatternGroups {
+		if prediction.PatternGroups[pattern], err = fromIndices(group.Indices); err != nil {
+			return nil, err
+		}
+	}
+	return prediction, nil
+}
+
+func (c *aiValidatorClient) ValidateBatch(batch *Batch) (bool, float64, error) {
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	return c.ValidateBatchAsync(ctx, batch)
+}
+
+func (c *aiValidatorClient) ValidateBatchAsync(ctx context.Context, batch *Batch) (bool, float64, error) {
+	client, err := c.client()
+	if err != nil {
+		return false, 0, err
+	}
+	res, err := client.ValidateBatch(ctx, &pluginproto.ValidateBatchRequest{
+		Transactions: toProtoTxs(batch.Transactions),
+		Hash:         batch.Hash,
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	return res.Valid, res.Confidence, nil
+}
+
+func (c *aiValidatorClient) PredictExecutionPattern(tx Transaction) (string, error) {
+	client, err := c.client()
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.PredictExecutionPattern(ctx, &pluginproto.Transaction{Data: tx.GetData(), Hash: tx.GetHash()})
+	if err != nil {
+		return "", err
+	}
+	return res.Pattern, nil
+}
+
+func (c *aiValidatorClient) EstimateCombinedGasSavings(batch []Transaction) (float64, error) {
+	client, err := c.client()
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.EstimateCombinedGasSavings(ctx, &pluginproto.TransactionsRequest{Transactions: toProtoTxs(batch)})
+	if err != nil {
+		return 0, err
+	}
+	return res.Savings, nil
+}
+
+func (c *aiValidatorClient) ScoreTransactions(transactions []Transaction) ([]int64, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.ScoreTransactions(ctx, &pluginproto.TransactionsRequest{Transactions: toProtoTxs(transactions)})
+	if err != nil {
+		return nil, err
+	}
+	return res.Scores, nil
+}
+
+// ModelHash implements FixedPointScorer. It returns nil if the plugin can't
+// be reached, or doesn't support fixed point inference.
+func (c *aiValidatorClient) ModelHash() []byte {
+	client, err := c.client()
+	if err != nil {
+		return nil
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.ModelHash(ctx, &pluginproto.ModelHashRequest{})
+	if err != nil {
+		return nil
+	}
+	return res.Hash
+}
+
+func (c *aiValidatorClient) GetModelMetrics() map[string]float64 {
+	client, err := c.client()
+	if err != nil {
+		return nil
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.ModelMetrics(ctx, &pluginproto.MetricsRequest{})
+	if err != nil {
+		return nil
+	}
+	return res.Metrics
+}
+
+func (c *aiValidatorClient) VersionInfo() map[string]string {
+	client, err := c.client()
+	if err != nil {
+		return nil
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.VersionInfo(ctx, &pluginproto.VersionInfoRequest{})
+	if err != nil {
+		return nil
+	}
+	return res.Info
+}
+
+func (c *aiValidatorClient) ResetMetrics() {
+	client, err := c.client()
+	if err != nil {
+		return
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	_, _ = client.ResetMetrics(ctx, &pluginproto.ResetMetricsRequest{})
+}
+
+func (c *aiValidatorClient) UpdateWeights(weightsPath string) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	if _, err := client.UpdateWeights(ctx, &pluginproto.UpdateWeightsRequest{WeightsPath: weightsPath}); err != nil {
+		return err
+	}
+	c.mtx.Lock()
+	c.weightsPath = weightsPath
+	c.mtx.Unlock()
+	return nil
+}
+
+// restore initializes a restarted process of the plugin with the last
+// configuration and weights.
+func (c *aiValidatorClient) restore(ctx context.Context, conn *grpc.ClientConn) error {
+	c.mtx.Lock()
+	config, weightsPath := c.config, c.weightsPath
+	c.mtx.Unlock()
+
+	client := pluginproto.NewAIValidatorClient(conn)
+	if config != nil {
+		if _, err := client.Initialize(ctx, &pluginproto.InitializeRequest{Config: config}); err != nil {
+			return err
+		}
+	}
+	if weightsPath != "" {
+		if _, err := client.UpdateWeights(ctx, &pluginproto.UpdateWeightsRequest{WeightsPath: weightsPath}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *aiValidatorClient) GetConfig() map[string]interface{} {
+	client, err := c.client()
+	if err != nil {
+		return nil
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	res, err := client.GetConfig(ctx, &pluginproto.GetConfigRequest{})
+	if err != nil {
+		return nil
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(res.Config, &config); err != nil {
+		return nil
+	}
+	return config
+}
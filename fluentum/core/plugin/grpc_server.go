@@ -0,0 +1,317 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	pluginproto "github.com/fluentum-chain/fluentum/proto/fluentum/plugin"
+)
+
+// ServeConfig holds the implementations served by a plugin binary. Nil
+// implementations aren't served.
+type ServeConfig struct {
+	Signer      SignerPlugin
+	AIValidator AIValidatorPlugin
+
+	// Register registers additional services, e.g. the feature service.
+	Register func(*grpc.Server)
+}
+
+// Serve serves the plugin implementations of config to the node which
+// started the plugin binary, until the node stops it. It's called by the
+// main function of plugin binaries.
+func Serve(config ServeConfig) error {
+	if os.Getenv(MagicCookieEnv) != MagicCookie {
+		return errors.New("this binary is a Fluentum plugin, it must be started by the node")
+	}
+	socket := os.Getenv(SocketEnv)
+	if socket == "" {
+		return fmt.Errorf("%s is not set", SocketEnv)
+	}
+	ln, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+
+	srv := grpc.NewServer()
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	if config.Signer != nil {
+		pluginproto.RegisterSignerServer(srv, &signerServer{impl: config.Signer})
+	}
+	if config.AIValidator != nil {
+		pluginproto.RegisterAIValidatorServer(srv, &aiValidatorServer{impl: config.AIValidator})
+	}
+	if config.Register != nil {
+		config.Register(srv)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		healthSrv.Shutdown()
+		srv.GracefulStop()
+	}()
+
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	return srv.Serve(ln)
+}
+
+// signerServer serves a SignerPlugin.
+type signerServer struct {
+	pluginproto.UnimplementedSignerServer
+	impl SignerPlugin
+}
+
+func (s *signerServer) GenerateKeyPair(
+	context.Context, *pluginproto.GenerateKeyPairRequest,
+) (*pluginproto.GenerateKeyPairResponse, error) {
+	pubKey, privKey, err := s.impl.GenerateKeyPair()
+	if err != nil {
+		return nil, err
+	}
+	return &pluginproto.GenerateKeyPairResponse{PublicKey: pubKey, PrivateKey: privKey}, nil
+}
+
+func (s *signerServer) Sign(ctx context.Context, req *pluginproto.SignRequest) (*pluginproto.SignResponse, error) {
+	sig, err := s.impl.SignAsync(ctx, req.PrivateKey, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &pluginproto.SignResponse{Signature: sig}, nil
+}
+
+func (s *signerServer) Verify(ctx context.Context, req *pluginproto.VerifyRequest) (*pluginproto.VerifyResponse, error) {
+	valid, err := s.impl.VerifyAsync(ctx, req.PublicKey, req.Message, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return &pluginproto.VerifyResponse{Valid: valid}, nil
+}
+
+func (s *signerServer) BatchVerify(
+	_ context.Context, req *pluginproto.BatchVerifyRequest,
+) (*pluginproto.BatchVerifyResponse, error) {
+	valid, err := s.impl.BatchVerify(req.PublicKeys, req.Messages, req.Signatures)
+	if err != nil {
+		return nil, err
+	}
+	return &pluginproto.BatchVerifyResponse{Valid: valid}, nil
+}
+
+func (s *signerServer) SignerInfo(
+	context.Context, *pluginproto.SignerInfoRequest,
+) (*pluginproto.SignerInfoResponse, error) {
+	return &pluginproto.SignerInfoResponse{
+		SignatureSize:    int32(s.impl.SignatureSize()),
+		PublicKeySize:    int32(s.impl.PublicKeySize()),
+		PrivateKeySize:   int32(s.impl.PrivateKeySize()),
+		AlgorithmName:    s.impl.AlgorithmName(),
+		SecurityLevel:    s.impl.SecurityLevel(),
+		QuantumResistant: s.impl.IsQuantumResistant(),
+	}, nil
+}
+
+func (s *signerServer) PerformanceMetrics(
+	context.Context, *pluginproto.MetricsRequest,
+) (*pluginproto.MetricsResponse, error) {
+	return &pluginproto.MetricsResponse{Metrics: s.impl.PerformanceMetrics()}, nil
+}
+
+func (s *signerServer) ResetMetrics(
+	context.Context, *pluginproto.ResetMetricsRequest,
+) (*pluginproto.ResetMetricsResponse, error) {
+	s.impl.ResetMetrics()
+	return &pluginproto.ResetMetricsResponse{}, nil
+}
+
+// aiValidatorServer serves an AIValidatorPlugin.
+type aiValidatorServer struct {
+	pluginproto.UnimplementedAIValidatorServer
+	impl AIValidatorPlugin
+}
+
+// remoteTx is a transaction received from the node.
+type remoteTx struct {
+	data []byte
+	hash []byte
+}
+
+func (t *remoteTx) GetData() []byte { return t.data }
+func (t *remoteTx) GetHash() []byte { return t.hash }
+func (t *remoteTx) GetSize() int    { return len(t.data) }
+
+func fromProtoTxs(pbTxs []*pluginproto.Transaction) []Transaction {
+	txs := make([]Transaction, len(pbTxs))
+	for i, tx := range pbTxs {
+		txs[i] = &remoteTx{data: tx.Data, hash: tx.Hash}
+	}
+	return txs
+}
+
+func (s *aiValidatorServer) Initialize(
+	_ context.Context, req *pluginproto.InitializeRequest,
+) (*pluginproto.InitializeResponse, error) {
+	var config map[string]interface{}
+	if len(req.Config) > 0 {
+		if err := json.Unmarshal(req.Config, &config); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "decoding config: %v", err)
+		}
+	}
+	if err := s.impl.Initialize(config); err != nil {
+		return nil, err
+	}
+	return &pluginproto.InitializeResponse{}, nil
+}
+
+func (s *aiValidatorServer) PredictBatch(
+	ctx context.Context, req *pluginproto.TransactionsRequest,
+) (*pluginproto.BatchPrediction, error) {
+	txs := fromProtoTxs(req.Transactions)
+	prediction, err := s.impl.PredictBatchAsync(ctx, txs)
+	if err != nil {
+		return nil, err
+	}
+
+	// transactions are returned as indices in the request
+	indices := make(map[*remoteTx]uint32, len(txs))
+	for i, tx := range txs {
+		indices[tx.(*remoteTx)] = uint32(i)
+	}
+	toIndices := func(group []Transaction) *pluginproto.Indices {
+		pbGroup := &pluginproto.Indices{}
+		for _, tx := range group {
+			rtx, ok := tx.(*remoteTx)
+			if !ok {
+				continue
+			}
+			if i, ok := indices[rtx]; ok {
+				pbGroup.Indices = append(pbGroup.Indices, i)
+			}
+		}
+		return pbGroup
+	}
+
+	res := &pluginproto.BatchPrediction{
+		OptimalBatch:   toIndices(prediction.OptimalBatch).Indices,
+		Confidence:     prediction.Confidence,
+		EstimatedGas:   prediction.EstimatedGas,
+		ExecutionTime:  int64(prediction.ExecutionTime),
+		GasSavings:     prediction.GasSavings,
+		PriorityGroups: make(map[int32]*pluginproto.Indices, len(prediction.PriorityGroups)),
+		PatternGroups:  make(map[string]*pluginproto.Indices, len(prediction.PatternGroups)),
+	}
+	for priority, group := range prediction.PriorityGroups {
+		res.PriorityGroups[int32(priority)] = toIndices(group)
+	}
+	for pattern, group := range prediction.PatternGroups {
+		res.PatternGroups[pattern] = toIndices(group)
+	}
+	return res, nil
+}
+
+func (s *aiValidatorServer) ValidateBatch(
+	ctx context.Context, req *pluginproto.ValidateBatchRequest,
+) (*pluginproto.ValidateBatchResponse, error) {
+	txs := fromProtoTxs(req.Transactions)
+	valid, confidence, err := s.impl.ValidateBatchAsync(ctx, &Batch{Transactions: txs, Hash: req.Hash, Size: len(txs)})
+	if err != nil {
+		return nil, err
+	}
+	return &pluginproto.ValidateBatchResponse{Valid: valid, Confidence: confidence}, nil
+}
+
+func (s *aiValidatorServer) PredictExecutionPattern(
+	_ context.Context, req *pluginproto.Transaction,
+) (*pluginproto.ExecutionPatternResponse, error) {
+	pattern, err := s.impl.PredictExecutionPattern(&remoteTx{data: req.Data, hash: req.Hash})
+	if err != nil {
+		return nil, err
+	}
+	return &pluginproto.ExecutionPatternResponse{Pattern: pattern}, nil
+}
+
+func (s *aiValidatorServer) EstimateCombinedGasSavings(
+	_ context.Context, req *pluginproto.TransactionsRequest,
+) (*pluginproto.GasSavingsResponse, error) {
+	savings, err := s.impl.EstimateCombinedGasSavings(fromProtoTxs(req.Transactions))
+	if err != nil {
+		return nil, err
+	}
+	return &pluginproto.GasSavingsResponse{Savings: savings}, nil
+}
+
+func (s *aiValidatorServer) ScoreTransactions(
+	_ context.Context, req *pluginproto.TransactionsRequest,
+) (*pluginproto.ScoresResponse, error) {
+	scorer, ok := s.impl.(FixedPointScorer)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "plugin does not support fixed point inference")
+	}
+	scores, err := scorer.ScoreTransactions(fromProtoTxs(req.Transactions))
+	if err != nil {
+		return nil, err
+	}
+	return &pluginproto.ScoresResponse{Scores: scores}, nil
+}
+
+func (s *aiValidatorServer) ModelHash(
+	context.Context, *pluginproto.ModelHashRequest,
+) (*pluginproto.ModelHashResponse, error) {
+	scorer, ok := s.impl.(FixedPointScorer)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "plugin does not support fixed point inference")
+	}
+	return &pluginproto.ModelHashResponse{Hash: scorer.ModelHash()}, nil
+}
+
+func (s *aiValidatorServer) ModelMetrics(
+	context.Context, *pluginproto.MetricsRequest,
+) (*pluginproto.MetricsResponse, error) {
+	return &pluginproto.MetricsResponse{Metrics: s.impl.GetModelMetrics()}, nil
+}
+
+func (s *aiValidatorServer) VersionInfo(
+	context.Context, *pluginproto.VersionInfoRequest,
+) (*pluginproto.VersionInfoResponse, error) {
+	return &pluginproto.VersionInfoResponse{Info: s.impl.VersionInfo()}, nil
+}
+
+func (s *aiValidatorServer) ResetMetrics(
+	context.Context, *pluginproto.ResetMetricsRequest,
+) (*pluginproto.ResetMetricsResponse, error) {
+	s.impl.ResetMetrics()
+	return &pluginproto.ResetMetricsResponse{}, nil
+}
+
+func (s *aiValidatorServer) UpdateWeights(
+	_ context.Context, req *pluginproto.UpdateWeightsRequest,
+) (*pluginproto.UpdateWeightsResponse, error) {
+	if err := s.impl.UpdateWeights(req.WeightsPath); err != nil {
+		return nil, err
+	}
+	return &pluginproto.UpdateWeightsResponse{}, nil
+}
+
+func (s *aiValidatorServer) GetConfig(
+	context.Context, *pluginproto.GetConfigRequest,
+) (*pluginproto.GetConfigResponse, error) {
+	config, err := json.Marshal(s.impl.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	return &pluginproto.GetConfigResponse{Config: config}, nil
+}
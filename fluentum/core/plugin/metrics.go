@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "signer"
+)
+
+// Metrics contains the metrics of the signers, labeled by signing algorithm.
+// A node exports them for the votes and proposals its validator key signs,
+// see privval.InstrumentedPV.
+type Metrics struct {
+	// Time to sign a message, in seconds.
+	SignDuration metrics.Histogram
+
+	// Time to verify a signature, in seconds.
+	VerifyDuration metrics.Histogram
+
+	// Number of failed sign and verify operations, also labeled by operation.
+	Errors metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		SignDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sign_duration_seconds",
+			Help:      "Time to sign a message, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.00001, 2, 16),
+		}, append(labels, "algorithm")).With(labelsAndValues...),
+
+		VerifyDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verify_duration_seconds",
+			Help:      "Time to verify a signature, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.00001, 2, 16),
+		}, append(labels, "algorithm")).With(labelsAndValues...),
+
+		Errors: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "errors",
+			Help:      "Number of failed sign and verify operations.",
+		}, append(labels, "algorithm", "operation")).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		SignDuration:   discard.NewHistogram(),
+		VerifyDuration: discard.NewHistogram(),
+		Errors:         discard.NewCounter(),
+	}
+}
@@ -7,13 +7,17 @@ import (
 	"plugin"
 	"sync"
 	"time"
+
+	"github.com/fluentum-chain/fluentum/libs/log"
 )
 
 // PluginManager manages the loading and lifecycle of Fluentum plugins
 type PluginManager struct {
-	plugins map[string]interface{}
-	mutex   sync.RWMutex
-	config  *PluginManagerConfig
+	plugins   map[string]interface{}
+	processes map[string]*Process
+	mutex     sync.RWMutex
+	config    *PluginManagerConfig
+	logger    log.Logger
 }
 
 // PluginManagerConfig contains configuration for the plugin manager
@@ -22,18 +26,36 @@ type PluginManagerConfig struct {
 	AutoLoad        bool                   `json:"auto_load"`
 	PluginConfigs   map[string]interface{} `json:"plugin_configs"`
 	MaxPlugins      int                    `json:"max_plugins"`
+
+	// IsolationEnabled runs plugins as separate processes serving gRPC,
+	// see Process. Otherwise plugins are shared libraries loaded in the
+	// node process with Go's plugin package, which requires them to be
+	// built with the exact same toolchain and dependencies as the node.
+	// It's disabled by default, so existing shared library plugins keep
+	// loading; plugin binaries built with Serve require it.
+	IsolationEnabled    bool          `json:"isolation_enabled"`
+	HealthCheckInterval time.Duration `json:"health_check_interval"`
+	MaxRestarts         int           `json:"max_restarts"`
 }
 
 // DefaultPluginManagerConfig returns default configuration
 func DefaultPluginManagerConfig() *PluginManagerConfig {
 	return &PluginManagerConfig{
-		PluginDirectory: "./plugins",
-		AutoLoad:        true,
-		MaxPlugins:      10,
-		PluginConfigs:   make(map[string]interface{}),
+		PluginDirectory:     "./plugins",
+		AutoLoad:            true,
+		MaxPlugins:          10,
+		PluginConfigs:       make(map[string]interface{}),
+		IsolationEnabled:    false,
+		HealthCheckInterval: 5 * time.Second,
+		MaxRestarts:         5,
 	}
 }
 
+const (
+	aiPluginName     = "qmoe_validator"
+	signerPluginName = "quantum_signer"
+)
+
 // Global plugin manager instance
 var (
 	pluginManager *PluginManager
@@ -44,13 +66,23 @@ var (
 func Instance() *PluginManager {
 	once.Do(func() {
 		pluginManager = &PluginManager{
-			plugins: make(map[string]interface{}),
-			config:  DefaultPluginManagerConfig(),
+			plugins:   make(map[string]interface{}),
+			processes: make(map[string]*Process),
+			config:    DefaultPluginManagerConfig(),
+			logger:    log.NewNopLogger(),
 		}
 	})
 	return pluginManager
 }
 
+// SetLogger sets the logger of the plugin manager, and of the plugin
+// processes it starts.
+func (pm *PluginManager) SetLogger(l log.Logger) {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+	pm.logger = l
+}
+
 // Initialize initializes the plugin manager with configuration
 func (pm *PluginManager) Initialize(config *PluginManagerConfig) error {
 	pm.mutex.Lock()
@@ -71,7 +103,10 @@ func (pm *PluginManager) Initialize(config *PluginManagerConfig) error {
 	return nil
 }
 
-// LoadPlugin loads a plugin from the specified path
+// LoadPlugin loads a plugin from the specified path. If isolation is enabled,
+// the plugin binary at pluginPath is started, and symbolName selects the
+// interface of the returned client: "SignerPlugin" or "AIValidatorPlugin".
+// Otherwise symbolName is looked up in the shared library at pluginPath.
 func (pm *PluginManager) LoadPlugin(pluginPath, symbolName string) (interface{}, error) {
 	pm.mutex.Lock()
 	defer pm.mutex.Unlock()
@@ -86,6 +121,10 @@ func (pm *PluginManager) LoadPlugin(pluginPath, symbolName string) (interface{},
 		return nil, fmt.Errorf("maximum number of plugins (%d) reached", pm.config.MaxPlugins)
 	}
 
+	if pm.config.IsolationEnabled {
+		return pm.loadProcessPlugin(pluginPath, symbolName)
+	}
+
 	// Load the plugin
 	p, err := plugin.Open(pluginPath)
 	if err != nil {
@@ -104,9 +143,48 @@ func (pm *PluginManager) LoadPlugin(pluginPath, symbolName string) (interface{},
 	return sym, nil
 }
 
+// loadProcessPlugin starts the plugin binary at pluginPath, and returns a
+// client of its symbolName interface. It must be called with the mutex held.
+func (pm *PluginManager) loadProcessPlugin(pluginPath, symbolName string) (interface{}, error) {
+	var newClient func(*Process) interface{}
+	switch symbolName {
+	case "SignerPlugin":
+		newClient = func(p *Process) interface{} { return NewSignerClient(p) }
+	case "AIValidatorPlugin":
+		newClient = func(p *Process) interface{} { return NewAIValidatorClient(p) }
+	default:
+		return nil, fmt.Errorf("symbol %s can't be served by a plugin process", symbolName)
+	}
+
+	config := DefaultProcessConfig(pluginPath)
+	if pm.config.HealthCheckInterval > 0 {
+		config.HealthCheckInterval = pm.config.HealthCheckInterval
+	}
+	config.MaxRestarts = pm.config.MaxRestarts
+
+	process := NewProcess(config, pm.logger)
+	if err := process.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start plugin %s: %w", pluginPath, err)
+	}
+
+	client := newClient(process)
+	pm.plugins[pluginPath] = client
+	pm.processes[pluginPath] = process
+	return client, nil
+}
+
+// pluginPath returns the path of the plugin with the given name in the
+// plugin directory: a binary if isolation is enabled, or a shared library.
+func (pm *PluginManager) pluginPath(name string) string {
+	if pm.config.IsolationEnabled {
+		return filepath.Join(pm.config.PluginDirectory, name)
+	}
+	return filepath.Join(pm.config.PluginDirectory, name+".so")
+}
+
 // LoadAIPlugin loads the AI validation plugin
 func (pm *PluginManager) LoadAIPlugin() (AIValidatorPlugin, error) {
-	pluginPath := filepath.Join(pm.config.PluginDirectory, "qmoe_validator.so")
+	pluginPath := pm.pluginPath(aiPluginName)
 
 	plugin, err := pm.LoadPlugin(pluginPath, "AIValidatorPlugin")
 	if err != nil {
@@ -123,7 +201,7 @@ func (pm *PluginManager) LoadAIPlugin() (AIValidatorPlugin, error) {
 
 // LoadSigner loads the quantum signing plugin
 func (pm *PluginManager) LoadSigner() (SignerPlugin, error) {
-	pluginPath := filepath.Join(pm.config.PluginDirectory, "quantum_signer.so")
+	pluginPath := pm.pluginPath(signerPluginName)
 
 	plugin, err := pm.LoadPlugin(pluginPath, "SignerPlugin")
 	if err != nil {
@@ -147,7 +225,8 @@ func (pm *PluginManager) GetPlugin(pluginPath string) (interface{}, bool) {
 	return plugin, exists
 }
 
-// UnloadPlugin unloads a plugin
+// UnloadPlugin unloads a plugin. The process of an isolated plugin is
+// stopped; shared libraries can't be unloaded, and are only forgotten.
 func (pm *PluginManager) UnloadPlugin(pluginPath string) error {
 	pm.mutex.Lock()
 	defer pm.mutex.Unlock()
@@ -157,6 +236,10 @@ func (pm *PluginManager) UnloadPlugin(pluginPath string) error {
 	}
 
 	delete(pm.plugins, pluginPath)
+	if process, ok := pm.processes[pluginPath]; ok {
+		delete(pm.processes, pluginPath)
+		return process.Stop()
+	}
 	return nil
 }
 
@@ -191,6 +274,10 @@ func (pm *PluginManager) GetConfig() *PluginManagerConfig {
 
 // AutoLoadPlugins automatically loads plugins from the plugin directory
 func (pm *PluginManager) autoLoadPlugins() error {
+	if pm.config.IsolationEnabled {
+		return pm.autoLoadProcessPlugins()
+	}
+
 	entries, err := os.ReadDir(pm.config.PluginDirectory)
 	if err != nil {
 		return fmt.Errorf("failed to read plugin directory: %w", err)
@@ -226,6 +313,28 @@ func (pm *PluginManager) autoLoadPlugins() error {
 	return nil
 }
 
+// autoLoadProcessPlugins starts the AI validation and signing plugin binaries
+// present in the plugin directory. It must be called with the mutex held.
+func (pm *PluginManager) autoLoadProcessPlugins() error {
+	plugins := []struct{ name, symbol string }{
+		{aiPluginName, "AIValidatorPlugin"},
+		{signerPluginName, "SignerPlugin"},
+	}
+	for _, p := range plugins {
+		pluginPath := pm.pluginPath(p.name)
+		if _, err := os.Stat(pluginPath); err != nil {
+			continue
+		}
+		if _, exists := pm.plugins[pluginPath]; exists {
+			continue
+		}
+		if _, err := pm.loadProcessPlugin(pluginPath, p.symbol); err != nil {
+			pm.logger.Error("Failed to auto-load plugin", "path", pluginPath, "err", err)
+		}
+	}
+	return nil
+}
+
 // ValidatePlugin validates a plugin before loading
 func (pm *PluginManager) ValidatePlugin(pluginPath string) error {
 	// Check if file exists
@@ -246,8 +355,17 @@ func (pm *PluginManager) ValidatePlugin(pluginPath string) error {
 	return nil
 }
 
-// ReloadPlugin reloads a plugin
+// ReloadPlugin reloads a plugin. An isolated plugin is reloaded in place:
+// a new process of its binary replaces the current one, and the clients
+// returned by LoadPlugin keep working.
 func (pm *PluginManager) ReloadPlugin(pluginPath string) error {
+	pm.mutex.RLock()
+	process, ok := pm.processes[pluginPath]
+	pm.mutex.RUnlock()
+	if ok {
+		return process.Reload()
+	}
+
 	// Unload first
 	if err := pm.UnloadPlugin(pluginPath); err != nil {
 		return err
@@ -302,6 +420,12 @@ func (pm *PluginManager) Shutdown() error {
 	for path := range pm.plugins {
 		delete(pm.plugins, path)
 	}
+	for path, process := range pm.processes {
+		if err := process.Stop(); err != nil {
+			pm.logger.Error("Failed to stop plugin", "path", path, "err", err)
+		}
+		delete(pm.processes, path)
+	}
 
 	return nil
 }
@@ -367,7 +491,7 @@ func (pm *PluginManager) LoadPluginWithConfig(pluginPath, symbolName string, con
 // LoadAIPluginWithConfig loads AI plugin with configuration
 func (pm *PluginManager) LoadAIPluginWithConfig(config map[string]interface{}) (AIValidatorPlugin, error) {
 	plugin, err := pm.LoadPluginWithConfig(
-		filepath.Join(pm.config.PluginDirectory, "qmoe_validator.so"),
+		pm.pluginPath(aiPluginName),
 		"AIValidatorPlugin",
 		config,
 	)
@@ -386,7 +510,7 @@ func (pm *PluginManager) LoadAIPluginWithConfig(config map[string]interface{}) (
 // LoadSignerWithConfig loads signer plugin with configuration
 func (pm *PluginManager) LoadSignerWithConfig(config interface{}) (SignerPlugin, error) {
 	plugin, err := pm.LoadPluginWithConfig(
-		filepath.Join(pm.config.PluginDirectory, "quantum_signer.so"),
+		pm.pluginPath(signerPluginName),
 		"SignerPlugin",
 		config,
 	)
@@ -0,0 +1,448 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/fluentum-chain/fluentum/libs/log"
+)
+
+const (
+	// SocketEnv is the environment variable holding the path of the unix
+	// socket a plugin process must serve on.
+	SocketEnv = "FLUENTUM_PLUGIN_SOCKET"
+
+	// MagicCookieEnv is the environment variable set to MagicCookie by the
+	// node when it starts a plugin process. Plugins refuse to run without it,
+	// as they aren't meant to be started by hand.
+	MagicCookieEnv = "FLUENTUM_PLUGIN_MAGIC_COOKIE"
+
+	// MagicCookie is the value of MagicCookieEnv.
+	MagicCookie = "8b6f5c0e2d4a41c7a3f0e9d1b2c5a7e4"
+)
+
+// ErrProcessStopped is returned when calling a plugin process which was
+// stopped, or failed too many times.
+var ErrProcessStopped = errors.New("plugin process is stopped")
+
+// ProcessConfig is the configuration of a plugin process.
+type ProcessConfig struct {
+	// Path of the plugin binary
+	Path string
+	// Args and Env are passed to the plugin binary
+	Args []string
+	Env  []string
+
+	// StartTimeout is the time the plugin has to serve after being started.
+	StartTimeout time.Duration
+	// CallTimeout bounds the calls of the plugin interfaces which don't take
+	// a context.
+	CallTimeout time.Duration
+	// HealthCheckInterval is the interval between health checks. A plugin
+	// failing a health check is killed and restarted.
+	HealthCheckInterval time.Duration
+	// MaxRestarts is the number of times in a row the plugin is restarted
+	// after crashing, before giving up. A successful health check resets the
+	// count.
+	MaxRestarts int
+}
+
+// DefaultProcessConfig returns the default configuration of the plugin
+// process for the binary at path.
+func DefaultProcessConfig(path string) ProcessConfig {
+	return ProcessConfig{
+		Path:                path,
+		StartTimeout:        10 * time.Second,
+		CallTimeout:         10 * time.Second,
+		HealthCheckInterval: 5 * time.Second,
+		MaxRestarts:         5,
+	}
+}
+
+// RestartHook brings a new process of a plugin to the state of the process it
+// replaces, e.g. by initializing it with the last configuration. It's called
+// with a context bounded by the call timeout, and the connection to the new
+// process.
+type RestartHook func(ctx context.Context, conn *grpc.ClientConn) error
+
+// Process runs a plugin binary as a child process, and connects to the gRPC
+// services it serves. The process is health checked and restarted when it
+// crashes, so a failing plugin never takes the node down with it.
+type Process struct {
+	config ProcessConfig
+	logger log.Logger
+
+	mtx      sync.RWMutex
+	instance *instance
+	restarts int
+	started  bool
+	stopped  bool
+	hooks    []RestartHook
+
+	quit chan struct{}
+	done chan struct{}
+}
+
+// instance is a running plugin process.
+type instance struct {
+	cmd       *exec.Cmd
+	conn      *grpc.ClientConn
+	socketDir string
+	exited    chan struct{}
+
+	// calls is the number of calls in flight
+	calls int64
+}
+
+// NewProcess returns a plugin process with the given config. It must be
+// started with Start.
+func NewProcess(config ProcessConfig, logger log.Logger) *Process {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	return &Process{
+		config: config,
+		logger: logger.With("plugin", filepath.Base(config.Path)),
+		quit:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+// Start starts the plugin process, and waits for it to serve.
+func (p *Process) Start() error {
+	inst, err := p.launch(p.config.Path)
+	if err != nil {
+		return err
+	}
+	p.mtx.Lock()
+	p.instance = inst
+	p.started = true
+	p.mtx.Unlock()
+
+	go p.supervise()
+	return nil
+}
+
+// Stop stops the plugin process.
+func (p *Process) Stop() error {
+	p.mtx.Lock()
+	if p.stopped {
+		p.mtx.Unlock()
+		return nil
+	}
+	p.stopped = true
+	started := p.started
+	inst := p.instance
+	p.instance = nil
+	p.mtx.Unlock()
+
+	close(p.quit)
+	if started {
+		<-p.done
+	}
+	if inst != nil {
+		inst.stop(p.config.StartTimeout)
+	}
+	return nil
+}
+
+// AddRestartHook adds a hook called on every process started to replace the
+// current one, after a crash or by Reload, before it serves the calls of the
+// node. A process failing a hook is stopped, and counts as a failed start.
+func (p *Process) AddRestartHook(hook RestartHook) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.hooks = append(p.hooks, hook)
+}
+
+// Reload starts a new process of the plugin binary, which may have been
+// replaced since, and runs the restart hooks on it. Once it serves, new calls
+// go to the new process, and the current one is stopped after the calls in
+// flight complete, waiting at most CallTimeout for them.
+func (p *Process) Reload() error {
+	inst, err := p.launch(p.config.Path)
+	if err != nil {
+		return fmt.Errorf("reloading plugin: %w", err)
+	}
+	if err := p.runHooks(inst); err != nil {
+		inst.stop(p.config.StartTimeout)
+		return fmt.Errorf("reloading plugin: %w", err)
+	}
+
+	p.mtx.Lock()
+	if p.stopped {
+		p.mtx.Unlock()
+		inst.stop(p.config.StartTimeout)
+		return ErrProcessStopped
+	}
+	old := p.instance
+	p.instance = inst
+	p.restarts = 0
+	p.mtx.Unlock()
+
+	if old != nil {
+		old.drain(p.config.CallTimeout)
+		old.stop(p.config.StartTimeout)
+	}
+	p.logger.Info("Reloaded plugin")
+	return nil
+}
+
+// runHooks runs the restart hooks on the new instance inst.
+func (p *Process) runHooks(inst *instance) error {
+	p.mtx.RLock()
+	hooks := p.hooks
+	p.mtx.RUnlock()
+
+	for _, hook := range hooks {
+		ctx, cancel := p.CallContext()
+		err := hook(ctx, inst.conn)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("restart hook: %w", err)
+		}
+	}
+	return nil
+}
+
+// Conn returns the connection to the current plugin process.
+func (p *Process) Conn() (*grpc.ClientConn, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	if p.instance == nil {
+		return nil, ErrProcessStopped
+	}
+	return p.instance.conn, nil
+}
+
+// CallContext returns a context bounded by CallTimeout, for the calls of
+// plugin interfaces which don't take a context.
+func (p *Process) CallContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), p.config.CallTimeout)
+}
+
+// IsRunning returns whether the plugin process is running.
+func (p *Process) IsRunning() bool {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	return p.instance != nil
+}
+
+// supervise restarts the plugin process when it exits, and kills it when it
+// fails a health check.
+func (p *Process) supervise() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.config.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		p.mtx.RLock()
+		inst := p.instance
+		p.mtx.RUnlock()
+		if inst == nil {
+			return
+		}
+
+		select {
+		case <-p.quit:
+			return
+
+		case <-inst.exited:
+			if !p.restart(inst) {
+				return
+			}
+
+		case <-ticker.C:
+			if err := inst.checkHealth(p.config.CallTimeout); err != nil {
+				p.logger.Error("Plugin failed health check, killing it", "err", err)
+				_ = inst.cmd.Process.Kill()
+				continue
+			}
+			p.mtx.Lock()
+			p.restarts = 0
+			p.mtx.Unlock()
+		}
+	}
+}
+
+// restart replaces the exited instance inst by a new process on which the
+// restart hooks ran, retrying with a backoff up to MaxRestarts times. It
+// returns false if the plugin was stopped, or gave up.
+func (p *Process) restart(inst *instance) bool {
+	for {
+		p.mtx.Lock()
+		if p.instance != inst {
+			// replaced by Reload
+			p.mtx.Unlock()
+			return true
+		}
+		if p.restarts >= p.config.MaxRestarts {
+			p.instance = nil
+			p.mtx.Unlock()
+			inst.stop(0)
+			p.logger.Error("Plugin crashed too many times, giving up", "restarts", p.config.MaxRestarts)
+			return false
+		}
+		p.restarts++
+		backoff := time.Duration(p.restarts) * 500 * time.Millisecond
+		p.mtx.Unlock()
+
+		p.logger.Error("Plugin exited, restarting", "exit", inst.cmd.ProcessState, "backoff", backoff)
+		select {
+		case <-p.quit:
+			return false
+		case <-time.After(backoff):
+		}
+
+		next, err := p.launch(p.config.Path)
+		if err != nil {
+			p.logger.Error("Failed to restart plugin", "err", err)
+			continue
+		}
+		if err := p.runHooks(next); err != nil {
+			next.stop(p.config.StartTimeout)
+			p.logger.Error("Failed to restore restarted plugin", "err", err)
+			continue
+		}
+
+		p.mtx.Lock()
+		if p.instance != inst {
+			p.mtx.Unlock()
+			next.stop(p.config.StartTimeout)
+			return true
+		}
+		p.instance = next
+		p.mtx.Unlock()
+		inst.stop(0)
+		return true
+	}
+}
+
+// launch starts a process of the plugin binary at path, and waits for it to
+// serve.
+func (p *Process) launch(path string) (*instance, error) {
+	socketDir, err := os.MkdirTemp("", "fluentum-plugin-")
+	if err != nil {
+		return nil, err
+	}
+	socket := filepath.Join(socketDir, "plugin.sock")
+
+	cmd := exec.Command(path, p.config.Args...)
+	cmd.Env = append(os.Environ(), p.config.Env...)
+	cmd.Env = append(cmd.Env, SocketEnv+"="+socket, MagicCookieEnv+"="+MagicCookie)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(socketDir)
+		return nil, fmt.Errorf("starting plugin %s: %w", path, err)
+	}
+
+	inst := &instance{cmd: cmd, socketDir: socketDir, exited: make(chan struct{})}
+	go func() {
+		_ = cmd.Wait()
+		close(inst.exited)
+	}()
+
+	inst.conn, err = grpc.NewClient("unix://"+socket,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(inst.countCalls))
+	if err != nil {
+		inst.stop(0)
+		return nil, err
+	}
+	if err := inst.waitServing(p.config.StartTimeout); err != nil {
+		inst.stop(0)
+		return nil, fmt.Errorf("plugin %s: %w", path, err)
+	}
+	return inst, nil
+}
+
+// waitServing waits for the plugin to report it's serving.
+func (inst *instance) waitServing(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		err := inst.checkHealth(time.Until(deadline))
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-inst.exited:
+			return fmt.Errorf("exited before serving: %v", inst.cmd.ProcessState)
+		case <-time.After(50 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("not serving after %v: %w", timeout, err)
+		}
+	}
+}
+
+// countCalls counts the calls in flight on the instance.
+func (inst *instance) countCalls(
+	ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	atomic.AddInt64(&inst.calls, 1)
+	defer atomic.AddInt64(&inst.calls, -1)
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// drain waits for the calls in flight on the instance to complete, at most
+// timeout.
+func (inst *instance) drain(timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for atomic.LoadInt64(&inst.calls) > 0 && time.Now().Before(deadline) {
+		select {
+		case <-inst.exited:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// checkHealth checks the plugin is serving.
+func (inst *instance) checkHealth(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	res, err := healthpb.NewHealthClient(inst.conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if res.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("plugin is %v", res.Status)
+	}
+	return nil
+}
+
+// stop interrupts the process, and kills it if it's still running after
+// timeout.
+func (inst *instance) stop(timeout time.Duration) {
+	if inst.conn != nil {
+		inst.conn.Close()
+	}
+	select {
+	case <-inst.exited:
+	default:
+		if timeout > 0 {
+			_ = inst.cmd.Process.Signal(os.Interrupt)
+			select {
+			case <-inst.exited:
+			case <-time.After(timeout):
+			}
+		}
+		_ = inst.cmd.Process.Kill()
+		<-inst.exited
+	}
+	os.RemoveAll(inst.socketDir)
+}
@@ -214,6 +214,9 @@ type QuantumSigner struct {
 	metrics    *SignerMetrics
 	algorithms map[string]SigningAlgorithm
 	mutex      sync.RWMutex
+
+	// instrumentation exports the metrics to Prometheus
+	instrumentation *Metrics
 }
 
 // SigningAlgorithm represents a signing algorithm
@@ -232,6 +235,8 @@ func NewQuantumSigner(config *SignerConfig) *QuantumSigner {
 		config:     config,
 		metrics:    &SignerMetrics{},
 		algorithms: make(map[string]SigningAlgorithm),
+
+		instrumentation: NopMetrics(),
 	}
 
 	// Register supported algorithms
@@ -332,11 +337,13 @@ func (qs *QuantumSigner) Sign(data []byte) ([]byte, error) {
 	signature, err := algorithm.Sign(data, qs.keyPair.PrivateKey)
 	if err != nil {
 		qs.metrics.ErrorCount++
+		qs.instrumentation.Errors.With("algorithm", qs.keyPair.Algorithm, "operation", "sign").Add(1)
 		return nil, fmt.Errorf("signing failed: %w", err)
 	}
 
 	// Update metrics
 	elapsed := time.Since(start)
+	qs.instrumentation.SignDuration.With("algorithm", qs.keyPair.Algorithm).Observe(elapsed.Seconds())
 	qs.metrics.SignCount++
 	qs.metrics.TotalSignTime += elapsed
 	qs.metrics.AvgSignTime = qs.metrics.TotalSignTime / time.Duration(qs.metrics.SignCount)
@@ -366,11 +373,13 @@ func (qs *QuantumSigner) SignWithAlgorithm(data []byte, algorithmName string) ([
 	signature, err := algorithm.Sign(data, tempKeyPair.PrivateKey)
 	if err != nil {
 		qs.metrics.ErrorCount++
+		qs.instrumentation.Errors.With("algorithm", algorithmName, "operation", "sign").Add(1)
 		return nil, fmt.Errorf("signing failed: %w", err)
 	}
 
 	// Update metrics
 	elapsed := time.Since(start)
+	qs.instrumentation.SignDuration.With("algorithm", algorithmName).Observe(elapsed.Seconds())
 	qs.metrics.SignCount++
 	qs.metrics.TotalSignTime += elapsed
 	qs.metrics.AvgSignTime = qs.metrics.TotalSignTime / time.Duration(qs.metrics.SignCount)
@@ -400,11 +409,13 @@ func (qs *QuantumSigner) Verify(data, signature, publicKey []byte) (bool, error)
 	valid, err := algorithm.Verify(data, signature, publicKey)
 	if err != nil {
 		qs.metrics.ErrorCount++
+		qs.instrumentation.Errors.With("algorithm", algorithmName, "operation", "verify").Add(1)
 		return false, fmt.Errorf("verification failed: %w", err)
 	}
 
 	// Update metrics
 	elapsed := time.Since(start)
+	qs.instrumentation.VerifyDuration.With("algorithm", algorithmName).Observe(elapsed.Seconds())
 	qs.metrics.VerifyCount++
 	qs.metrics.TotalVerifyTime += elapsed
 	qs.metrics.AvgVerifyTime = qs.metrics.TotalVerifyTime / time.Duration(qs.metrics.VerifyCount)
@@ -542,6 +553,35 @@ func (qs *QuantumSigner) GetMetrics() map[string]float64 {
 	}
 }
 
+// Metrics returns a copy of the performance metrics
+func (qs *QuantumSigner) Metrics() SignerMetrics {
+	qs.mutex.RLock()
+	defer qs.mutex.RUnlock()
+
+	return *qs.metrics
+}
+
+// Algorithm returns the algorithm Sign uses, i.e. the algorithm of the key
+// pair, or the configured algorithm if there is no key pair yet
+func (qs *QuantumSigner) Algorithm() string {
+	qs.mutex.RLock()
+	defer qs.mutex.RUnlock()
+
+	if qs.keyPair != nil {
+		return qs.keyPair.Algorithm
+	}
+	return qs.config.Algorithm
+}
+
+// SetMetrics sets the metrics the signer exports to Prometheus. They're
+// not reset by ResetMetrics.
+func (qs *QuantumSigner) SetMetrics(metrics *Metrics) {
+	qs.mutex.Lock()
+	defer qs.mutex.Unlock()
+
+	qs.instrumentation = metrics
+}
+
 // ResetMetrics resets performance metrics
 func (qs *QuantumSigner) ResetMetrics() {
 	qs.mutex.Lock()
@@ -50,6 +50,9 @@ type AIValidator struct {
 	lastBlockTime time.Time
 	blockCount    int64
 	gasSavings    float64
+
+	// instrumentation exports the metrics to Prometheus
+	instrumentation *Metrics
 }
 
 // AIValidatorConfig contains configuration for AI validator
@@ -92,6 +95,7 @@ func NewAIValidator(config *AIValidatorConfig) (*AIValidator, error) {
 		metrics: &ValidatorMetrics{
 			LastUpdate: time.Now(),
 		},
+		instrumentation: NopMetrics(),
 	}
 
 	// Load AI validation plugin
@@ -182,6 +186,12 @@ func (v *AIValidator) ProcessBlock(block *types.Block) error {
 		v.metrics.AIPredictions++
 		v.metrics.AvgPredictionTime = (v.metrics.AvgPredictionTime*time.Duration(v.metrics.AIPredictions-1) + time.Since(start)) / time.Duration(v.metrics.AIPredictions)
 		v.metrics.ModelConfidence = prediction.Confidence
+		v.instrumentation.PredictionDuration.With("method", "predict_batch").Observe(time.Since(start).Seconds())
+		v.instrumentation.PredictionConfidence.Observe(prediction.Confidence)
+		if len(block.Data.Txs) > 0 {
+			v.metrics.BatchEfficiency = float64(len(prediction.OptimalBatch)) / float64(len(block.Data.Txs))
+			v.instrumentation.BatchEfficiency.Set(v.metrics.BatchEfficiency)
+		}
 	}
 
 	// Create optimized batch
@@ -202,6 +212,7 @@ func (v *AIValidator) ProcessBlock(block *types.Block) error {
 		}
 
 		if !valid {
+			v.instrumentation.RejectedBatches.Add(1)
 			return fmt.Errorf("AI validation rejected batch (confidence: %.2f)", confidence)
 		}
 
@@ -284,12 +295,14 @@ func (v *AIValidator) serializeBlock(transactions types.Txs) ([]byte, error) {
 func (v *AIValidator) updateMetrics(start time.Time, prediction *plugin.BatchPrediction) {
 	v.metrics.BlocksProcessed++
 	v.metrics.TransactionsProcessed += int64(len(v.batchQueue))
+	v.instrumentation.BlocksProcessed.Add(1)
 
 	blockTime := time.Since(start)
 	v.metrics.AvgBlockTime = (v.metrics.AvgBlockTime*time.Duration(v.metrics.BlocksProcessed-1) + blockTime) / time.Duration(v.metrics.BlocksProcessed)
 
 	if prediction != nil {
 		v.metrics.TotalGasSaved += uint64(prediction.GasSavings)
+		v.instrumentation.GasSaved.Add(prediction.GasSavings)
 		v.metrics.AvgGasSavings = float64(v.metrics.TotalGasSaved) / float64(v.metrics.BlocksProcessed)
 	}
 
@@ -311,6 +324,14 @@ func (v *AIValidator) GetMetrics() *ValidatorMetrics {
 	return &metrics
 }
 
+// SetMetrics sets the metrics the validator exports to Prometheus.
+func (v *AIValidator) SetMetrics(metrics *Metrics) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.instrumentation = metrics
+}
+
 // GetAIMetrics returns AI-specific metrics
 func (v *AIValidator) GetAIMetrics() map[string]float64 {
 	if v.aiPlugin == nil {
@@ -401,7 +422,9 @@ func (v *AIValidator) StartBatchProcessor(ctx context.Context) {
 	}()
 }
 
-// PredictOptimalBatch predicts the optimal batch composition for given transactions
+// PredictOptimalBatch predicts the optimal batch composition for given transactions.
+// The prediction uses floating point inference and may differ between nodes;
+// blocks are ordered with OrderTxs instead.
 func (v *AIValidator) PredictOptimalBatch(txs types.Txs) (types.Txs, error) {
 	if v.aiPlugin == nil {
 		return txs, fmt.Errorf("AI plugin not available")
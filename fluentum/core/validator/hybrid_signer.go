@@ -18,8 +18,17 @@ type HybridSigner struct {
 	useQuantum    bool
 	mu            sync.RWMutex
 	stats         *HybridSignerStats
+	metrics       *plugin.Metrics
+
+	// quantumAlgorithm caches the algorithm of the quantum signer, getting it
+	// is a call to the plugin process
+	quantumAlgorithm string
 }
 
+// classicAlgorithm is the algorithm label of the classical signatures in the
+// signer metrics.
+const classicAlgorithm = "ed25519"
+
 // HybridSignerStats tracks performance metrics for hybrid signing
 type HybridSignerStats struct {
 	ClassicSignCount   int64         `json:"classic_sign_count"`
@@ -53,6 +62,7 @@ func NewHybridSigner() (*HybridSigner, error) {
 		stats: &HybridSignerStats{
 			LastReset: time.Now(),
 		},
+		metrics: plugin.NopMetrics(),
 	}
 
 	// Try to load quantum signer
@@ -61,7 +71,8 @@ func NewHybridSigner() (*HybridSigner, error) {
 		quantumSigner, err := pm.GetSigner()
 		if err == nil {
 			hs.quantumSigner = quantumSigner
-			fmt.Printf("Hybrid signer initialized with quantum support: %s\n", quantumSigner.AlgorithmName())
+			hs.quantumAlgorithm = quantumSigner.AlgorithmName()
+			fmt.Printf("Hybrid signer initialized with quantum support: %s\n", hs.quantumAlgorithm)
 		} else {
 			fmt.Printf("Warning: Failed to load quantum signer: %v\n", err)
 		}
@@ -84,6 +95,7 @@ func (hs *HybridSigner) Sign(privateKey []byte, message []byte) (*HybridSignatur
 	classicSig := ed25519.Sign(hs.classicSigner.privateKey, message)
 	hs.stats.TotalClassicTime += time.Since(start)
 	hs.stats.ClassicSignCount++
+	hs.metrics.SignDuration.With("algorithm", classicAlgorithm).Observe(time.Since(start).Seconds())
 	signature.ClassicSignature = classicSig
 
 	// Sign with quantum algorithm if available
@@ -91,10 +103,12 @@ func (hs *HybridSigner) Sign(privateKey []byte, message []byte) (*HybridSignatur
 		start := time.Now()
 		quantumSig, err := hs.quantumSigner.Sign(privateKey, message)
 		if err != nil {
+			hs.metrics.Errors.With("algorithm", hs.quantumAlgorithm, "operation", "sign").Add(1)
 			return nil, fmt.Errorf("quantum signing failed: %w", err)
 		}
 		hs.stats.TotalQuantumTime += time.Since(start)
 		hs.stats.QuantumSignCount++
+		hs.metrics.SignDuration.With("algorithm", hs.quantumAlgorithm).Observe(time.Since(start).Seconds())
 		signature.QuantumSignature = quantumSig
 		signature.Mode = "dual"
 	} else {
@@ -172,6 +186,7 @@ func (hs *HybridSigner) Verify(publicKey []byte, message []byte, signature *Hybr
 	classicValid := ed25519.Verify(hs.classicSigner.publicKey, message, signature.ClassicSignature)
 	hs.stats.TotalClassicTime += time.Since(start)
 	hs.stats.ClassicVerifyCount++
+	hs.metrics.VerifyDuration.With("algorithm", classicAlgorithm).Observe(time.Since(start).Seconds())
 
 	if !classicValid {
 		return false, fmt.Errorf("classical signature verification failed")
@@ -183,8 +198,10 @@ func (hs *HybridSigner) Verify(publicKey []byte, message []byte, signature *Hybr
 		quantumValid, err := hs.quantumSigner.Verify(publicKey, message, signature.QuantumSignature)
 		hs.stats.TotalQuantumTime += time.Since(start)
 		hs.stats.QuantumVerifyCount++
+		hs.metrics.VerifyDuration.With("algorithm", hs.quantumAlgorithm).Observe(time.Since(start).Seconds())
 
 		if err != nil {
+			hs.metrics.Errors.With("algorithm", hs.quantumAlgorithm, "operation", "verify").Add(1)
 			return false, fmt.Errorf("quantum signature verification failed: %w", err)
 		}
 
@@ -286,6 +303,15 @@ func (hs *HybridSigner) GetStats() *HybridSignerStats {
 	return &stats
 }
 
+// SetMetrics sets the metrics the signer exports to Prometheus. They're
+// not reset by ResetStats.
+func (hs *HybridSigner) SetMetrics(metrics *plugin.Metrics) {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	hs.metrics = metrics
+}
+
 // ResetStats resets all statistics
 func (hs *HybridSigner) ResetStats() {
 	hs.mu.Lock()
@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "ai_validator"
+)
+
+// Metrics contains the metrics of the AI validator.
+type Metrics struct {
+	// Time the AI model takes to predict a batch or score transactions, in
+	// seconds, labeled by method.
+	PredictionDuration metrics.Histogram
+
+	// Confidence of the batch predictions of the AI model.
+	PredictionConfidence metrics.Histogram
+
+	// Share of the transactions of the last processed block in the batch
+	// predicted by the AI model.
+	BatchEfficiency metrics.Gauge
+
+	// Number of blocks processed by the AI validator.
+	BlocksProcessed metrics.Counter
+
+	// Number of batches rejected by the AI model.
+	RejectedBatches metrics.Counter
+
+	// Gas saved by the predicted batches, as estimated by the AI model.
+	GasSaved metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		PredictionDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "prediction_duration_seconds",
+			Help:      "Time the AI model takes to predict a batch or score transactions, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, append(labels, "method")).With(labelsAndValues...),
+
+		PredictionConfidence: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "prediction_confidence",
+			Help:      "Confidence of the batch predictions of the AI model.",
+			Buckets:   stdprometheus.LinearBuckets(0.1, 0.1, 10),
+		}, labels).With(labelsAndValues...),
+
+		BatchEfficiency: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "batch_efficiency",
+			Help:      "Share of the transactions of the last processed block in the batch predicted by the AI model.",
+		}, labels).With(labelsAndValues...),
+
+		BlocksProcessed: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "blocks_processed",
+			Help:      "Number of blocks processed by the AI validator.",
+		}, labels).With(labelsAndValues...),
+
+		RejectedBatches: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "rejected_batches",
+			Help:      "Number of batches rejected by the AI model.",
+		}, labels).With(labelsAndValues...),
+
+		GasSaved: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gas_saved",
+			Help:      "Gas saved by the predicted batches, as estimated by the AI model.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		PredictionDuration:   discard.NewHistogram(),
+		PredictionConfidence: discard.NewHistogram(),
+		BatchEfficiency:      discard.NewGauge(),
+		BlocksProcessed:      discard.NewCounter(),
+		RejectedBatches:      discard.NewCounter(),
+		GasSaved:             discard.NewCounter(),
+	}
+}
@@ -0,0 +1,70 @@
+package validator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fluentum-chain/fluentum/core/plugin"
+	"github.com/fluentum-chain/fluentum/types"
+)
+
+// ErrNoFixedPointModel is returned by OrderTxs when the AI plugin can't score
+// transactions with fixed point arithmetic.
+var ErrNoFixedPointModel = errors.New("AI plugin does not support fixed point inference")
+
+// OrderTxs orders txs by the fixed point scores of the AI model, and returns
+// them along with the hash of the model. Transactions with equal scores are
+// ordered by hash, so the result only depends on txs and the model weights.
+//
+// OrderTxs returns ErrNoFixedPointModel if the AI plugin doesn't implement
+// plugin.FixedPointScorer. PredictOptimalBatch must not be used instead to
+// build blocks, as its floating point results vary between hosts.
+func (v *AIValidator) OrderTxs(txs types.Txs) (types.Txs, []byte, error) {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	scorer, ok := v.aiPlugin.(plugin.FixedPointScorer)
+	if !ok {
+		return nil, nil, ErrNoFixedPointModel
+	}
+	modelHash := scorer.ModelHash()
+	if len(modelHash) != sha256.Size {
+		return nil, nil, fmt.Errorf("model hash has %d bytes, expected %d", len(modelHash), sha256.Size)
+	}
+
+	start := time.Now()
+	scores, err := scorer.ScoreTransactions(convertTxsToTransactions(txs))
+	if err != nil {
+		return nil, nil, fmt.Errorf("AI scoring failed: %w", err)
+	}
+	v.instrumentation.PredictionDuration.With("method", "score_transactions").Observe(time.Since(start).Seconds())
+	if len(scores) != len(txs) {
+		return nil, nil, fmt.Errorf("AI plugin returned %d scores for %d transactions", len(scores), len(txs))
+	}
+
+	type scoredTx struct {
+		tx    types.Tx
+		key   types.TxKey
+		score int64
+	}
+	scored := make([]scoredTx, len(txs))
+	for i, tx := range txs {
+		scored[i] = scoredTx{tx: tx, key: tx.Key(), score: scores[i]}
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		return bytes.Compare(scored[i].key[:], scored[j].key[:]) < 0
+	})
+
+	ordered := make(types.Txs, len(scored))
+	for i, s := range scored {
+		ordered[i] = s.tx
+	}
+	return ordered, modelHash, nil
+}
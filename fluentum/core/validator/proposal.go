@@ -0,0 +1,136 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/fluentum-chain/fluentum/abci/types"
+	"github.com/fluentum-chain/fluentum/libs/log"
+	"github.com/fluentum-chain/fluentum/types"
+)
+
+// OrderingApplication wraps an ABCI application to order the transactions of
+// the blocks proposed by this node with the AI model.
+//
+// AI ordering is confined to PrepareProposal, i.e. to the proposer. It never
+// changes which transactions the application selected, only their order, and
+// the proposer records the hash of its model in the block. Other validators
+// don't run the model: ProcessProposal only checks invariants that don't
+// depend on it, so validators with different weights, or no AI plugin at
+// all, agree on every block.
+type OrderingApplication struct {
+	abci.Application
+
+	validator *AIValidator
+	logger    log.Logger
+}
+
+var _ abci.Application = (*OrderingApplication)(nil)
+
+// NewOrderingApplication returns app, with the blocks it proposes ordered by
+// the AI model of v.
+func NewOrderingApplication(app abci.Application, v *AIValidator) *OrderingApplication {
+	return &OrderingApplication{
+		Application: app,
+		validator:   v,
+		logger:      log.NewNopLogger(),
+	}
+}
+
+// SetLogger sets the logger.
+func (app *OrderingApplication) SetLogger(l log.Logger) {
+	app.logger = l
+}
+
+// CheckTx implements abci.Application. Ordering records are rejected, as
+// only proposers may add them to blocks.
+func (app *OrderingApplication) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	if types.IsOrderingRecord(req.Tx) {
+		return &abci.CheckTxResponse{
+			Code: abci.CodeTypeUnknownRequest,
+			Log:  "ordering records can only be added by block proposers",
+		}, nil
+	}
+	return app.Application.CheckTx(ctx, req)
+}
+
+// PrepareProposal implements abci.Application. The transactions selected by
+// the application are ordered by the AI model, after an ordering record. If
+// the model fails, the block is proposed in the order of the application.
+// Before the OrderingRecordHeight of the block params, the node drops the
+// record and keeps the order.
+func (app *OrderingApplication) PrepareProposal(
+	ctx context.Context,
+	req *abci.PrepareProposalRequest,
+) (*abci.PrepareProposalResponse, error) {
+	if req.MaxTxBytes < int64(types.OrderingRecordSize) {
+		return app.Application.PrepareProposal(ctx, req)
+	}
+
+	// leave room for the ordering record
+	inner := *req
+	inner.MaxTxBytes -= int64(types.OrderingRecordSize)
+	res, err := app.Application.PrepareProposal(ctx, &inner)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make(types.Txs, 0, len(res.Txs))
+	for _, tx := range res.Txs {
+		if !types.IsOrderingRecord(tx) {
+			txs = append(txs, tx)
+		}
+	}
+
+	ordered, modelHash, err := app.validator.OrderTxs(txs)
+	if err != nil {
+		app.logger.Error("AI ordering failed, proposing transactions in application order",
+			"height", req.Height, "err", err)
+		return &abci.PrepareProposalResponse{Txs: txs.ToSliceOfBytes()}, nil
+	}
+	record, err := types.NewOrderingRecord(modelHash)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Txs = append([][]byte{record}, ordered.ToSliceOfBytes()...)
+	return res, nil
+}
+
+// ProcessProposal implements abci.Application. The node calls it before
+// prevoting a proposal, having stripped the ordering record the block may
+// hold. The proposal is rejected if it breaks an ordering invariant, see
+// checkOrderingInvariants. Otherwise the application processes its
+// transactions, without any ordering record.
+func (app *OrderingApplication) ProcessProposal(
+	ctx context.Context,
+	req *abci.ProcessProposalRequest,
+) (*abci.ProcessProposalResponse, error) {
+	if err := checkOrderingInvariants(req.Txs); err != nil {
+		app.logger.Info("Rejecting proposal", "height", req.Height, "err", err)
+		return &abci.ProcessProposalResponse{Status: abci.ResponseProcessProposal_REJECT}, nil
+	}
+
+	inner := *req
+	inner.Txs = types.StripOrderingRecord(req.Txs)
+	return app.Application.ProcessProposal(ctx, &inner)
+}
+
+// checkOrderingInvariants checks the invariants of the transactions of a
+// proposal, which are cheap to check and don't depend on the AI model:
+//   - an ordering record may only be the first transaction;
+//   - the ordering record is well-formed;
+//   - no transaction appears twice.
+func checkOrderingInvariants(txs [][]byte) error {
+	seen := make(map[types.TxKey]struct{}, len(txs))
+	blockTxs := make(types.Txs, len(txs))
+	for i, tx := range txs {
+		key := types.Tx(tx).Key()
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate transaction at index %d", i)
+		}
+		seen[key] = struct{}{}
+		blockTxs[i] = tx
+	}
+	return types.ValidateOrderingRecord(blockTxs)
+}
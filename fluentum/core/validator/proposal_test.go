@@ -0,0 +1,136 @@
+package validator
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/fluentum-chain/fluentum/abci/types"
+	"github.com/fluentum-chain/fluentum/core/plugin"
+	"github.com/fluentum-chain/fluentum/types"
+)
+
+// fixedPointModel scores transactions by their first byte.
+type fixedPointModel struct {
+	plugin.AIValidatorPlugin
+	weights []byte
+	err     error
+}
+
+func (m *fixedPointModel) ScoreTransactions(transactions []plugin.Transaction) ([]int64, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	scores := make([]int64, len(transactions))
+	for i, tx := range transactions {
+		if data := tx.GetData(); len(data) > 0 {
+			scores[i] = int64(data[0]) * plugin.FixedPointOne
+		}
+	}
+	return scores, nil
+}
+
+func (m *fixedPointModel) ModelHash() []byte {
+	hash := sha256.Sum256(m.weights)
+	return hash[:]
+}
+
+func newTestOrderingApp(model plugin.AIValidatorPlugin) *OrderingApplication {
+	v := &AIValidator{aiPlugin: model, initialized: true, instrumentation: NopMetrics()}
+	return NewOrderingApplication(abci.NewBaseApplication(), v)
+}
+
+func TestOrderTxsIsDeterministic(t *testing.T) {
+	model := &fixedPointModel{weights: []byte("weights")}
+	v := &AIValidator{aiPlugin: model, instrumentation: NopMetrics()}
+
+	txs := types.Txs{{1, 'a'}, {3, 'b'}, {2, 'c'}, {3, 'd'}, {1, 'e'}}
+	ordered, modelHash, err := v.OrderTxs(txs)
+	require.NoError(t, err)
+	assert.Equal(t, model.ModelHash(), modelHash)
+	assert.Equal(t, byte(3), ordered[0][0])
+	assert.Equal(t, byte(3), ordered[1][0])
+	assert.Equal(t, byte(2), ordered[2][0])
+
+	// the order doesn't depend on the order of the input
+	reversed := make(types.Txs, len(txs))
+	for i, tx := range txs {
+		reversed[len(txs)-1-i] = tx
+	}
+	reordered, _, err := v.OrderTxs(reversed)
+	require.NoError(t, err)
+	assert.Equal(t, ordered, reordered)
+
+	_, _, err = (&AIValidator{}).OrderTxs(txs)
+	assert.ErrorIs(t, err, ErrNoFixedPointModel)
+}
+
+func TestOrderingApplicationPrepareProposal(t *testing.T) {
+	model := &fixedPointModel{weights: []byte("weights")}
+	app := newTestOrderingApp(model)
+
+	res, err := app.PrepareProposal(context.Background(), &abci.PrepareProposalRequest{
+		MaxTxBytes: 1024,
+		Txs:        [][]byte{{1, 'a'}, {2, 'b'}},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 3)
+	modelHash, ok := types.ParseOrderingRecord(res.Txs[0])
+	require.True(t, ok)
+	assert.Equal(t, model.ModelHash(), modelHash)
+	assert.Equal(t, []byte{2, 'b'}, res.Txs[1])
+
+	// other validators accept the proposal without running the model
+	other := newTestOrderingApp(nil)
+	processRes, err := other.ProcessProposal(context.Background(), &abci.ProcessProposalRequest{Txs: res.Txs})
+	require.NoError(t, err)
+	assert.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Status)
+
+	// a failing model falls back to the order of the application
+	model.err = errors.New("inference failed")
+	res, err = app.PrepareProposal(context.Background(), &abci.PrepareProposalRequest{
+		MaxTxBytes: 1024,
+		Txs:        [][]byte{{1, 'a'}, {2, 'b'}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{1, 'a'}, {2, 'b'}}, res.Txs)
+}
+
+func TestOrderingApplicationProcessProposal(t *testing.T) {
+	app := newTestOrderingApp(nil)
+	record, err := types.NewOrderingRecord(make([]byte, sha256.Size))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		txs    [][]byte
+		accept bool
+	}{
+		{"no record", [][]byte{{1}, {2}}, true},
+		{"leading record", [][]byte{record, {1}}, true},
+		{"record not first", [][]byte{{1}, record}, false},
+		{"malformed record", [][]byte{record[:len(record)-1], {1}}, false},
+		{"duplicate tx", [][]byte{record, {1}, {1}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := app.ProcessProposal(context.Background(), &abci.ProcessProposalRequest{Txs: tc.txs})
+			require.NoError(t, err)
+			assert.Equal(t, tc.accept, res.Status == abci.ResponseProcessProposal_ACCEPT)
+		})
+	}
+}
+
+func TestOrderingApplicationCheckTx(t *testing.T) {
+	app := newTestOrderingApp(nil)
+	record, err := types.NewOrderingRecord(make([]byte, sha256.Size))
+	require.NoError(t, err)
+
+	checkRes, err := app.CheckTx(context.Background(), &abci.CheckTxRequest{Tx: record})
+	require.NoError(t, err)
+	assert.NotEqual(t, abci.CodeTypeOK, checkRes.Code)
+}
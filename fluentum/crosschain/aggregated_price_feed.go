@@ -0,0 +1,344 @@
+package crosschain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	tmsync "github.com/fluentum-chain/fluentum/libs/sync"
+)
+
+const (
+	defaultMaxQuoteAge  = 15 * time.Minute
+	defaultMaxDeviation = 0.5
+)
+
+var (
+	// ErrStaleQuote is returned for readings older than the maximum quote age.
+	ErrStaleQuote = errors.New("stale price quote")
+	// ErrNotEnoughSources is returned when fewer than the required number of
+	// feeds returned a usable reading.
+	ErrNotEnoughSources = errors.New("not enough price sources")
+)
+
+// AggregatedPriceFeed combines several price feeds and reports the median of
+// their readings. Stale readings, and readings deviating from the median by
+// more than the maximum deviation, are discarded before the median is taken.
+// The aggregate is cached for the update interval of the gas config, and if all feeds fail, the
+// last aggregate is served until it is older than the maximum quote age, so
+// that an outage of one or even all sources doesn't stall gas payments.
+type AggregatedPriceFeed struct {
+	feeds []PriceFeed
+
+	cacheTTL     time.Duration
+	maxQuoteAge  time.Duration
+	maxDeviation float64
+	minSources   int
+
+	mtx    tmsync.Mutex
+	cached *Quote
+	// fetchedAt is when cached was aggregated; cached.Timestamp is the time of
+	// the oldest reading it is based on.
+	fetchedAt time.Time
+}
+
+var (
+	_ PriceFeed        = (*AggregatedPriceFeed)(nil)
+	_ ExchangeRateFeed = (*AggregatedPriceFeed)(nil)
+	_ QuoteFeed        = (*AggregatedPriceFeed)(nil)
+)
+
+// AggregatorOption sets an optional parameter on the AggregatedPriceFeed.
+type AggregatorOption func(*AggregatedPriceFeed)
+
+// WithGasConfig sets the gas config of the chain the feeds quote. Aggregates
+// are served for its UpdateInterval before the feeds are queried again, so
+// that every gas price update the oracle submits is a fresh one.
+func WithGasConfig(config *GasConfig) AggregatorOption {
+	return func(af *AggregatedPriceFeed) { af.cacheTTL = config.UpdateInterval }
+}
+
+// WithMaxQuoteAge sets the age after which readings are considered stale.
+func WithMaxQuoteAge(age time.Duration) AggregatorOption {
+	return func(af *AggregatedPriceFeed) { af.maxQuoteAge = age }
+}
+
+// WithMaxDeviation sets the maximum relative deviation from the median, e.g.
+// 0.2 for 20%, beyond which a reading is discarded as an outlier. Congestion
+// readings, which are fractions already, deviate by their difference to the
+// median.
+func WithMaxDeviation(deviation float64) AggregatorOption {
+	return func(af *AggregatedPriceFeed) { af.maxDeviation = deviation }
+}
+
+// WithMinSources sets the number of feeds that must return a usable reading
+// for an aggregate to be produced.
+func WithMinSources(n int) AggregatorOption {
+	return func(af *AggregatedPriceFeed) { af.minSources = n }
+}
+
+// NewAggregatedPriceFeed creates a price feed aggregating feeds. By default,
+// aggregates are cached for the update interval of DefaultGasConfig,
+// readings expire after 15 minutes,
+// readings more than 50% off the median are discarded and a single usable
+// reading is enough.
+func NewAggregatedPriceFeed(feeds []PriceFeed, options ...AggregatorOption) *AggregatedPriceFeed {
+	af := &AggregatedPriceFeed{
+		feeds:        feeds,
+		cacheTTL:     DefaultGasConfig().UpdateInterval,
+		maxQuoteAge:  defaultMaxQuoteAge,
+		maxDeviation: defaultMaxDeviation,
+		minSources:   1,
+	}
+	for _, option := range options {
+		option(af)
+	}
+	return af
+}
+
+// GetGasPrice implements PriceFeed.
+func (af *AggregatedPriceFeed) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	q, err := af.GetQuote(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return q.GasPrice, nil
+}
+
+// GetNetworkCongestion implements PriceFeed.
+func (af *AggregatedPriceFeed) GetNetworkCongestion(ctx context.Context) (float64, error) {
+	q, err := af.GetQuote(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return q.Congestion, nil
+}
+
+// GetExchangeRate implements ExchangeRateFeed. It returns ErrNoExchangeRate
+// if none of the feeds quote an exchange rate.
+func (af *AggregatedPriceFeed) GetExchangeRate(ctx context.Context) (*big.Rat, error) {
+	q, err := af.GetQuote(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if q.ExchangeRate == nil {
+		return nil, ErrNoExchangeRate
+	}
+	return q.ExchangeRate, nil
+}
+
+// GetQuote implements QuoteFeed. It returns the cached aggregate if it is
+// younger than the cache TTL, and queries the feeds otherwise. The feeds are
+// queried concurrently, without holding the lock, so a slow source delays
+// neither the other sources nor the callers served from the cache.
+func (af *AggregatedPriceFeed) GetQuote(ctx context.Context) (Quote, error) {
+	now := time.Now()
+	af.mtx.Lock()
+	if af.cached != nil && now.Sub(af.fetchedAt) < af.cacheTTL {
+		q := *af.cached
+		af.mtx.Unlock()
+		return q, nil
+	}
+	af.mtx.Unlock()
+
+	q, err := af.aggregate(ctx, now)
+
+	af.mtx.Lock()
+	defer af.mtx.Unlock()
+	if err != nil {
+		if af.cached != nil && now.Sub(af.cached.Timestamp) < af.maxQuoteAge {
+			return *af.cached, nil
+		}
+		return Quote{}, err
+	}
+	// don't replace an aggregate fetched later by a concurrent call
+	if af.cached == nil || !now.Before(af.fetchedAt) {
+		af.cached = &q
+		af.fetchedAt = now
+	}
+	return q, nil
+}
+
+func (af *AggregatedPriceFeed) aggregate(ctx context.Context, now time.Time) (Quote, error) {
+	results := make([]Quote, len(af.feeds))
+	fetchErrs := make([]error, len(af.feeds))
+	var wg sync.WaitGroup
+	for i, feed := range af.feeds {
+		wg.Add(1)
+		go func(i int, feed PriceFeed) {
+			defer wg.Done()
+			results[i], fetchErrs[i] = fetchQuote(ctx, feed, now)
+		}(i, feed)
+	}
+	wg.Wait()
+
+	var (
+		quotes []Quote
+		errs   []error
+	)
+	for i, q := range results {
+		err := fetchErrs[i]
+		if err == nil && now.Sub(q.Timestamp) > af.maxQuoteAge {
+			err = fmt.Errorf("%w from %v", ErrStaleQuote, q.Timestamp)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("feed %d: %w", i, err))
+			continue
+		}
+		quotes = append(quotes, q)
+	}
+
+	gasPrices := make([]*big.Int, 0, len(quotes))
+	congestions := make([]float64, 0, len(quotes))
+	rates := make([]*big.Rat, 0, len(quotes))
+	oldest := now
+	for _, q := range quotes {
+		gasPrices = append(gasPrices, q.GasPrice)
+		congestions = append(congestions, clamp(q.Congestion, 0, 1))
+		if q.ExchangeRate != nil {
+			rates = append(rates, q.ExchangeRate)
+		}
+		if q.Timestamp.Before(oldest) {
+			oldest = q.Timestamp
+		}
+	}
+
+	gasPrices = filterOutliers(gasPrices, af.maxDeviation, func(x *big.Int) *big.Rat { return new(big.Rat).SetInt(x) })
+	if len(gasPrices) == 0 || len(gasPrices) < af.minSources {
+		return Quote{}, fmt.Errorf("%w: got %d, need %d: %v",
+			ErrNotEnoughSources, len(gasPrices), af.minSources, errors.Join(errs...))
+	}
+
+	q := Quote{
+		GasPrice:   medianInt(gasPrices),
+		Congestion: medianFloat(filterCongestionOutliers(congestions, af.maxDeviation)),
+		Timestamp:  oldest,
+	}
+	rates = filterOutliers(rates, af.maxDeviation, func(x *big.Rat) *big.Rat { return x })
+	if len(rates) > 0 {
+		q.ExchangeRate = medianRat(rates)
+	}
+	return q, nil
+}
+
+// fetchQuote reads a quote from feed. Feeds that don't implement QuoteFeed
+// are assumed to return current readings.
+func fetchQuote(ctx context.Context, feed PriceFeed, now time.Time) (Quote, error) {
+	var (
+		q   Quote
+		err error
+	)
+	if qf, ok := feed.(QuoteFeed); ok {
+		q, err = qf.GetQuote(ctx)
+		if err != nil {
+			return Quote{}, err
+		}
+	} else {
+		q.Timestamp = now
+		if q.GasPrice, err = feed.GetGasPrice(ctx); err != nil {
+			return Quote{}, err
+		}
+		if q.Congestion, err = feed.GetNetworkCongestion(ctx); err != nil {
+			return Quote{}, err
+		}
+		if rf, ok := feed.(ExchangeRateFeed); ok {
+			q.ExchangeRate, err = rf.GetExchangeRate(ctx)
+			if err != nil && !errors.Is(err, ErrNoExchangeRate) {
+				return Quote{}, err
+			}
+		}
+	}
+
+	if q.GasPrice == nil || q.GasPrice.Sign() <= 0 {
+		return Quote{}, fmt.Errorf("invalid gas price %v", q.GasPrice)
+	}
+	if q.ExchangeRate != nil && q.ExchangeRate.Sign() <= 0 {
+		return Quote{}, fmt.Errorf("invalid exchange rate %v", q.ExchangeRate)
+	}
+	return q, nil
+}
+
+// filterOutliers drops the values deviating from the median of xs by more
+// than maxDeviation, relative to the median.
+func filterOutliers[T any](xs []T, maxDeviation float64, toRat func(T) *big.Rat) []T {
+	if len(xs) < 3 {
+		// with one or two readings there is no majority to tell the outlier
+		return xs
+	}
+	rats := make([]*big.Rat, len(xs))
+	for i, x := range xs {
+		rats[i] = toRat(x)
+	}
+	median := medianRat(rats)
+	bound := new(big.Rat).SetFloat64(maxDeviation)
+	if bound == nil {
+		return xs
+	}
+	bound.Mul(bound, median)
+
+	kept := xs[:0:0]
+	for i, x := range xs {
+		diff := new(big.Rat).Sub(rats[i], median)
+		if diff.Abs(diff).Cmp(bound) <= 0 {
+			kept = append(kept, x)
+		}
+	}
+	return kept
+}
+
+// filterCongestionOutliers drops the congestion readings deviating from the
+// median of xs by more than maxDeviation. If the readings are split so that
+// none is close to the median, they are all kept.
+func filterCongestionOutliers(xs []float64, maxDeviation float64) []float64 {
+	if len(xs) < 3 {
+		return xs
+	}
+	median := medianFloat(xs)
+	kept := xs[:0:0]
+	for _, x := range xs {
+		if math.Abs(x-median) <= maxDeviation {
+			kept = append(kept, x)
+		}
+	}
+	if len(kept) == 0 {
+		return xs
+	}
+	return kept
+}
+
+func medianInt(xs []*big.Int) *big.Int {
+	sorted := append([]*big.Int(nil), xs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Int).Set(sorted[mid])
+	}
+	sum := new(big.Int).Add(sorted[mid-1], sorted[mid])
+	return sum.Rsh(sum, 1)
+}
+
+func medianRat(xs []*big.Rat) *big.Rat {
+	sorted := append([]*big.Rat(nil), xs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Rat).Set(sorted[mid])
+	}
+	sum := new(big.Rat).Add(sorted[mid-1], sorted[mid])
+	return sum.Quo(sum, big.NewRat(2, 1))
+}
+
+func medianFloat(xs []float64) float64 {
+	sorted := append([]float64(nil), xs...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
@@ -0,0 +1,263 @@
+package crosschain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// stubOracle serves a JSON quote and counts the requests it receives.
+type stubOracle struct {
+	*httptest.Server
+	body     atomic.Value
+	requests atomic.Int32
+	down     atomic.Bool
+}
+
+func newStubOracle(t *testing.T, body string) *stubOracle {
+	so := &stubOracle{}
+	so.body.Store(body)
+	so.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		so.requests.Add(1)
+		if so.down.Load() {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, so.body.Load().(string))
+	}))
+	t.Cleanup(so.Close)
+	return so
+}
+
+func quoteJSON(gasPrice int64, congestion float64, rate string, ts time.Time) string {
+	return fmt.Sprintf(`{"gas_price":"%d","congestion":%v,"exchange_rate":"%s","timestamp":%d}`,
+		gasPrice, congestion, rate, ts.Unix())
+}
+
+func TestJSONPriceFeed(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	oracle := newStubOracle(t, quoteJSON(25000000000, 0.4, "1/1000000000", now))
+
+	q, err := NewJSONPriceFeed(oracle.URL, nil).GetQuote(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get quote: %v", err)
+	}
+	if q.GasPrice.Cmp(big.NewInt(25000000000)) != 0 || q.Congestion != 0.4 ||
+		q.ExchangeRate.Cmp(big.NewRat(1, 1000000000)) != 0 || !q.Timestamp.Equal(now) {
+		t.Errorf("unexpected quote %+v", q)
+	}
+
+	path := filepath.Join(t.TempDir(), "quote.json")
+	if err := os.WriteFile(path, []byte(`{"gas_price":"30000000000","congestion":0.1}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	feed := NewJSONPriceFeed("file://"+path, nil)
+	gasPrice, err := feed.GetGasPrice(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get gas price: %v", err)
+	}
+	if gasPrice.Cmp(big.NewInt(30000000000)) != 0 {
+		t.Errorf("unexpected gas price %s", gasPrice)
+	}
+	if _, err := feed.GetExchangeRate(context.Background()); !errors.Is(err, ErrNoExchangeRate) {
+		t.Errorf("expected ErrNoExchangeRate, got %v", err)
+	}
+
+	oracle.body.Store(`{"gas_price":"twenty","congestion":0.1}`)
+	if _, err := NewJSONPriceFeed(oracle.URL, nil).GetQuote(context.Background()); err == nil {
+		t.Error("expected error for malformed gas price")
+	}
+
+	oracle.down.Store(true)
+	if _, err := NewJSONPriceFeed(oracle.URL, nil).GetQuote(context.Background()); err == nil {
+		t.Error("expected error from unavailable oracle")
+	}
+}
+
+func TestAggregatedPriceFeedMedian(t *testing.T) {
+	now := time.Now()
+	feeds := []PriceFeed{
+		NewJSONPriceFeed(newStubOracle(t, quoteJSON(20000000000, 0.2, "1/1000000000", now)).URL, nil),
+		NewJSONPriceFeed(newStubOracle(t, quoteJSON(22000000000, 0.3, "1/1100000000", now)).URL, nil),
+		NewJSONPriceFeed(newStubOracle(t, quoteJSON(21000000000, 0.4, "1/1050000000", now)).URL, nil),
+		// outlier, discarded before taking the median
+		NewJSONPriceFeed(newStubOracle(t, quoteJSON(900000000000, 1, "1", now)).URL, nil),
+		// stale reading
+		NewJSONPriceFeed(newStubOracle(t, quoteJSON(1, 0, "", now.Add(-time.Hour))).URL, nil),
+		// unreachable source
+		NewJSONPriceFeed("http://127.0.0.1:0", nil),
+	}
+
+	af := NewAggregatedPriceFeed(feeds, WithMaxDeviation(0.2), WithMinSources(3))
+	q, err := af.GetQuote(context.Background())
+	if err != nil {
+		t.Fatalf("failed to aggregate: %v", err)
+	}
+	if q.GasPrice.Cmp(big.NewInt(21000000000)) != 0 {
+		t.Errorf("unexpected median gas price %s", q.GasPrice)
+	}
+	if q.ExchangeRate.Cmp(big.NewRat(1, 1050000000)) != 0 {
+		t.Errorf("unexpected median exchange rate %s", q.ExchangeRate)
+	}
+	// the congestion outlier is discarded too: median of 0.2, 0.3 and 0.4
+	if q.Congestion != 0.3 {
+		t.Errorf("unexpected median congestion %v", q.Congestion)
+	}
+
+	af = NewAggregatedPriceFeed(feeds, WithMaxDeviation(0.2), WithMinSources(4))
+	if _, err := af.GetQuote(context.Background()); !errors.Is(err, ErrNotEnoughSources) {
+		t.Errorf("expected ErrNotEnoughSources, got %v", err)
+	}
+}
+
+func TestAggregatedPriceFeedCaching(t *testing.T) {
+	oracle := newStubOracle(t, quoteJSON(20000000000, 0.5, "1/1000000000", time.Now()))
+	af := NewAggregatedPriceFeed(
+		[]PriceFeed{NewJSONPriceFeed(oracle.URL, nil)},
+		WithGasConfig(&GasConfig{UpdateInterval: time.Minute}),
+	)
+
+	for i := 0; i < 3; i++ {
+		if _, err := af.GetGasPrice(context.Background()); err != nil {
+			t.Fatalf("failed to get gas price: %v", err)
+		}
+	}
+	if n := oracle.requests.Load(); n != 1 {
+		t.Errorf("expected 1 request within the cache TTL, got %d", n)
+	}
+
+	// after the TTL, an outage is bridged with the last aggregate
+	af.fetchedAt = time.Now().Add(-2 * time.Minute)
+	oracle.down.Store(true)
+	gasPrice, err := af.GetGasPrice(context.Background())
+	if err != nil {
+		t.Fatalf("expected cached gas price during outage, got %v", err)
+	}
+	if gasPrice.Cmp(big.NewInt(20000000000)) != 0 {
+		t.Errorf("unexpected gas price %s", gasPrice)
+	}
+
+	// until the aggregate itself is stale
+	af.cached.Timestamp = time.Now().Add(-time.Hour)
+	if _, err := af.GetGasPrice(context.Background()); !errors.Is(err, ErrNotEnoughSources) {
+		t.Errorf("expected ErrNotEnoughSources, got %v", err)
+	}
+}
+
+// blockingFeed is a price feed whose first reading blocks until release is
+// closed. Its readings quote gas prices of 1, 2, 3...
+type blockingFeed struct {
+	calls   atomic.Int64
+	started chan struct{}
+	release chan struct{}
+}
+
+func (f *blockingFeed) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	n := f.calls.Add(1)
+	if n == 1 {
+		close(f.started)
+		<-f.release
+	}
+	return big.NewInt(n), nil
+}
+
+func (f *blockingFeed) GetNetworkCongestion(context.Context) (float64, error) { return 0.5, nil }
+
+// barrierFeed is a price feed whose readings wait for all the feeds sharing
+// its barrier to be read.
+type barrierFeed struct {
+	barrier *sync.WaitGroup
+}
+
+func (f barrierFeed) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	f.barrier.Done()
+	done := make(chan struct{})
+	go func() {
+		f.barrier.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return big.NewInt(20000000000), nil
+	case <-time.After(5 * time.Second):
+		return nil, errors.New("feeds not read concurrently")
+	}
+}
+
+func (f barrierFeed) GetNetworkCongestion(context.Context) (float64, error) { return 0.5, nil }
+
+func TestAggregatedPriceFeedConcurrency(t *testing.T) {
+	barrier := new(sync.WaitGroup)
+	barrier.Add(3)
+	feeds := []PriceFeed{barrierFeed{barrier}, barrierFeed{barrier}, barrierFeed{barrier}}
+	af := NewAggregatedPriceFeed(feeds, WithMinSources(3))
+	if _, err := af.GetQuote(context.Background()); err != nil {
+		t.Fatalf("failed to aggregate: %v", err)
+	}
+
+	// a slow source doesn't hold the lock
+	slow := &blockingFeed{started: make(chan struct{}), release: make(chan struct{})}
+	af = NewAggregatedPriceFeed([]PriceFeed{slow})
+	first := make(chan error, 1)
+	go func() {
+		_, err := af.GetQuote(context.Background())
+		first <- err
+	}()
+	<-slow.started
+
+	gasPrice, err := af.GetGasPrice(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get gas price: %v", err)
+	}
+	if gasPrice.Int64() != 2 {
+		t.Errorf("expected the second reading, got %s", gasPrice)
+	}
+
+	close(slow.release)
+	if err := <-first; err != nil {
+		t.Fatalf("failed to get quote: %v", err)
+	}
+	// the first call started before the second, and doesn't replace its
+	// aggregate
+	if q, _ := af.GetQuote(context.Background()); q.GasPrice.Int64() != 2 {
+		t.Errorf("expected the cached aggregate of the second reading, got %s", q.GasPrice)
+	}
+}
+
+func TestGasAbstractionWithAggregatedPriceFeed(t *testing.T) {
+	now := time.Now()
+	down := newStubOracle(t, quoteJSON(20000000000, 0.5, "1/1000000000", now))
+	down.down.Store(true)
+	af := NewAggregatedPriceFeed([]PriceFeed{
+		NewJSONPriceFeed(down.URL, nil),
+		NewJSONPriceFeed(newStubOracle(t, quoteJSON(20000000000, 0.5, "1/1000000000", now)).URL, nil),
+	})
+
+	update, err := FetchGasPriceUpdate(context.Background(), af)
+	if err != nil {
+		t.Fatalf("gas price update failed with one source down: %v", err)
+	}
+
+	ga, ctx := newTestGasAbstraction(newMockBankKeeper())
+	if err := ga.UpdateGasPrice(ctx, updateMsg(update)); err != nil {
+		t.Fatal(err)
+	}
+	config, err := ga.getGasConfig(ctx, "ethereum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.ExchangeRate.Cmp(big.NewRat(1, 1000000000)) != 0 || !config.GasMultiplier.Equal(sdkmath.LegacyMustNewDecFromStr("1.1")) {
+		t.Errorf("unexpected gas config %+v", config)
+	}
+}
@@ -2,85 +2,334 @@ package crosschain
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math/big"
+	"strconv"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
 	"github.com/fluentum-chain/fluentum/types"
 )
 
-// GasConfig holds configuration for gas costs on different chains
+// FLUMXDenom is the base denomination gas payments are collected in.
+const FLUMXDenom = "uflumx"
+
+// Event type and attribute keys of the event emitted for every gas payment.
+// All attributes are indexed, so payments can be searched by sender or
+// target chain, e.g. "crosschain_gas_payment.chain_id='ethereum'".
+const (
+	EventTypeGasPayment = "crosschain_gas_payment"
+
+	AttributeKeySender   = "sender"
+	AttributeKeyChainID  = "chain_id"
+	AttributeKeyGasLimit = "gas_limit"
+	AttributeKeyGasPrice = "gas_price"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyTxHash   = "tx_hash"
+)
+
+var (
+	// ErrNoExchangeRate is returned when the chain's GasConfig has no FLUMX
+	// exchange rate for the native gas token.
+	ErrNoExchangeRate = errors.New("no FLUMX exchange rate for chain")
+	// ErrGasCostTooHigh is returned when the gas cost exceeds
+	// GasConfig.MaxGasAllowed.
+	ErrGasCostTooHigh = errors.New("gas cost exceeds the maximum allowed")
+	// ErrStaleGasConfig is returned when the gas prices of a chain weren't
+	// updated within GasConfig.UpdateInterval of the block time.
+	ErrStaleGasConfig = errors.New("gas config is stale")
+	// ErrUnauthorizedOracle is returned when a gas price update isn't signed
+	// by the gas price oracle.
+	ErrUnauthorizedOracle = errors.New("signer is not the gas price oracle")
+)
+
+// gasConfigKeyPrefix prefixes the store keys of the chains' gas configs.
+var gasConfigKeyPrefix = []byte("gas_config/")
+
+// BankKeeper defines the bank functionality needed to collect gas payments.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// GasConfig holds configuration for gas costs on different chains. It is
+// kept in the state, and only changes with SetGasConfig and UpdateGasPrice.
 type GasConfig struct {
-	BaseGasPrice   *big.Int
-	GasMultiplier  float64
-	MinGasRequired *big.Int
-	MaxGasAllowed  *big.Int
-	UpdateInterval time.Duration
-	LastUpdate     time.Time
+	BaseGasPrice   *big.Int          `json:"base_gas_price"`
+	GasMultiplier  sdkmath.LegacyDec `json:"gas_multiplier"`
+	MinGasRequired *big.Int          `json:"min_gas_required,omitempty"`
+	MaxGasAllowed  *big.Int          `json:"max_gas_allowed,omitempty"`
+	UpdateInterval time.Duration     `json:"update_interval"`
+	LastUpdate     time.Time         `json:"last_update"`
+
+	// ExchangeRate is the price of one unit of the chain's native gas token
+	// (e.g. wei) in uflumx.
+	ExchangeRate *big.Rat `json:"exchange_rate,omitempty"`
+}
+
+// DefaultGasConfig returns the gas configuration of chains without one. It
+// has no exchange rate, so payments fail until the first UpdateGasPrice.
+func DefaultGasConfig() *GasConfig {
+	return &GasConfig{
+		BaseGasPrice:   big.NewInt(20000000000),                // 20 Gwei
+		GasMultiplier:  sdkmath.LegacyMustNewDecFromStr("1.1"), // 10% buffer
+		MinGasRequired: big.NewInt(1000000000),                 // 1 FLUX
+		MaxGasAllowed:  big.NewInt(10000000000),                // 10 FLUX
+		UpdateInterval: 5 * time.Minute,
+	}
+}
+
+// GasPriceUpdate is a reading of a chain's gas market, submitted by the gas
+// price oracle in a transaction. ExchangeRate is nil if the oracle doesn't
+// quote the native token in FLUMX.
+type GasPriceUpdate struct {
+	GasPrice     *big.Int
+	Congestion   sdkmath.LegacyDec
+	ExchangeRate *big.Rat
+}
+
+// MsgUpdateGasPrice submits a gas price update for ChainID. It must be signed
+// by Oracle, the gas price oracle of the GasAbstraction applying it.
+type MsgUpdateGasPrice struct {
+	Oracle  sdk.AccAddress
+	ChainID string
+	Update  GasPriceUpdate
+}
+
+// ValidateBasic performs the stateless checks of the message.
+func (msg MsgUpdateGasPrice) ValidateBasic() error {
+	if msg.Oracle.Empty() {
+		return errors.New("empty oracle")
+	}
+	if msg.ChainID == "" {
+		return errors.New("empty chain ID")
+	}
+	update := msg.Update
+	if update.GasPrice == nil || update.GasPrice.Sign() <= 0 {
+		return fmt.Errorf("invalid gas price %v", update.GasPrice)
+	}
+	if update.Congestion.IsNil() {
+		return errors.New("missing network congestion")
+	}
+	if update.ExchangeRate != nil && update.ExchangeRate.Sign() <= 0 {
+		return fmt.Errorf("invalid exchange rate %v", update.ExchangeRate)
+	}
+	return nil
 }
 
-// GasAbstraction handles cross-chain gas payments using FLUMX tokens
+// CrossChainTx is a transaction to be executed on another chain, whose gas
+// is paid for in FLUMX by Sender.
+type CrossChainTx struct {
+	Sender   sdk.AccAddress
+	GasLimit uint64
+	Tx       types.Tx
+}
+
+// GasAbstraction handles cross-chain gas payments using FLUMX tokens. The gas
+// configs are kept in the store of storeKey, and never fetched from price
+// feeds during execution: the oracle fetches them with FetchGasPriceUpdate,
+// and submits them in MsgUpdateGasPrice transactions applied by
+// UpdateGasPrice.
 type GasAbstraction struct {
-	configs    map[string]*GasConfig
-	priceFeeds map[string]PriceFeed
+	storeKey   storetypes.StoreKey
+	bankKeeper BankKeeper
+	oracle     sdk.AccAddress
 }
 
-// NewGasAbstraction creates a new gas abstraction handler
-func NewGasAbstraction() *GasAbstraction {
+// NewGasAbstraction creates a new gas abstraction handler, whose gas prices
+// are updated by oracle. Gas payments are sent to the fee collector module
+// account.
+func NewGasAbstraction(storeKey storetypes.StoreKey, bankKeeper BankKeeper, oracle sdk.AccAddress) *GasAbstraction {
 	return &GasAbstraction{
-		configs:    make(map[string]*GasConfig),
-		priceFeeds: make(map[string]PriceFeed),
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+		oracle:     oracle,
 	}
 }
 
-// PayGasWithFLUMX handles gas payment using FLUMX tokens
-func (ga *GasAbstraction) PayGasWithFLUMX(ctx context.Context, tx types.Tx, chainID string) error {
-	// TODO: Implement gas payment logic
-	// For now, just return success
+// SetGasConfig sets the gas configuration for a chain. ctx must wrap an
+// sdk.Context.
+func (ga *GasAbstraction) SetGasConfig(ctx context.Context, chainID string, config *GasConfig) error {
+	if config.BaseGasPrice == nil || config.BaseGasPrice.Sign() <= 0 {
+		return fmt.Errorf("invalid base gas price %v for %s", config.BaseGasPrice, chainID)
+	}
+	if config.GasMultiplier.IsNil() || !config.GasMultiplier.IsPositive() {
+		return fmt.Errorf("invalid gas multiplier %v for %s", config.GasMultiplier, chainID)
+	}
+	if config.ExchangeRate != nil && config.ExchangeRate.Sign() <= 0 {
+		return fmt.Errorf("invalid exchange rate %v for %s", config.ExchangeRate, chainID)
+	}
+
+	bz, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	store := sdk.UnwrapSDKContext(ctx).KVStore(ga.storeKey)
+	store.Set(gasConfigKey(chainID), bz)
 	return nil
 }
 
-// calculateGasCost calculates the gas cost in FLUMX tokens
-func (ga *GasAbstraction) calculateGasCost(ctx context.Context, tx types.Tx, chainID string, config *GasConfig) (*big.Int, error) {
-	// TODO: Implement gas cost calculation
-	return big.NewInt(0), nil
-}
-
-// getGasConfig returns the gas configuration for a chain
-func (ga *GasAbstraction) getGasConfig(chainID string) (*GasConfig, error) {
-	config, exists := ga.configs[chainID]
-	if !exists {
-		// Create default config
-		config = &GasConfig{
-			BaseGasPrice:   big.NewInt(20000000000), // 20 Gwei
-			GasMultiplier:  1.1,                     // 10% buffer
-			MinGasRequired: big.NewInt(1000000000),  // 1 FLUX
-			MaxGasAllowed:  big.NewInt(10000000000), // 10 FLUX
-			UpdateInterval: 5 * time.Minute,
-			LastUpdate:     time.Now(),
-		}
-		ga.configs[chainID] = config
+// GasConfig returns the gas configuration for a chain, or the default one if
+// it has none. ctx must wrap an sdk.Context.
+func (ga *GasAbstraction) GasConfig(ctx context.Context, chainID string) (*GasConfig, error) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(ga.storeKey)
+	bz := store.Get(gasConfigKey(chainID))
+	if bz == nil {
+		return DefaultGasConfig(), nil
 	}
+	var config GasConfig
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode gas config of %s: %w", chainID, err)
+	}
+	return &config, nil
+}
 
-	// Update config if needed
-	if time.Since(config.LastUpdate) > config.UpdateInterval {
-		err := ga.updateGasConfig(chainID, config)
-		if err != nil {
-			return nil, err
-		}
+// UpdateGasPrice applies the gas price update of msg to the gas
+// configuration of its chain, as of the block time. The gas multiplier grows
+// linearly from 1.0 to 1.2 with the network congestion. msg must be signed by
+// the oracle of ga, or ErrUnauthorizedOracle is returned. ctx must wrap an
+// sdk.Context.
+func (ga *GasAbstraction) UpdateGasPrice(ctx context.Context, msg MsgUpdateGasPrice) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if ga.oracle.Empty() || !msg.Oracle.Equals(ga.oracle) {
+		return fmt.Errorf("%w: expected %s, got %s", ErrUnauthorizedOracle, ga.oracle, msg.Oracle)
 	}
+	chainID, update := msg.ChainID, msg.Update
 
-	return config, nil
+	config, err := ga.GasConfig(ctx, chainID)
+	if err != nil {
+		return err
+	}
+	congestion := sdkmath.LegacyMinDec(sdkmath.LegacyMaxDec(update.Congestion, sdkmath.LegacyZeroDec()), sdkmath.LegacyOneDec())
+
+	config.BaseGasPrice = new(big.Int).Set(update.GasPrice)
+	config.GasMultiplier = sdkmath.LegacyOneDec().Add(congestion.Mul(sdkmath.LegacyMustNewDecFromStr("0.2")))
+	if update.ExchangeRate != nil {
+		config.ExchangeRate = new(big.Rat).Set(update.ExchangeRate)
+	}
+	config.LastUpdate = sdk.UnwrapSDKContext(ctx).BlockTime()
+	return ga.SetGasConfig(ctx, chainID, config)
 }
 
-// updateGasConfig updates the gas configuration for a chain
-func (ga *GasAbstraction) updateGasConfig(chainID string, config *GasConfig) error {
-	// TODO: Implement gas config update logic
-	config.LastUpdate = time.Now()
-	return nil
+// PayGasWithFLUMX charges tx.Sender the FLUMX equivalent of tx.GasLimit gas
+// on chainID, sends it to the fee collector and emits an
+// EventTypeGasPayment event. ctx must wrap an sdk.Context.
+func (ga *GasAbstraction) PayGasWithFLUMX(ctx context.Context, tx CrossChainTx, chainID string) error {
+	if tx.Sender.Empty() {
+		return errors.New("empty sender")
+	}
+	if tx.GasLimit == 0 {
+		return errors.New("zero gas limit")
+	}
+
+	config, err := ga.getGasConfig(ctx, chainID)
+	if err != nil {
+		return fmt.Errorf("failed to get gas config for %s: %w", chainID, err)
+	}
+	gasCost, err := ga.calculateGasCost(ctx, tx, chainID, config)
+	if err != nil {
+		return err
+	}
+
+	fee := sdk.NewCoins(sdk.NewCoin(FLUMXDenom, sdkmath.NewIntFromBigInt(gasCost)))
+	if err := ga.bankKeeper.SendCoinsFromAccountToModule(ctx, tx.Sender, authtypes.FeeCollectorName, fee); err != nil {
+		return fmt.Errorf("failed to collect gas payment of %s from %s: %w", fee, tx.Sender, err)
+	}
+
+	return ga.emitGasPaymentEvent(ctx, tx, chainID, config.BaseGasPrice, gasCost)
+}
+
+// calculateGasCost calculates the gas cost in FLUMX tokens: the gas limit
+// times the chain's gas price, converted at the exchange rate and scaled by
+// the gas multiplier. The result is rounded up and raised to MinGasRequired;
+// a cost above MaxGasAllowed is rejected.
+func (ga *GasAbstraction) calculateGasCost(ctx context.Context, tx CrossChainTx, chainID string, config *GasConfig) (*big.Int, error) {
+	if config.ExchangeRate == nil {
+		return nil, fmt.Errorf("%w %s", ErrNoExchangeRate, chainID)
+	}
+	if config.GasMultiplier.IsNil() || !config.GasMultiplier.IsPositive() {
+		return nil, fmt.Errorf("invalid gas multiplier %v for %s", config.GasMultiplier, chainID)
+	}
+
+	nativeCost := new(big.Int).Mul(new(big.Int).SetUint64(tx.GasLimit), config.BaseGasPrice)
+	multiplier := new(big.Rat).SetFrac(config.GasMultiplier.BigInt(), sdkmath.LegacyOneDec().BigInt())
+
+	cost := new(big.Rat).SetInt(nativeCost)
+	cost.Mul(cost, config.ExchangeRate)
+	cost.Mul(cost, multiplier)
+
+	// round up, so the payment always covers the gas
+	gasCost := new(big.Int).Quo(cost.Num(), cost.Denom())
+	if new(big.Int).Mul(gasCost, cost.Denom()).Cmp(cost.Num()) != 0 {
+		gasCost.Add(gasCost, big.NewInt(1))
+	}
+
+	if config.MinGasRequired != nil && gasCost.Cmp(config.MinGasRequired) < 0 {
+		gasCost.Set(config.MinGasRequired)
+	}
+	if config.MaxGasAllowed != nil && gasCost.Cmp(config.MaxGasAllowed) > 0 {
+		return nil, fmt.Errorf("%w on %s: %s > %s%s", ErrGasCostTooHigh, chainID, gasCost, config.MaxGasAllowed, FLUMXDenom)
+	}
+
+	return gasCost, nil
+}
+
+// getGasConfig returns the gas configuration for a chain, checking it was
+// updated within its update interval of the block time.
+func (ga *GasAbstraction) getGasConfig(ctx context.Context, chainID string) (*GasConfig, error) {
+	config, err := ga.GasConfig(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if config.ExchangeRate == nil {
+		return nil, fmt.Errorf("%w %s", ErrNoExchangeRate, chainID)
+	}
+	if config.UpdateInterval > 0 {
+		blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+		if blockTime.Sub(config.LastUpdate) > config.UpdateInterval {
+			return nil, fmt.Errorf("%w: last updated at %v, block time %v", ErrStaleGasConfig, config.LastUpdate, blockTime)
+		}
+	}
+	return config, nil
 }
 
 // emitGasPaymentEvent emits a cross-chain gas payment event
-func (ga *GasAbstraction) emitGasPaymentEvent(ctx context.Context, tx types.Tx, chainID string, gasCost *big.Int) error {
-	// TODO: Implement event emission
+func (ga *GasAbstraction) emitGasPaymentEvent(
+	ctx context.Context,
+	tx CrossChainTx,
+	chainID string,
+	gasPrice, gasCost *big.Int,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeGasPayment,
+		sdk.NewAttribute(AttributeKeySender, tx.Sender.String()),
+		sdk.NewAttribute(AttributeKeyChainID, chainID),
+		sdk.NewAttribute(AttributeKeyGasLimit, strconv.FormatUint(tx.GasLimit, 10)),
+		sdk.NewAttribute(AttributeKeyGasPrice, gasPrice.String()),
+		sdk.NewAttribute(AttributeKeyAmount, gasCost.String()+FLUMXDenom),
+		sdk.NewAttribute(AttributeKeyTxHash, fmt.Sprintf("%X", tx.Tx.Hash())),
+	))
 	return nil
 }
+
+func clamp(v, lo, hi float64) float64 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+func gasConfigKey(chainID string) []byte {
+	return append(append([]byte{}, gasConfigKeyPrefix...), chainID...)
+}
@@ -7,53 +7,48 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
 	"github.com/fluentum-chain/fluentum/types"
 )
 
-type mockKeeper struct {
-	balances map[string]*big.Int
-	events   []types.GasPaymentEvent
-}
-
-func newMockKeeper() *mockKeeper {
-	return &mockKeeper{
-		balances: make(map[string]*big.Int),
-		events:   make([]types.GasPaymentEvent, 0),
-	}
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	modules  map[string]sdk.Coins
 }
 
-func (mk *mockKeeper) GetBalance(ctx context.Context, address string) *big.Int {
-	if balance, exists := mk.balances[address]; exists {
-		return balance
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{
+		balances: make(map[string]sdk.Coins),
+		modules:  make(map[string]sdk.Coins),
 	}
-	return big.NewInt(0)
-}
-
-func (mk *mockKeeper) BurnTokens(ctx context.Context, address string, amount *big.Int) error {
-	balance := mk.GetBalance(ctx, address)
-	if balance.Cmp(amount) < 0 {
-		return errors.New("insufficient balance")
-	}
-	mk.balances[address] = new(big.Int).Sub(balance, amount)
-	return nil
-}
-
-func (mk *mockKeeper) MintTokens(ctx context.Context, address string, amount *big.Int) error {
-	balance := mk.GetBalance(ctx, address)
-	mk.balances[address] = new(big.Int).Add(balance, amount)
-	return nil
 }
 
-func (mk *mockKeeper) EmitEvent(ctx context.Context, eventType string, event interface{}) error {
-	if eventType == "gas_payment" {
-		mk.events = append(mk.events, event.(types.GasPaymentEvent))
+func (mk *mockBankKeeper) SendCoinsFromAccountToModule(
+	ctx context.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	balance := mk.balances[senderAddr.String()]
+	newBalance, negative := balance.SafeSub(amt...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds
 	}
+	mk.balances[senderAddr.String()] = newBalance
+	mk.modules[recipientModule] = mk.modules[recipientModule].Add(amt...)
 	return nil
 }
 
 type mockPriceFeed struct {
 	gasPrice    *big.Int
 	congestion  float64
+	rate        *big.Rat
 	shouldError bool
 }
 
@@ -61,6 +56,7 @@ func newMockPriceFeed() *mockPriceFeed {
 	return &mockPriceFeed{
 		gasPrice:   big.NewInt(20000000000), // 20 Gwei
 		congestion: 0.5,
+		rate:       big.NewRat(1, 1000000000), // 1 ETH = 1000 FLUMX
 	}
 }
 
@@ -78,90 +74,197 @@ func (mpf *mockPriceFeed) GetNetworkCongestion(ctx context.Context) (float64, er
 	return mpf.congestion, nil
 }
 
-func TestGasAbstraction(t *testing.T) {
-	keeper := newMockKeeper()
-	priceFeed := newMockPriceFeed()
+func (mpf *mockPriceFeed) GetExchangeRate(ctx context.Context) (*big.Rat, error) {
+	if mpf.shouldError {
+		return nil, errors.New("mock error")
+	}
+	return mpf.rate, nil
+}
 
-	ga := NewGasAbstraction(keeper)
-	ga.priceFeeds["ethereum"] = priceFeed
+// gasFeedOnly hides the exchange rate of the wrapped feed.
+type gasFeedOnly struct {
+	PriceFeed
+}
+
+var (
+	testBlockTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testOracle    = sdk.AccAddress("oracle______________")
+)
+
+// newTestGasAbstraction returns a gas abstraction and a context with its
+// store, at testBlockTime. Its gas prices are updated by testOracle.
+func newTestGasAbstraction(keeper BankKeeper) (*GasAbstraction, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey("crosschain")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_crosschain"))
+	return NewGasAbstraction(storeKey, keeper, testOracle), ctx.WithBlockTime(testBlockTime)
+}
+
+// updateMsg returns the message of testOracle submitting update for
+// ethereum.
+func updateMsg(update GasPriceUpdate) MsgUpdateGasPrice {
+	return MsgUpdateGasPrice{Oracle: testOracle, ChainID: "ethereum", Update: update}
+}
+
+func testGasConfig() *GasConfig {
+	return &GasConfig{
+		BaseGasPrice:   big.NewInt(20000000000),
+		GasMultiplier:  sdkmath.LegacyMustNewDecFromStr("1.1"),
+		MinGasRequired: big.NewInt(1000),
+		MaxGasAllowed:  big.NewInt(1000000),
+		UpdateInterval: 5 * time.Minute,
+		LastUpdate:     testBlockTime,
+		ExchangeRate:   big.NewRat(1, 1000000000),
+	}
+}
+
+func TestGasAbstraction(t *testing.T) {
+	keeper := newMockBankKeeper()
+	ga, baseCtx := newTestGasAbstraction(keeper)
+	if err := ga.SetGasConfig(baseCtx, "ethereum", testGasConfig()); err != nil {
+		t.Fatal(err)
+	}
 
 	// Test cases
 	tests := []struct {
 		name           string
-		sender         string
-		initialBalance *big.Int
-		gasLimit       int64
-		shouldSucceed  bool
+		sender         sdk.AccAddress
+		initialBalance int64
+		gasLimit       uint64
+		expectedCost   int64
+		expectedErr    error
 	}{
 		{
 			name:           "sufficient balance",
-			sender:         "0x123",
-			initialBalance: big.NewInt(10000000000), // 100 FLUX
+			sender:         sdk.AccAddress("sender_1____________"),
+			initialBalance: 1000000,
 			gasLimit:       21000,
-			shouldSucceed:  true,
+			expectedCost:   462000, // 21000 * 20 Gwei / 1e9 * 1.1
 		},
 		{
 			name:           "insufficient balance",
-			sender:         "0x456",
-			initialBalance: big.NewInt(100000000), // 1 FLUX
+			sender:         sdk.AccAddress("sender_2____________"),
+			initialBalance: 1000,
 			gasLimit:       21000,
-			shouldSucceed:  false,
+			expectedErr:    sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name:           "raised to minimum",
+			sender:         sdk.AccAddress("sender_3____________"),
+			initialBalance: 1000000,
+			gasLimit:       1,
+			expectedCost:   1000,
+		},
+		{
+			name:           "above maximum",
+			sender:         sdk.AccAddress("sender_4____________"),
+			initialBalance: 10000000,
+			gasLimit:       50000,
+			expectedErr:    ErrGasCostTooHigh,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Set initial balance
-			keeper.balances[tt.sender] = tt.initialBalance
+			ctx := baseCtx.WithEventManager(sdk.NewEventManager())
+			initial := sdk.NewCoins(sdk.NewInt64Coin(FLUMXDenom, tt.initialBalance))
+			keeper.balances[tt.sender.String()] = initial
+			collected := keeper.modules[authtypes.FeeCollectorName]
 
-			// Create test transaction
-			tx := types.Tx{
+			tx := CrossChainTx{
 				Sender:   tt.sender,
 				GasLimit: tt.gasLimit,
+				Tx:       types.Tx("call"),
 			}
 
 			// Attempt gas payment
-			err := ga.PayGasWithFLUX(context.Background(), tx, "ethereum")
-
-			if tt.shouldSucceed {
-				if err != nil {
-					t.Errorf("expected success, got error: %v", err)
-				}
+			err := ga.PayGasWithFLUMX(ctx, tx, "ethereum")
 
-				// Verify balance was reduced
-				finalBalance := keeper.GetBalance(context.Background(), tt.sender)
-				if finalBalance.Cmp(tt.initialBalance) >= 0 {
-					t.Error("balance was not reduced")
-				}
-
-				// Verify event was emitted
-				if len(keeper.events) == 0 {
-					t.Error("no gas payment event was emitted")
-				}
-			} else {
-				if err == nil {
-					t.Error("expected error, got success")
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Fatalf("expected %v, got %v", tt.expectedErr, err)
 				}
 
 				// Verify balance was not changed
-				finalBalance := keeper.GetBalance(context.Background(), tt.sender)
-				if finalBalance.Cmp(tt.initialBalance) != 0 {
+				if !keeper.balances[tt.sender.String()].Equal(initial) {
 					t.Error("balance was changed when it shouldn't have been")
 				}
+				if len(ctx.EventManager().Events()) != 0 {
+					t.Error("gas payment event was emitted for a failed payment")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected success, got error: %v", err)
+			}
+
+			// Verify the cost was moved to the fee collector
+			cost := sdkmath.NewInt(tt.expectedCost)
+			if got := keeper.balances[tt.sender.String()].AmountOf(FLUMXDenom); !got.Equal(initial.AmountOf(FLUMXDenom).Sub(cost)) {
+				t.Errorf("unexpected balance %s", got)
+			}
+			if got := keeper.modules[authtypes.FeeCollectorName].AmountOf(FLUMXDenom); !got.Equal(collected.AmountOf(FLUMXDenom).Add(cost)) {
+				t.Errorf("unexpected fee collector balance %s", got)
+			}
+
+			// Verify event was emitted
+			events := ctx.EventManager().Events()
+			if len(events) != 1 || events[0].Type != EventTypeGasPayment {
+				t.Fatalf("expected one gas payment event, got %v", events)
+			}
+			attrs := make(map[string]string)
+			for _, attr := range events[0].Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			if attrs[AttributeKeySender] != tt.sender.String() ||
+				attrs[AttributeKeyChainID] != "ethereum" ||
+				attrs[AttributeKeyAmount] != cost.String()+FLUMXDenom {
+				t.Errorf("unexpected event attributes %v", attrs)
 			}
 		})
 	}
 }
 
+func TestGasAbstractionNoExchangeRate(t *testing.T) {
+	keeper := newMockBankKeeper()
+	ga, ctx := newTestGasAbstraction(keeper)
+
+	sender := sdk.AccAddress("sender______________")
+	keeper.balances[sender.String()] = sdk.NewCoins(sdk.NewInt64Coin(FLUMXDenom, 1000000))
+
+	// the default config has no exchange rate
+	err := ga.PayGasWithFLUMX(ctx, CrossChainTx{Sender: sender, GasLimit: 21000}, "ethereum")
+	if !errors.Is(err, ErrNoExchangeRate) {
+		t.Fatalf("expected ErrNoExchangeRate, got %v", err)
+	}
+
+	// nor do updates of feeds without one
+	update, err := FetchGasPriceUpdate(ctx, gasFeedOnly{newMockPriceFeed()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ga.UpdateGasPrice(ctx, updateMsg(update)); err != nil {
+		t.Fatal(err)
+	}
+	err = ga.PayGasWithFLUMX(ctx, CrossChainTx{Sender: sender, GasLimit: 21000}, "ethereum")
+	if !errors.Is(err, ErrNoExchangeRate) {
+		t.Fatalf("expected ErrNoExchangeRate, got %v", err)
+	}
+}
+
 func TestGasConfigUpdates(t *testing.T) {
-	keeper := newMockKeeper()
 	priceFeed := newMockPriceFeed()
+	ga, ctx := newTestGasAbstraction(newMockBankKeeper())
 
-	ga := NewGasAbstraction(keeper)
-	ga.priceFeeds["ethereum"] = priceFeed
-
-	// Get initial config
-	config, err := ga.getGasConfig("ethereum")
+	// The default config gets the prices of the first update
+	update, err := FetchGasPriceUpdate(context.Background(), priceFeed)
+	if err != nil {
+		t.Fatalf("failed to fetch gas price update: %v", err)
+	}
+	if err := ga.UpdateGasPrice(ctx, updateMsg(update)); err != nil {
+		t.Fatalf("failed to update gas price: %v", err)
+	}
+	config, err := ga.getGasConfig(ctx, "ethereum")
 	if err != nil {
 		t.Fatalf("failed to get gas config: %v", err)
 	}
@@ -170,19 +273,35 @@ func TestGasConfigUpdates(t *testing.T) {
 	if config.BaseGasPrice.Cmp(big.NewInt(20000000000)) != 0 {
 		t.Error("unexpected initial base gas price")
 	}
-	if config.GasMultiplier != 1.1 {
-		t.Error("unexpected initial gas multiplier")
+	if !config.GasMultiplier.Equal(sdkmath.LegacyMustNewDecFromStr("1.1")) {
+		t.Errorf("unexpected initial gas multiplier %s", config.GasMultiplier)
+	}
+	if config.ExchangeRate.Cmp(priceFeed.rate) != 0 {
+		t.Error("unexpected initial exchange rate")
+	}
+	if !config.LastUpdate.Equal(testBlockTime) {
+		t.Errorf("expected last update at the block time, got %v", config.LastUpdate)
+	}
+
+	// Without updates, the config goes stale with the block time
+	ctx = ctx.WithBlockTime(testBlockTime.Add(6 * time.Minute))
+	if _, err := ga.getGasConfig(ctx, "ethereum"); !errors.Is(err, ErrStaleGasConfig) {
+		t.Fatalf("expected ErrStaleGasConfig, got %v", err)
 	}
 
 	// Update price feed values
 	priceFeed.gasPrice = big.NewInt(30000000000) // 30 Gwei
 	priceFeed.congestion = 0.8
-
-	// Force config update
-	config.LastUpdate = time.Now().Add(-6 * time.Minute)
+	update, err = FetchGasPriceUpdate(context.Background(), priceFeed)
+	if err != nil {
+		t.Fatalf("failed to fetch gas price update: %v", err)
+	}
+	if err := ga.UpdateGasPrice(ctx, updateMsg(update)); err != nil {
+		t.Fatalf("failed to update gas price: %v", err)
+	}
 
 	// Get updated config
-	config, err = ga.getGasConfig("ethereum")
+	config, err = ga.getGasConfig(ctx, "ethereum")
 	if err != nil {
 		t.Fatalf("failed to get updated gas config: %v", err)
 	}
@@ -191,7 +310,33 @@ func TestGasConfigUpdates(t *testing.T) {
 	if config.BaseGasPrice.Cmp(big.NewInt(30000000000)) != 0 {
 		t.Error("base gas price was not updated")
 	}
-	if config.GasMultiplier != 1.16 { // 1.0 + (0.8 * 0.2)
-		t.Error("gas multiplier was not updated correctly")
+	if !config.GasMultiplier.Equal(sdkmath.LegacyMustNewDecFromStr("1.16")) { // 1.0 + (0.8 * 0.2)
+		t.Errorf("gas multiplier was not updated correctly: %s", config.GasMultiplier)
+	}
+
+	// A failing feed surfaces an error instead of submitting stale values
+	priceFeed.shouldError = true
+	if _, err := FetchGasPriceUpdate(context.Background(), priceFeed); err == nil {
+		t.Error("expected error from failing price feed")
+	}
+
+	// Invalid updates are rejected
+	if err := ga.UpdateGasPrice(ctx, updateMsg(GasPriceUpdate{GasPrice: big.NewInt(0), Congestion: sdkmath.LegacyZeroDec()})); err == nil {
+		t.Error("expected zero gas price to be rejected")
+	}
+
+	// Only the oracle can update the gas prices
+	msg := updateMsg(update)
+	msg.Oracle = sdk.AccAddress("not_the_oracle______")
+	msg.Update.GasPrice = big.NewInt(1)
+	if err := ga.UpdateGasPrice(ctx, msg); !errors.Is(err, ErrUnauthorizedOracle) {
+		t.Fatalf("expected ErrUnauthorizedOracle, got %v", err)
+	}
+	config, err = ga.getGasConfig(ctx, "ethereum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.BaseGasPrice.Cmp(big.NewInt(30000000000)) != 0 {
+		t.Error("unauthorized update was applied")
 	}
 }
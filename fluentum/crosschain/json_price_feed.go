@@ -0,0 +1,153 @@
+package crosschain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// maxQuoteDocumentSize bounds the size of the documents read by JSONPriceFeed.
+const maxQuoteDocumentSize = 1 << 20
+
+// JSONPriceFeed reads quotes from a JSON document served over HTTP or stored
+// in a local file, e.g. one written by an off-chain oracle:
+//
+//	{
+//	  "gas_price": "20000000000",
+//	  "congestion": 0.5,
+//	  "exchange_rate": "1/1000000000",
+//	  "timestamp": 1700000000
+//	}
+//
+// gas_price is in the native token's smallest unit (e.g. wei) and
+// exchange_rate, which is optional, is the price of that unit in uflumx, as
+// a fraction or decimal. timestamp is in Unix seconds; if it is omitted, the
+// time of the read is used.
+type JSONPriceFeed struct {
+	source     string
+	httpClient *http.Client
+}
+
+var (
+	_ PriceFeed        = (*JSONPriceFeed)(nil)
+	_ ExchangeRateFeed = (*JSONPriceFeed)(nil)
+	_ QuoteFeed        = (*JSONPriceFeed)(nil)
+)
+
+// NewJSONPriceFeed creates a feed reading source, which is either an http(s)
+// URL or a file path, optionally prefixed with "file://". If httpClient is
+// nil, a client with a 10 second timeout is used.
+func NewJSONPriceFeed(source string, httpClient *http.Client) *JSONPriceFeed {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &JSONPriceFeed{source: source, httpClient: httpClient}
+}
+
+type jsonQuote struct {
+	GasPrice     string  `json:"gas_price"`
+	Congestion   float64 `json:"congestion"`
+	ExchangeRate string  `json:"exchange_rate,omitempty"`
+	Timestamp    int64   `json:"timestamp,omitempty"`
+}
+
+// GetGasPrice implements PriceFeed.
+func (pf *JSONPriceFeed) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	q, err := pf.GetQuote(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return q.GasPrice, nil
+}
+
+// GetNetworkCongestion implements PriceFeed.
+func (pf *JSONPriceFeed) GetNetworkCongestion(ctx context.Context) (float64, error) {
+	q, err := pf.GetQuote(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return q.Congestion, nil
+}
+
+// GetExchangeRate implements ExchangeRateFeed. It returns ErrNoExchangeRate
+// if the document has no exchange rate.
+func (pf *JSONPriceFeed) GetExchangeRate(ctx context.Context) (*big.Rat, error) {
+	q, err := pf.GetQuote(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if q.ExchangeRate == nil {
+		return nil, ErrNoExchangeRate
+	}
+	return q.ExchangeRate, nil
+}
+
+// GetQuote implements QuoteFeed.
+func (pf *JSONPriceFeed) GetQuote(ctx context.Context) (Quote, error) {
+	bz, err := pf.read(ctx)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	var jq jsonQuote
+	if err := json.Unmarshal(bz, &jq); err != nil {
+		return Quote{}, fmt.Errorf("invalid quote from %s: %w", pf.source, err)
+	}
+
+	gasPrice, ok := new(big.Int).SetString(jq.GasPrice, 10)
+	if !ok || gasPrice.Sign() <= 0 {
+		return Quote{}, fmt.Errorf("invalid gas price %q from %s", jq.GasPrice, pf.source)
+	}
+	if jq.Congestion < 0 || jq.Congestion > 1 {
+		return Quote{}, fmt.Errorf("congestion %v from %s is out of [0, 1]", jq.Congestion, pf.source)
+	}
+
+	q := Quote{
+		GasPrice:   gasPrice,
+		Congestion: jq.Congestion,
+		Timestamp:  time.Now(),
+	}
+	if jq.ExchangeRate != "" {
+		rate, ok := new(big.Rat).SetString(jq.ExchangeRate)
+		if !ok || rate.Sign() <= 0 {
+			return Quote{}, fmt.Errorf("invalid exchange rate %q from %s", jq.ExchangeRate, pf.source)
+		}
+		q.ExchangeRate = rate
+	}
+	if jq.Timestamp != 0 {
+		q.Timestamp = time.Unix(jq.Timestamp, 0)
+	}
+	return q, nil
+}
+
+func (pf *JSONPriceFeed) read(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(pf.source, "http://") && !strings.HasPrefix(pf.source, "https://") {
+		f, err := os.Open(strings.TrimPrefix(pf.source, "file://"))
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return io.ReadAll(io.LimitReader(f, maxQuoteDocumentSize))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pf.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := pf.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", pf.source, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxQuoteDocumentSize))
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"io/ioutil"
+	"fmt"
+	"io"
 	"math/big"
 	"net/http"
+	"strconv"
 	"time"
+
+	sdkmath "cosmossdk.io/math"
 )
 
 // PriceFeed interface for getting gas prices and network conditions
@@ -16,6 +20,29 @@ type PriceFeed interface {
 	GetNetworkCongestion(ctx context.Context) (float64, error)
 }
 
+// Quote is a single reading of a chain's gas market. ExchangeRate is nil if
+// the source doesn't quote the native token in FLUMX.
+type Quote struct {
+	GasPrice     *big.Int
+	Congestion   float64
+	ExchangeRate *big.Rat
+	Timestamp    time.Time
+}
+
+// QuoteFeed is implemented by price feeds that return all readings at once,
+// along with the time they were taken. AggregatedPriceFeed uses it to detect
+// stale readings.
+type QuoteFeed interface {
+	GetQuote(ctx context.Context) (Quote, error)
+}
+
+// ExchangeRateFeed is implemented by price feeds that also quote the chain's
+// native gas token in FLUMX. The rate is the price of one unit of the native
+// token (e.g. wei) in uflumx.
+type ExchangeRateFeed interface {
+	GetExchangeRate(ctx context.Context) (*big.Rat, error)
+}
+
 // EtherscanPriceFeed implements PriceFeed for Ethereum mainnet
 type EtherscanPriceFeed struct {
 	apiKey     string
@@ -26,7 +53,7 @@ type EtherscanPriceFeed struct {
 // NewEtherscanPriceFeed creates a new Etherscan price feed
 func NewEtherscanPriceFeed(apiKey string) *EtherscanPriceFeed {
 	return &EtherscanPriceFeed{
-		apiKey: apiKey,
+		apiKey:  apiKey,
 		baseURL: "https://api.etherscan.io/api",
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
@@ -48,8 +75,11 @@ func (pf *EtherscanPriceFeed) GetGasPrice(ctx context.Context) (*big.Int, error)
 		return nil, err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("etherscan API returned %s", resp.Status)
+	}
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -58,9 +88,9 @@ func (pf *EtherscanPriceFeed) GetGasPrice(ctx context.Context) (*big.Int, error)
 		Status  string `json:"status"`
 		Message string `json:"message"`
 		Result  struct {
-			SafeLow   string `json:"SafeGasPrice"`
-			Standard  string `json:"ProposeGasPrice"`
-			Fast      string `json:"FastGasPrice"`
+			SafeLow  string `json:"SafeGasPrice"`
+			Standard string `json:"ProposeGasPrice"`
+			Fast     string `json:"FastGasPrice"`
 		} `json:"result"`
 	}
 
@@ -73,10 +103,13 @@ func (pf *EtherscanPriceFeed) GetGasPrice(ctx context.Context) (*big.Int, error)
 		return nil, errors.New("etherscan API error: " + result.Message)
 	}
 
-	// Use standard gas price
-	gasPrice := new(big.Int)
-	gasPrice.SetString(result.Result.Standard, 10)
-	return gasPrice, nil
+	// Use standard gas price, which Etherscan reports in (fractional) Gwei
+	gwei, ok := new(big.Rat).SetString(result.Result.Standard)
+	if !ok || gwei.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid etherscan gas price %q", result.Result.Standard)
+	}
+	wei := gwei.Mul(gwei, new(big.Rat).SetInt64(1e9))
+	return new(big.Int).Quo(wei.Num(), wei.Denom()), nil
 }
 
 // GetNetworkCongestion gets the current network congestion level
@@ -93,8 +126,11 @@ func (pf *EtherscanPriceFeed) GetNetworkCongestion(ctx context.Context) (float64
 		return 0, err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("etherscan API returned %s", resp.Status)
+	}
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return 0, err
 	}
@@ -115,8 +151,10 @@ func (pf *EtherscanPriceFeed) GetNetworkCongestion(ctx context.Context) (float64
 	}
 
 	// Calculate congestion based on gas estimate
-	estimate := new(big.Int)
-	estimate.SetString(result.Result, 10)
+	estimate, ok := new(big.Int).SetString(result.Result, 10)
+	if !ok {
+		return 0, fmt.Errorf("invalid etherscan gas estimate %q", result.Result)
+	}
 
 	// Normalize congestion to 0-1 range
 	// Higher estimate means higher congestion
@@ -132,4 +170,42 @@ func (pf *EtherscanPriceFeed) GetNetworkCongestion(ctx context.Context) (float64
 	}
 
 	return congestionFloat, nil
-} 
+}
+
+// FetchGasPriceUpdate reads a gas price update from a price feed. It makes
+// network requests, so it must only be called off-chain, by the oracle
+// submitting the update in a MsgUpdateGasPrice transaction.
+func FetchGasPriceUpdate(ctx context.Context, feed PriceFeed) (GasPriceUpdate, error) {
+	gasPrice, err := feed.GetGasPrice(ctx)
+	if err != nil {
+		return GasPriceUpdate{}, fmt.Errorf("failed to get gas price: %w", err)
+	}
+	if gasPrice == nil || gasPrice.Sign() <= 0 {
+		return GasPriceUpdate{}, fmt.Errorf("invalid gas price %v", gasPrice)
+	}
+	congestion, err := feed.GetNetworkCongestion(ctx)
+	if err != nil {
+		return GasPriceUpdate{}, fmt.Errorf("failed to get network congestion: %w", err)
+	}
+
+	var rate *big.Rat
+	if rf, ok := feed.(ExchangeRateFeed); ok {
+		rate, err = rf.GetExchangeRate(ctx)
+		switch {
+		case errors.Is(err, ErrNoExchangeRate):
+			rate = nil
+		case err != nil:
+			return GasPriceUpdate{}, fmt.Errorf("failed to get exchange rate: %w", err)
+		case rate == nil || rate.Sign() <= 0:
+			return GasPriceUpdate{}, fmt.Errorf("invalid exchange rate %v", rate)
+		}
+	}
+
+	// the congestion is rounded to 6 decimal places, so that float noise
+	// doesn't end up in the state
+	return GasPriceUpdate{
+		GasPrice:     gasPrice,
+		Congestion:   sdkmath.LegacyMustNewDecFromStr(strconv.FormatFloat(clamp(congestion, 0, 1), 'f', 6, 64)),
+		ExchangeRate: rate,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"os"
+
+	"github.com/fluentum-chain/fluentum/core/plugin"
+)
+
+var _ plugin.FixedPointScorer = (*QMoEValidator)(nil)
+
+// fixedPointModel is the integer model used to order the transactions of the
+// blocks proposed by the node. It scores a transaction with a linear
+// combination of its first bytes, with Q16.16 weights, so every host computes
+// the same scores for the same weights.
+//
+// The weights file holds InputSize little-endian int32 weights followed by
+// the int32 bias, all in Q16.16.
+type fixedPointModel struct {
+	weights []int64
+	bias    int64
+	hash    []byte
+}
+
+// newFixedPointModel parses the weights of a model with inputSize features.
+func newFixedPointModel(raw []byte, inputSize int) (*fixedPointModel, error) {
+	if inputSize <= 0 {
+		return nil, fmt.Errorf("invalid input size %d", inputSize)
+	}
+	if len(raw) != 4*(inputSize+1) {
+		return nil, fmt.Errorf("weights file has %d bytes, expected %d", len(raw), 4*(inputSize+1))
+	}
+
+	m := &fixedPointModel{weights: make([]int64, inputSize)}
+	for i := range m.weights {
+		m.weights[i] = int64(int32(binary.LittleEndian.Uint32(raw[4*i:])))
+	}
+	m.bias = int64(int32(binary.LittleEndian.Uint32(raw[4*inputSize:])))
+	hash := sha256.Sum256(raw)
+	m.hash = hash[:]
+	return m, nil
+}
+
+// defaultFixedPointModel returns the model used without a weights file, which
+// scores every transaction 0, so blocks are ordered by transaction hash.
+func defaultFixedPointModel(inputSize int) *fixedPointModel {
+	if inputSize <= 0 {
+		inputSize = 1
+	}
+	m, _ := newFixedPointModel(make([]byte, 4*(inputSize+1)), inputSize)
+	return m
+}
+
+// score returns the Q16.16 score of a transaction. The features are integers,
+// so the products keep the Q16.16 format of the weights.
+func (m *fixedPointModel) score(data []byte) int64 {
+	score := m.bias
+	for i, w := range m.weights {
+		if i >= len(data) {
+			break
+		}
+		score += w * int64(data[i])
+	}
+	return score
+}
+
+// loadModelWeights loads the fixed point weights of a model with inputSize
+// features.
+func loadModelWeights(path string, inputSize int) (*fixedPointModel, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read model weights: %w", err)
+	}
+	return newFixedPointModel(raw, inputSize)
+}
+
+// ScoreTransactions implements plugin.FixedPointScorer.
+func (q *QMoEValidator) ScoreTransactions(transactions []plugin.Transaction) ([]int64, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.fixedPoint == nil {
+		return nil, fmt.Errorf("model not initialized")
+	}
+	scores := make([]int64, len(transactions))
+	for i, tx := range transactions {
+		scores[i] = q.fixedPoint.score(tx.GetData())
+	}
+	return scores, nil
+}
+
+// ModelHash implements plugin.FixedPointScorer.
+func (q *QMoEValidator) ModelHash() []byte {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.fixedPoint == nil {
+		return nil
+	}
+	return q.fixedPoint.hash
+}
@@ -21,6 +21,9 @@ type QMoEValidator struct {
 	metrics   *plugin.ModelMetrics
 	quantizer *DynamicQuantizer
 	config    plugin.ModelConfig
+
+	// fixedPoint orders block transactions, see ScoreTransactions
+	fixedPoint *fixedPointModel
 }
 
 // exported symbol for plugin loading
@@ -77,10 +80,13 @@ func Initialize(configJSON *C.char) error {
 	)
 	
 	// Load pre-trained weights if available
+	AIValidatorPlugin.fixedPoint = defaultFixedPointModel(modelConfig.InputSize)
 	if modelConfig.WeightsPath != "" {
-		if err := loadModelWeights(modelConfig.WeightsPath); err != nil {
+		fixedPoint, err := loadModelWeights(modelConfig.WeightsPath, modelConfig.InputSize)
+		if err != nil {
 			return err
 		}
+		AIValidatorPlugin.fixedPoint = fixedPoint
 	}
 	
 	AIValidatorPlugin.config = modelConfig
@@ -202,7 +208,12 @@ func (q *QMoEValidator) UpdateWeights(weightsPath string) error {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 	
-	return loadModelWeights(weightsPath)
+	fixedPoint, err := loadModelWeights(weightsPath, q.config.InputSize)
+	if err != nil {
+		return err
+	}
+	q.fixedPoint = fixedPoint
+	return nil
 }
 
 func (q *QMoEValidator) GetConfig() map[string]interface{} {
@@ -267,10 +278,4 @@ func (q *QMoEValidator) EstimateCombinedGasSavings(batch []plugin.Transaction) (
 	return totalSavings, nil
 }
 
-func loadModelWeights(path string) error {
-	// Load pre-trained weights from file
-	// Implementation depends on your model serialization format
-	return nil
-}
-
 func main() {} // Required but unused
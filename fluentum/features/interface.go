@@ -2,11 +2,13 @@ package features
 
 // No imports needed as we use basic Go types
 
-// FeatureInterface defines the interface that all features must implement
+// FeatureInterface defines the lifecycle that all features must implement,
+// whether they're compiled into the node or served by a feature binary.
+//
+// The manager checks the compatibility of each feature with the node and
+// initializes it, then starts the enabled features in the order of their
+// dependencies. Features are stopped in reverse order.
 type FeatureInterface interface {
-	// Initialize initializes the feature with the given config
-	Initialize(config map[string]interface{}) error
-
 	// Name returns the name of the feature
 	Name() string
 
@@ -16,39 +18,53 @@ type FeatureInterface interface {
 	// Description returns a description of the feature
 	Description() string
 
+	// Dependencies returns the names of the features which must be started
+	// before this one
+	Dependencies() []string
+
+	// CheckCompatibility returns an error if the feature can't run on the
+	// given node version
+	CheckCompatibility(nodeVersion string) error
+
+	// Initialize initializes the feature with the given config
+	Initialize(config map[string]interface{}) error
+
+	// Start starts the feature
+	Start() error
+
+	// Stop stops the feature, and cleans up the resources it uses
+	Stop() error
+
+	// Reload reinitializes the feature with its current config
+	Reload() error
+
 	// IsEnabled returns whether the feature is enabled
 	IsEnabled() bool
 
 	// SetEnabled enables or disables the feature
 	SetEnabled(enabled bool)
-
-	// Close cleans up resources used by the feature
-	Close() error
 }
 
 // BaseFeature provides a default implementation of common FeatureInterface methods
 type BaseFeature struct {
-	name        string
-	version     string
-	description string
-	enabled     bool
+	name         string
+	version      string
+	description  string
+	dependencies []string
+	enabled      bool
 }
 
 // NewBaseFeature creates a new BaseFeature
-func NewBaseFeature(name, version, description string) *BaseFeature {
+func NewBaseFeature(name, version, description string, dependencies ...string) *BaseFeature {
 	return &BaseFeature{
-		name:        name,
-		version:     version,
-		description: description,
-		enabled:     true, // Features are enabled by default
+		name:         name,
+		version:      version,
+		description:  description,
+		dependencies: dependencies,
+		enabled:      true, // Features are enabled by default
 	}
 }
 
-// Initialize implements FeatureInterface
-func (f *BaseFeature) Initialize(cfg interface{}) error {
-	return nil
-}
-
 // Name returns the name of the feature
 func (f *BaseFeature) Name() string {
 	return f.name
@@ -64,6 +80,40 @@ func (f *BaseFeature) Description() string {
 	return f.description
 }
 
+// Dependencies returns the names of the features this feature depends on
+func (f *BaseFeature) Dependencies() []string {
+	return f.dependencies
+}
+
+// CheckCompatibility implements FeatureInterface, accepting all node versions
+func (f *BaseFeature) CheckCompatibility(nodeVersion string) error {
+	return nil
+}
+
+// Initialize implements FeatureInterface
+func (f *BaseFeature) Initialize(config map[string]interface{}) error {
+	if enabled, ok := config["enabled"].(bool); ok {
+		f.enabled = enabled
+	}
+	return nil
+}
+
+// Start implements FeatureInterface
+func (f *BaseFeature) Start() error {
+	return nil
+}
+
+// Stop implements FeatureInterface
+func (f *BaseFeature) Stop() error {
+	// Default implementation does nothing
+	return nil
+}
+
+// Reload implements FeatureInterface
+func (f *BaseFeature) Reload() error {
+	return nil
+}
+
 // IsEnabled returns whether the feature is enabled
 func (f *BaseFeature) IsEnabled() bool {
 	return f.enabled
@@ -74,12 +124,6 @@ func (f *BaseFeature) SetEnabled(enabled bool) {
 	f.enabled = enabled
 }
 
-// Close cleans up resources used by the feature
-func (f *BaseFeature) Close() error {
-	// Default implementation does nothing
-	return nil
-}
-
 // QMoEValidator defines the interface for QMoE validator features
 type QMoEValidator interface {
 	FeatureInterface
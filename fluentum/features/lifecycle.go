@@ -0,0 +1,368 @@
+package features
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ErrFeaturesStarted is returned when starting features which are already
+// started.
+var ErrFeaturesStarted = errors.New("features are already started")
+
+// RegisterFeature registers a feature compiled into the node. It's started
+// and stopped along with the features loaded from the registry.
+func (m *Manager) RegisterFeature(feature FeatureInterface) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	name := feature.Name()
+	if _, exists := m.features[name]; exists {
+		return fmt.Errorf("feature %s already registered", name)
+	}
+
+	m.features[name] = &FeatureInfo{
+		Name:        name,
+		Version:     feature.Version(),
+		Description: feature.Description(),
+		Enabled:     feature.IsEnabled(),
+		Feature:     feature,
+	}
+	return nil
+}
+
+// SetNodeVersion sets the node version the features are checked against
+// before being started.
+func (m *Manager) SetNodeVersion(version string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nodeVersion = version
+}
+
+// SetFeatureConfig sets the configuration a feature is initialized with. It
+// overrides the configuration file of the feature in the registry.
+func (m *Manager) SetFeatureConfig(name string, config map[string]interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.configs[name] = config
+}
+
+// StartFeatures checks the compatibility of all features with the node and
+// initializes them, then starts the enabled ones in dependency order. If a
+// feature fails to start, the features started before it are stopped.
+func (m *Manager) StartFeatures() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.running {
+		return ErrFeaturesStarted
+	}
+
+	ordered, err := sortFeatures(m.features)
+	if err != nil {
+		return err
+	}
+	for _, feature := range ordered {
+		if err := m.startFeature(feature); err != nil {
+			if stopErr := m.stopFeatures(); stopErr != nil {
+				m.logger.Error("Failed to roll back started features", "error", stopErr)
+			}
+			return err
+		}
+	}
+	m.running = true
+	return nil
+}
+
+// StopFeatures stops the started features, in reverse dependency order.
+func (m *Manager) StopFeatures() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.stopFeatures()
+}
+
+// ReloadAllFeatures reloads all loaded features, in dependency order.
+func (m *Manager) ReloadAllFeatures() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ordered, err := sortFeatures(m.features)
+	if err != nil {
+		return err
+	}
+	for _, feature := range ordered {
+		if err := m.reloadFeature(feature); err != nil {
+			return fmt.Errorf("failed to reload feature %s: %w", feature.Name, err)
+		}
+	}
+	return nil
+}
+
+// Enable enables a feature. The setting is saved in the configuration file of
+// features installed in the registry. If features are started, the feature
+// is started.
+func (m *Manager) Enable(name string) error {
+	return m.setEnabled(name, true)
+}
+
+// Disable disables a feature. The setting is saved in the configuration file
+// of features installed in the registry. If the feature is started, it's
+// stopped, unless started features depend on it.
+func (m *Manager) Disable(name string) error {
+	return m.setEnabled(name, false)
+}
+
+// GetFeatureStatus returns the status of all loaded features
+func (m *Manager) GetFeatureStatus() map[string]interface{} {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	status := make(map[string]interface{}, len(m.features))
+	for name, feature := range m.features {
+		status[name] = map[string]interface{}{
+			"enabled":      feature.Enabled,
+			"version":      feature.Version,
+			"started":      m.isStarted(name),
+			"dependencies": feature.Feature.Dependencies(),
+		}
+	}
+	return status
+}
+
+func (m *Manager) setEnabled(name string, enabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// a refused disable leaves the setting unchanged
+	if !enabled && m.isStarted(name) {
+		if dependents := m.startedDependents(name); len(dependents) > 0 {
+			return fmt.Errorf("feature %s is required by %s", name, strings.Join(dependents, ", "))
+		}
+	}
+
+	if config, ok := m.configs[name]; ok {
+		config["enabled"] = enabled
+	}
+	if err := m.saveEnabled(name, enabled); err != nil {
+		return err
+	}
+
+	feature, loaded := m.features[name]
+	if !loaded {
+		return nil
+	}
+	if config, ok := feature.Config.(map[string]interface{}); ok {
+		config["enabled"] = enabled
+	}
+	if !enabled && m.isStarted(name) {
+		if err := feature.Feature.Stop(); err != nil {
+			return fmt.Errorf("failed to stop feature %s: %w", name, err)
+		}
+		m.removeStarted(name)
+	}
+	feature.Feature.SetEnabled(enabled)
+	feature.Enabled = enabled
+
+	if enabled && m.running && !m.isStarted(name) {
+		return m.startFeature(feature)
+	}
+	return nil
+}
+
+// saveEnabled saves the enabled setting in the configuration file of a
+// feature installed in the registry.
+func (m *Manager) saveEnabled(name string, enabled bool) error {
+	dir := filepath.Join(m.config.GetRegistry(), name)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+
+	configPath := filepath.Join(dir, "config.json")
+	config := make(map[string]interface{})
+	data, err := os.ReadFile(configPath)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &config); err != nil {
+			return fmt.Errorf("failed to parse config file %s: %v", configPath, err)
+		}
+	case !os.IsNotExist(err):
+		return fmt.Errorf("failed to read config file %s: %v", configPath, err)
+	}
+
+	config["enabled"] = enabled
+	data, err = json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(configPath, data, 0644)
+}
+
+// startFeature checks the compatibility of a feature and initializes it, then
+// starts it if it's enabled. The features it depends on must be started. A
+// feature initialized but failing to start is stopped, to release what it
+// initialized. It must be called with the mutex held.
+func (m *Manager) startFeature(feature *FeatureInfo) error {
+	name := feature.Name
+	if err := feature.Feature.CheckCompatibility(m.nodeVersion); err != nil {
+		return fmt.Errorf("feature %s compatibility check failed: %w", name, err)
+	}
+	if err := feature.Feature.Initialize(m.featureConfig(feature)); err != nil {
+		return fmt.Errorf("failed to initialize feature %s: %w", name, err)
+	}
+
+	feature.Enabled = feature.Feature.IsEnabled()
+	if !feature.Enabled {
+		return nil
+	}
+	for _, dep := range feature.Feature.Dependencies() {
+		if !m.isStarted(dep) {
+			m.cleanUpFeature(feature)
+			return fmt.Errorf("feature %s depends on feature %s, which is not enabled", name, dep)
+		}
+	}
+	if err := feature.Feature.Start(); err != nil {
+		m.cleanUpFeature(feature)
+		return fmt.Errorf("failed to start feature %s: %w", name, err)
+	}
+
+	m.started = append(m.started, name)
+	m.metrics.Started.Set(float64(len(m.started)))
+	m.logger.Info("Started feature", "name", name, "version", feature.Version)
+	return nil
+}
+
+// cleanUpFeature stops a feature which was initialized but not started.
+func (m *Manager) cleanUpFeature(feature *FeatureInfo) {
+	if err := feature.Feature.Stop(); err != nil {
+		m.logger.Error("Failed to clean up feature", "name", feature.Name, "error", err)
+	}
+}
+
+// stopFeatures stops the started features in reverse order. All features are
+// stopped even if some fail, the first error is returned. It must be called
+// with the mutex held.
+func (m *Manager) stopFeatures() error {
+	var firstErr error
+	for i := len(m.started) - 1; i >= 0; i-- {
+		name := m.started[i]
+		feature, ok := m.features[name]
+		if !ok {
+			continue
+		}
+		if err := feature.Feature.Stop(); err != nil {
+			m.logger.Error("Failed to stop feature", "name", name, "error", err)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to stop feature %s: %w", name, err)
+			}
+			continue
+		}
+		m.logger.Info("Stopped feature", "name", name)
+	}
+	m.started = nil
+	m.running = false
+	m.metrics.Started.Set(0)
+	return firstErr
+}
+
+// featureConfig returns the configuration of a feature: the one set with
+// SetFeatureConfig, or else the one of its configuration file.
+func (m *Manager) featureConfig(feature *FeatureInfo) map[string]interface{} {
+	if config, ok := m.configs[feature.Name]; ok {
+		return config
+	}
+	if config, ok := feature.Config.(map[string]interface{}); ok {
+		return config
+	}
+	return make(map[string]interface{})
+}
+
+func (m *Manager) isStarted(name string) bool {
+	for _, started := range m.started {
+		if started == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manager) removeStarted(name string) {
+	for i, started := range m.started {
+		if started == name {
+			m.started = append(m.started[:i], m.started[i+1:]...)
+			m.metrics.Started.Set(float64(len(m.started)))
+			return
+		}
+	}
+}
+
+// startedDependents returns the started features depending on a feature.
+func (m *Manager) startedDependents(name string) []string {
+	var dependents []string
+	for _, started := range m.started {
+		for _, dep := range m.features[started].Feature.Dependencies() {
+			if dep == name {
+				dependents = append(dependents, started)
+				break
+			}
+		}
+	}
+	return dependents
+}
+
+// sortFeatures returns the features ordered so that each feature comes after
+// the features it depends on. Features are otherwise ordered by name, so the
+// order is deterministic. It fails if a dependency isn't loaded, or if
+// dependencies form a cycle.
+func sortFeatures(features map[string]*FeatureInfo) ([]*FeatureInfo, error) {
+	names := make([]string, 0, len(features))
+	for name := range features {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int, len(features))
+	ordered := make([]*FeatureInfo, 0, len(features))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("feature dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		}
+		state[name] = visiting
+
+		feature := features[name]
+		for _, dep := range feature.Feature.Dependencies() {
+			if _, ok := features[dep]; !ok {
+				return fmt.Errorf("feature %s depends on feature %s, which is not loaded", name, dep)
+			}
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+		ordered = append(ordered, feature)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
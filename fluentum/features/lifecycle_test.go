@@ -0,0 +1,214 @@
+package features
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// recordingFeature records its lifecycle calls in a shared log.
+type recordingFeature struct {
+	*BaseFeature
+	log        *[]string
+	startErr   error
+	minVersion string
+}
+
+func newRecordingFeature(log *[]string, name string, deps ...string) *recordingFeature {
+	return &recordingFeature{BaseFeature: NewBaseFeature(name, "1.0.0", "", deps...), log: log}
+}
+
+func (f *recordingFeature) CheckCompatibility(nodeVersion string) error {
+	if nodeVersion < f.minVersion {
+		return errors.New("node is too old")
+	}
+	return nil
+}
+
+func (f *recordingFeature) Start() error {
+	if f.startErr != nil {
+		return f.startErr
+	}
+	*f.log = append(*f.log, "start "+f.Name())
+	return nil
+}
+
+func (f *recordingFeature) Stop() error {
+	*f.log = append(*f.log, "stop "+f.Name())
+	return nil
+}
+
+func newTestManager(t *testing.T, features ...FeatureInterface) *Manager {
+	t.Helper()
+	m := NewManager(&testConfig{registry: t.TempDir()}, nil)
+	m.SetNodeVersion("v1.0.0")
+	for _, f := range features {
+		if err := m.RegisterFeature(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return m
+}
+
+type testConfig struct{ registry string }
+
+func (c *testConfig) GetRegistry() string { return c.registry }
+func (c *testConfig) IsEnabled() bool     { return true }
+func (c *testConfig) GetAutoUpdate() bool { return false }
+
+func TestStartFeaturesInDependencyOrder(t *testing.T) {
+	var log []string
+	m := newTestManager(t,
+		newRecordingFeature(&log, "a", "c"),
+		newRecordingFeature(&log, "b"),
+		newRecordingFeature(&log, "c", "b"),
+	)
+
+	if err := m.StartFeatures(); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.StartFeatures(); !errors.Is(err, ErrFeaturesStarted) {
+		t.Fatalf("expected ErrFeaturesStarted, got %v", err)
+	}
+	if err := m.StopFeatures(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"start b", "start c", "start a", "stop a", "stop c", "stop b"}
+	if !reflect.DeepEqual(log, expected) {
+		t.Fatalf("expected %v, got %v", expected, log)
+	}
+}
+
+func TestStartFeaturesRollsBack(t *testing.T) {
+	var log []string
+	failing := newRecordingFeature(&log, "c", "b")
+	failing.startErr = errors.New("boom")
+	m := newTestManager(t,
+		newRecordingFeature(&log, "a"),
+		newRecordingFeature(&log, "b"),
+		failing,
+	)
+
+	err := m.StartFeatures()
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected start error, got %v", err)
+	}
+	// c is cleaned up, then the started features are stopped
+	expected := []string{"start a", "start b", "stop c", "stop b", "stop a"}
+	if !reflect.DeepEqual(log, expected) {
+		t.Fatalf("expected %v, got %v", expected, log)
+	}
+
+	// the features can be started again once fixed
+	failing.startErr = nil
+	log = nil
+	if err := m.StartFeatures(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStartFeaturesChecks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		features func(log *[]string) []FeatureInterface
+		err      string
+		log      []string
+	}{
+		{
+			"missing dependency",
+			func(log *[]string) []FeatureInterface {
+				return []FeatureInterface{newRecordingFeature(log, "a", "b")}
+			},
+			"depends on feature b, which is not loaded",
+			nil,
+		},
+		{
+			"cycle",
+			func(log *[]string) []FeatureInterface {
+				return []FeatureInterface{
+					newRecordingFeature(log, "a", "b"),
+					newRecordingFeature(log, "b", "c"),
+					newRecordingFeature(log, "c", "a"),
+				}
+			},
+			"cycle: a -> b -> c -> a",
+			nil,
+		},
+		{
+			"disabled dependency",
+			func(log *[]string) []FeatureInterface {
+				disabled := newRecordingFeature(log, "b")
+				disabled.SetEnabled(false)
+				return []FeatureInterface{newRecordingFeature(log, "a", "b"), disabled}
+			},
+			"depends on feature b, which is not enabled",
+			[]string{"stop a"},
+		},
+		{
+			"incompatible",
+			func(log *[]string) []FeatureInterface {
+				f := newRecordingFeature(log, "a")
+				f.minVersion = "v2.0.0"
+				return []FeatureInterface{f}
+			},
+			"compatibility check failed",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var log []string
+			m := newTestManager(t, tc.features(&log)...)
+			err := m.StartFeatures()
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+			if !reflect.DeepEqual(log, tc.log) {
+				t.Fatalf("expected %v, got %v", tc.log, log)
+			}
+		})
+	}
+}
+
+func TestEnableDisableStartedFeatures(t *testing.T) {
+	var log []string
+	b := newRecordingFeature(&log, "b")
+	b.SetEnabled(false)
+	m := newTestManager(t, newRecordingFeature(&log, "a"), b, newRecordingFeature(&log, "c", "a"))
+	m.SetFeatureConfig("b", map[string]interface{}{"enabled": false})
+	aConfig := map[string]interface{}{"enabled": true}
+	m.SetFeatureConfig("a", aConfig)
+	if err := os.Mkdir(filepath.Join(m.config.GetRegistry(), "a"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.StartFeatures(); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Enable("b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Disable("a"); err == nil || !strings.Contains(err.Error(), "required by c") {
+		t.Fatalf("expected dependents error, got %v", err)
+	}
+	// the refused disable isn't saved
+	if aConfig["enabled"] != true {
+		t.Fatal("expected a to stay enabled in its config")
+	}
+	if _, err := os.Stat(filepath.Join(m.config.GetRegistry(), "a", "config.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected no config file saved, got %v", err)
+	}
+	if err := m.Disable("c"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"start a", "start c", "start b", "stop c"}
+	if !reflect.DeepEqual(log, expected) {
+		t.Fatalf("expected %v, got %v", expected, log)
+	}
+}
@@ -4,15 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/fs"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
-	"plugin"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/fluentum-chain/fluentum/core/plugin"
 )
 
 // Logger is a simple logger interface to replace the missing logging functionality
@@ -83,8 +85,14 @@ type FeatureInfo struct {
 	Version     string
 	Description string
 	Enabled     bool
-	Plugin      *plugin.Plugin
-	Config      interface{}
+	// Feature is the feature, nil if not loaded. Features served by a
+	// feature binary are clients of the feature process.
+	Feature FeatureInterface
+	Config  interface{}
+
+	// process is the feature process, nil for features registered with
+	// RegisterFeature
+	process *plugin.Process
 }
 
 // Config defines the interface for feature configuration
@@ -111,10 +119,22 @@ func (c *defaultConfig) GetAutoUpdate() bool {
 
 // Manager handles feature loading and management
 type Manager struct {
-	logger  Logger
-	config  Config
-	mu      sync.RWMutex
+	logger   Logger
+	config   Config
+	mu       sync.RWMutex
 	features map[string]*FeatureInfo
+
+	// lifecycle state, see lifecycle.go
+	nodeVersion string
+	configs     map[string]map[string]interface{}
+	started     []string
+	running     bool
+
+	// feature registry, see registry.go
+	registry   Registry
+	trustStore *TrustStore
+
+	metrics *Metrics
 }
 
 // NewManager creates a new feature manager
@@ -137,6 +157,8 @@ func NewManager(cfg interface{}, logger Logger) *Manager {
 		logger:  logger.With("module", "features"),
 		config:  config,
 		features: make(map[string]*FeatureInfo),
+		configs:  make(map[string]map[string]interface{}),
+		metrics:  NopMetrics(),
 	}
 }
 
@@ -195,25 +217,64 @@ func (m *Manager) GetFeature(name string) (*FeatureInfo, bool) {
 	return feature, exists
 }
 
-// ReloadFeature reloads a specific feature
+// ReloadFeature reloads a specific feature. For features served by a feature
+// binary, a new process of the binary, which may have been updated, replaces
+// the running one.
 func (m *Manager) ReloadFeature(name string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// Unload existing feature if loaded
-	if _, exists := m.features[name]; exists {
-		// Note: Go's plugin system doesn't support unloading, so we just remove the reference
-		delete(m.features, name)
+	feature, exists := m.features[name]
+	if !exists {
+		return m.loadFeature(name)
+	}
+	return m.reloadFeature(feature)
+}
+
+// reloadFeature reloads a loaded feature. It must be called with the mutex
+// held.
+func (m *Manager) reloadFeature(feature *FeatureInfo) error {
+	if feature.process == nil {
+		if err := feature.Feature.Reload(); err != nil {
+			return err
+		}
+		m.metrics.Reloads.With("feature", feature.Name).Add(1)
+		return nil
 	}
 
-	return m.loadFeature(name)
+	if err := m.verifyInstalled(feature.Name); err != nil {
+		return err
+	}
+	// the feature client brings the new process back to where the lifecycle
+	// left the previous one
+	if err := feature.process.Reload(); err != nil {
+		return err
+	}
+	m.metrics.Reloads.With("feature", feature.Name).Add(1)
+	return nil
 }
 
-// loadFeature loads a single feature
-func (m *Manager) loadFeature(name string) error {
+// Close stops all features, and the processes of the feature binaries.
+func (m *Manager) Close() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	err := m.stopFeatures()
+	for name, feature := range m.features {
+		if feature.process == nil {
+			continue
+		}
+		if err := feature.process.Stop(); err != nil {
+			m.logger.Error("Failed to stop feature process", "name", name, "error", err)
+		}
+		delete(m.features, name)
+	}
+	return err
+}
+
+// loadFeature starts the binary of a single feature, which serves it from
+// its own process. It must be called with the mutex held.
+func (m *Manager) loadFeature(name string) error {
 	// Check if feature is already loaded
 	if _, exists := m.features[name]; exists {
 		return fmt.Errorf("feature %s is already loaded", name)
@@ -224,28 +285,35 @@ func (m *Manager) loadFeature(name string) error {
 		Name: name,
 	}
 
-	// Load the plugin
-	pluginPath := m.getPluginPath(name)
-	plug, err := plugin.Open(pluginPath)
-	if err != nil {
-		return fmt.Errorf("failed to load plugin %s: %v", pluginPath, err)
+	// Load the feature configuration
+	if err := m.loadFeatureConfig(name, feature); err != nil {
+		return fmt.Errorf("failed to load config for feature %s: %v", name, err)
 	}
 
-	// Verify the feature implements the required interface
-	if _, err := plug.Lookup("Feature"); err != nil {
-		return fmt.Errorf("feature %s does not export \"Feature\" symbol: %v", name, err)
+	// Verify the feature binary against its manifest
+	if err := m.verifyInstalled(name); err != nil {
+		return err
 	}
 
-	// Store the plugin
-	feature.Plugin = plug
+	// Start the feature process
+	pluginPath := m.getPluginPath(name)
+	feature.process = plugin.NewProcess(plugin.DefaultProcessConfig(pluginPath), nil)
+	if err := feature.process.Start(); err != nil {
+		return fmt.Errorf("failed to start feature %s: %v", pluginPath, err)
+	}
+	feature.Feature = NewFeatureClient(feature.process)
 
-	// Load the feature configuration
-	if err := m.loadFeatureConfig(name, feature); err != nil {
-		return fmt.Errorf("failed to load config for feature %s: %v", name, err)
+	// Fill in the info missing from the configuration
+	if feature.Version == "" {
+		feature.Version = feature.Feature.Version()
+	}
+	if feature.Description == "" {
+		feature.Description = feature.Feature.Description()
 	}
 
 	// Add to the features map
 	m.features[name] = feature
+	m.metrics.Loads.With("feature", name).Add(1)
 
 	m.logger.Info("Loaded feature", "name", name, "version", feature.Version)
 	return nil
@@ -253,6 +321,10 @@ func (m *Manager) loadFeature(name string) error {
 
 // loadFeatureConfig loads configuration for a feature
 func (m *Manager) loadFeatureConfig(name string, feature *FeatureInfo) error {
+	// The version of features installed from the registry is the one of
+	// their manifest
+	feature.Version = m.installedVersion(name)
+
 	// Default config path: $HOME/.fluentum/features/{name}/config.json
 	configPath := filepath.Join(m.config.GetRegistry(), name, "config.json")
 
@@ -297,69 +369,100 @@ func (m *Manager) loadFeatureConfig(name string, feature *FeatureInfo) error {
 	return nil
 }
 
-// getPluginPath returns the path to a feature's plugin file
+// getPluginPath returns the path to a feature's binary, in the feature
+// directory.
 func (m *Manager) getPluginPath(name string) string {
-	// On Windows, we need to add .dll extension
-	ext := ".so"
+	// On Windows, we need to add .exe extension
+	ext := ""
 	if runtime.GOOS == "windows" {
-		ext = ".dll"
-	}
-	// Ensure the directory exists
-	if err := os.MkdirAll(m.config.GetRegistry(), 0755); err != nil {
-		m.logger.Error("Failed to create features directory", "error", err)
+		ext = ".exe"
 	}
-	return filepath.Join(m.config.GetRegistry(), "lib"+name+ext)
+	return filepath.Join(m.config.GetRegistry(), name, name+ext)
 }
 
-// CheckForUpdates checks for updates to installed features
+// CheckForUpdates checks the registry for updates to installed features. It
+// returns the latest version of the features which have one.
 func (m *Manager) CheckForUpdates() (map[string]string, error) {
+	m.mu.RLock()
+	registry := m.registry
+	m.mu.RUnlock()
+
 	updates := make(map[string]string)
+	if registry == nil {
+		return updates, nil
+	}
 
-	// In a real implementation, this would check a remote registry
-	// For now, we'll just return an empty map
+	installed, err := m.ListFeatures()
+	if err != nil {
+		return nil, err
+	}
+	for _, feature := range installed {
+		var index RegistryIndex
+		if _, err := fetchJSON(context.Background(), registry, path.Join(feature.Name, indexFile), &index); err != nil {
+			m.logger.Debug("Failed to fetch feature index", "name", feature.Name, "error", err)
+			continue
+		}
+		if index.Latest != "" && index.Latest != feature.Version {
+			updates[feature.Name] = index.Latest
+		}
+	}
 
 	return updates, nil
 }
 
-// InstallFeature installs a new feature
-func (m *Manager) InstallFeature(name, version string) error {
-	// In a real implementation, this would download the feature
-	// from a registry and install it
-	return fmt.Errorf("not implemented")
-}
-
 // UninstallFeature removes an installed feature
 func (m *Manager) UninstallFeature(name string) error {
 	featurePath := filepath.Join(m.config.GetRegistry(), name)
 	return os.RemoveAll(featurePath)
 }
 
-// ListFeatures returns a list of all installed features
+// ListFeatures returns a list of all registered and installed features,
+// loaded or not, sorted by name
 func (m *Manager) ListFeatures() ([]*FeatureInfo, error) {
-	var features []*FeatureInfo
-
-	err := filepath.WalkDir(m.config.GetRegistry(), func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-
-		if d.IsDir() && path != m.config.GetRegistry() {
-			name := filepath.Base(path)
-			if feature, exists := m.GetFeature(name); exists {
-				features = append(features, feature)
-			}
-		}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 
-		return nil
-	})
+	features := make([]*FeatureInfo, 0, len(m.features))
+	for _, feature := range m.features {
+		features = append(features, feature)
+	}
 
-	if err != nil {
+	dirs, err := os.ReadDir(m.config.GetRegistry())
+	if err != nil && !os.IsNotExist(err) {
 		return nil, err
 	}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		name := dir.Name()
+		if _, loaded := m.features[name]; loaded {
+			continue
+		}
+		feature := &FeatureInfo{Name: name}
+		if err := m.loadFeatureConfig(name, feature); err != nil {
+			return nil, fmt.Errorf("failed to load config for feature %s: %w", name, err)
+		}
+		features = append(features, feature)
+	}
 
+	sort.Slice(features, func(i, j int) bool { return features[i].Name < features[j].Name })
 	return features, nil
 }
 
+// HasFeature returns whether a feature is registered, or installed in the
+// registry
+func (m *Manager) HasFeature(name string) bool {
+	m.mu.RLock()
+	_, exists := m.features[name]
+	m.mu.RUnlock()
+	if exists {
+		return true
+	}
+	info, err := os.Stat(filepath.Join(m.config.GetRegistry(), name))
+	return err == nil && info.IsDir()
+}
+
 // StartUpdateChecker starts a background goroutine to check for updates
 func (m *Manager) StartUpdateChecker(interval time.Duration) {
 	if !m.config.GetAutoUpdate() {
@@ -397,7 +500,7 @@ func (m *Manager) UpdateFeature(name string) error {
 	m.logger.Info("Updating feature", "name", name, "current_version", feature.Version)
 
 	// Reload the feature to get the latest version
-	if err := m.loadFeature(name); err != nil {
+	if err := m.reloadFeature(feature); err != nil {
 		return fmt.Errorf("failed to reload feature: %w", err)
 	}
 
@@ -0,0 +1,204 @@
+package features
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/version"
+)
+
+// manifestSignPrefix is prepended to the manifest bytes before signing, so a
+// manifest signature can't be mistaken for a signature of anything else.
+const manifestSignPrefix = "fluentum/feature-manifest/v1:"
+
+// ErrUntrustedManifest is returned when a manifest isn't signed by a key
+// trusted for its publisher.
+var ErrUntrustedManifest = errors.New("manifest is not signed by a trusted publisher key")
+
+// validName matches the names of features, versions and artifact files. It
+// keeps them safe to use as path elements.
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Manifest describes a release of a feature in the registry.
+type Manifest struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Publisher   string `json:"publisher"`
+	Description string `json:"description,omitempty"`
+
+	// MinNodeVersion is the lowest node version the release runs on, and
+	// MaxNodeVersion, if set, the first node version it doesn't run on.
+	MinNodeVersion string `json:"min_node_version"`
+	MaxNodeVersion string `json:"max_node_version,omitempty"`
+
+	// Artifacts are the feature binaries, by platform ("linux/amd64")
+	Artifacts map[string]Artifact `json:"artifacts"`
+}
+
+// Artifact is a feature binary, pinned by its SHA-256 digest. Size bounds
+// the download, so a registry can't fill the disk before the digest is
+// checked.
+type Artifact struct {
+	File   string `json:"file"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// SignedManifest is a manifest along with the signatures of its publisher.
+// The manifest is kept as signed, so its signatures verify regardless of how
+// the JSON is encoded.
+type SignedManifest struct {
+	Manifest   json.RawMessage     `json:"manifest"`
+	Signatures []ManifestSignature `json:"signatures"`
+}
+
+// ManifestSignature is a signature of a manifest by a publisher key.
+type ManifestSignature struct {
+	// Algorithm is the key type, "ed25519" or "dilithium"
+	Algorithm string `json:"algorithm"`
+	PublicKey []byte `json:"public_key"`
+	Signature []byte `json:"signature"`
+}
+
+// SignManifest encodes and signs a manifest with a publisher key. Ed25519 and
+// Dilithium keys are supported.
+func SignManifest(manifest *Manifest, privKeys ...crypto.PrivKey) (*SignedManifest, error) {
+	bz, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := &SignedManifest{Manifest: bz}
+	for _, privKey := range privKeys {
+		if _, err := pubKeyFromBytes(privKey.Type(), privKey.PubKey().Bytes()); err != nil {
+			return nil, err
+		}
+		sig, err := privKey.Sign(manifestSignBytes(bz))
+		if err != nil {
+			return nil, err
+		}
+		signed.Signatures = append(signed.Signatures, ManifestSignature{
+			Algorithm: privKey.Type(),
+			PublicKey: privKey.PubKey().Bytes(),
+			Signature: sig,
+		})
+	}
+	return signed, nil
+}
+
+// Verify verifies the manifest is signed by a key trusted for its publisher,
+// and returns it.
+func (sm *SignedManifest) Verify(trustStore *TrustStore) (*Manifest, error) {
+	manifest := &Manifest{}
+	if err := json.Unmarshal(sm.Manifest, manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	signBytes := manifestSignBytes(sm.Manifest)
+	for _, sig := range sm.Signatures {
+		if !trustStore.IsTrusted(manifest.Publisher, sig.Algorithm, sig.PublicKey) {
+			continue
+		}
+		pubKey, err := pubKeyFromBytes(sig.Algorithm, sig.PublicKey)
+		if err != nil {
+			continue
+		}
+		if pubKey.VerifySignature(signBytes, sig.Signature) {
+			if err := manifest.ValidateBasic(); err != nil {
+				return nil, err
+			}
+			return manifest, nil
+		}
+	}
+	return nil, fmt.Errorf("%w %q", ErrUntrustedManifest, manifest.Publisher)
+}
+
+// ValidateBasic checks the manifest fields.
+func (m *Manifest) ValidateBasic() error {
+	if !validName.MatchString(m.Name) {
+		return fmt.Errorf("invalid feature name %q", m.Name)
+	}
+	if !validName.MatchString(m.Version) {
+		return fmt.Errorf("invalid feature version %q", m.Version)
+	}
+	if m.Publisher == "" {
+		return errors.New("manifest missing required field: publisher")
+	}
+	if _, err := version.Compare(m.MinNodeVersion, m.MinNodeVersion); err != nil {
+		return fmt.Errorf("invalid min_node_version: %w", err)
+	}
+	if m.MaxNodeVersion != "" {
+		if _, err := version.Compare(m.MaxNodeVersion, m.MaxNodeVersion); err != nil {
+			return fmt.Errorf("invalid max_node_version: %w", err)
+		}
+	}
+	if len(m.Artifacts) == 0 {
+		return errors.New("manifest missing required field: artifacts")
+	}
+	for platform, artifact := range m.Artifacts {
+		if !validName.MatchString(artifact.File) {
+			return fmt.Errorf("invalid artifact file %q for %s", artifact.File, platform)
+		}
+		if digest, err := hex.DecodeString(artifact.SHA256); err != nil || len(digest) != sha256.Size {
+			return fmt.Errorf("invalid artifact sha256 for %s", platform)
+		}
+		if artifact.Size <= 0 || artifact.Size > maxArtifactSize {
+			return fmt.Errorf("invalid artifact size %d for %s, must be in (0, %d]", artifact.Size, platform, maxArtifactSize)
+		}
+	}
+	return nil
+}
+
+// CheckNodeVersion returns an error if the release doesn't run on the given
+// node version.
+func (m *Manifest) CheckNodeVersion(nodeVersion string) error {
+	cmp, err := version.Compare(nodeVersion, m.MinNodeVersion)
+	if err != nil {
+		return err
+	}
+	if cmp < 0 {
+		return fmt.Errorf("feature %s@%s requires node version >= %s, node is %s",
+			m.Name, m.Version, m.MinNodeVersion, nodeVersion)
+	}
+	if m.MaxNodeVersion == "" {
+		return nil
+	}
+	cmp, err = version.Compare(nodeVersion, m.MaxNodeVersion)
+	if err != nil {
+		return err
+	}
+	if cmp >= 0 {
+		return fmt.Errorf("feature %s@%s requires node version < %s, node is %s",
+			m.Name, m.Version, m.MaxNodeVersion, nodeVersion)
+	}
+	return nil
+}
+
+func manifestSignBytes(manifest []byte) []byte {
+	return append([]byte(manifestSignPrefix), manifest...)
+}
+
+// pubKeyFromBytes returns the public key of the given algorithm.
+func pubKeyFromBytes(algorithm string, bz []byte) (crypto.PubKey, error) {
+	switch algorithm {
+	case ed25519.KeyType:
+		if len(bz) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("invalid ed25519 public key size %d", len(bz))
+		}
+		return ed25519.PubKey(bz), nil
+	case dilithium.KeyType:
+		if _, ok := dilithium.ModeFromPubKeySize(len(bz)); !ok {
+			return nil, fmt.Errorf("invalid dilithium public key size %d", len(bz))
+		}
+		return dilithium.PubKey(bz), nil
+	default:
+		return nil, fmt.Errorf("unsupported publisher key algorithm %q", algorithm)
+	}
+}
@@ -0,0 +1,75 @@
+package features
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "features"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of times a feature binary was loaded, labeled by feature.
+	Loads metrics.Counter
+
+	// Number of times a feature was reloaded, labeled by feature.
+	Reloads metrics.Counter
+
+	// Number of started features.
+	Started metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		Loads: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "loads",
+			Help:      "Number of times a feature binary was loaded.",
+		}, append(labels, "feature")).With(labelsAndValues...),
+
+		Reloads: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reloads",
+			Help:      "Number of times a feature was reloaded.",
+		}, append(labels, "feature")).With(labelsAndValues...),
+
+		Started: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "started",
+			Help:      "Number of started features.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Loads:   discard.NewCounter(),
+		Reloads: discard.NewCounter(),
+		Started: discard.NewGauge(),
+	}
+}
+
+// SetMetrics sets the metrics of the manager.
+func (m *Manager) SetMetrics(metrics *Metrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.metrics = metrics
+}
@@ -0,0 +1,248 @@
+package features
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"github.com/fluentum-chain/fluentum/core/plugin"
+	pluginproto "github.com/fluentum-chain/fluentum/proto/fluentum/plugin"
+)
+
+// Serve serves feature to the node which started the feature binary, until
+// the node stops it. It's called by the main function of feature binaries:
+//
+//	func main() {
+//		if err := features.Serve(NewMyFeature()); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+func Serve(feature FeatureInterface) error {
+	return plugin.Serve(plugin.ServeConfig{
+		Register: func(srv *grpc.Server) {
+			pluginproto.RegisterFeatureServer(srv, &featureServer{impl: feature})
+		},
+	})
+}
+
+// NewFeatureClient returns a FeatureInterface calling the feature served by
+// the plugin process p. The processes restarting the plugin are brought back
+// to the lifecycle state of the feature: initialized with the last
+// configuration, enabled or disabled, and started.
+func NewFeatureClient(p *plugin.Process) FeatureInterface {
+	c := &featureClient{process: p}
+	p.AddRestartHook(c.restore)
+	return c
+}
+
+// featureServer serves a FeatureInterface.
+type featureServer struct {
+	pluginproto.UnimplementedFeatureServer
+	impl FeatureInterface
+}
+
+func (s *featureServer) FeatureInfo(
+	context.Context, *pluginproto.FeatureInfoRequest,
+) (*pluginproto.FeatureInfoResponse, error) {
+	return &pluginproto.FeatureInfoResponse{
+		Name:         s.impl.Name(),
+		Version:      s.impl.Version(),
+		Description:  s.impl.Description(),
+		Enabled:      s.impl.IsEnabled(),
+		Dependencies: s.impl.Dependencies(),
+	}, nil
+}
+
+func (s *featureServer) CheckCompatibility(
+	_ context.Context, req *pluginproto.CheckCompatibilityRequest,
+) (*pluginproto.CheckCompatibilityResponse, error) {
+	if err := s.impl.CheckCompatibility(req.NodeVersion); err != nil {
+		return nil, err
+	}
+	return &pluginproto.CheckCompatibilityResponse{}, nil
+}
+
+func (s *featureServer) Initialize(
+	_ context.Context, req *pluginproto.InitializeRequest,
+) (*pluginproto.InitializeResponse, error) {
+	var config map[string]interface{}
+	if len(req.Config) > 0 {
+		if err := json.Unmarshal(req.Config, &config); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.impl.Initialize(config); err != nil {
+		return nil, err
+	}
+	return &pluginproto.InitializeResponse{}, nil
+}
+
+func (s *featureServer) Start(context.Context, *pluginproto.StartRequest) (*pluginproto.StartResponse, error) {
+	if err := s.impl.Start(); err != nil {
+		return nil, err
+	}
+	return &pluginproto.StartResponse{}, nil
+}
+
+func (s *featureServer) Stop(context.Context, *pluginproto.StopRequest) (*pluginproto.StopResponse, error) {
+	if err := s.impl.Stop(); err != nil {
+		return nil, err
+	}
+	return &pluginproto.StopResponse{}, nil
+}
+
+func (s *featureServer) Reload(context.Context, *pluginproto.ReloadRequest) (*pluginproto.ReloadResponse, error) {
+	if err := s.impl.Reload(); err != nil {
+		return nil, err
+	}
+	return &pluginproto.ReloadResponse{}, nil
+}
+
+func (s *featureServer) SetEnabled(
+	_ context.Context, req *pluginproto.SetEnabledRequest,
+) (*pluginproto.SetEnabledResponse, error) {
+	s.impl.SetEnabled(req.Enabled)
+	return &pluginproto.SetEnabledResponse{}, nil
+}
+
+// featureClient is a FeatureInterface served by a plugin process.
+type featureClient struct {
+	process *plugin.Process
+
+	// mtx guards the lifecycle state restored on restarted processes
+	mtx     sync.Mutex
+	config  []byte
+	enabled *bool
+	started bool
+}
+
+var _ FeatureInterface = (*featureClient)(nil)
+
+// call calls the feature process with a context bounded by the call timeout.
+func (c *featureClient) call(fn func(context.Context, pluginproto.FeatureClient) error) error {
+	conn, err := c.process.Conn()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.process.CallContext()
+	defer cancel()
+	return fn(ctx, pluginproto.NewFeatureClient(conn))
+}
+
+// info returns the info of the feature, or an empty info if the plugin can't
+// be reached.
+func (c *featureClient) info() *pluginproto.FeatureInfoResponse {
+	res := &pluginproto.FeatureInfoResponse{}
+	_ = c.call(func(ctx context.Context, client pluginproto.FeatureClient) error {
+		info, err := client.FeatureInfo(ctx, &pluginproto.FeatureInfoRequest{})
+		if err == nil {
+			res = info
+		}
+		return err
+	})
+	return res
+}
+
+func (c *featureClient) Name() string           { return c.info().Name }
+func (c *featureClient) Version() string        { return c.info().Version }
+func (c *featureClient) Description() string    { return c.info().Description }
+func (c *featureClient) Dependencies() []string { return c.info().Dependencies }
+func (c *featureClient) IsEnabled() bool        { return c.info().Enabled }
+
+func (c *featureClient) CheckCompatibility(nodeVersion string) error {
+	return c.call(func(ctx context.Context, client pluginproto.FeatureClient) error {
+		_, err := client.CheckCompatibility(ctx, &pluginproto.CheckCompatibilityRequest{NodeVersion: nodeVersion})
+		return err
+	})
+}
+
+func (c *featureClient) Initialize(config map[string]interface{}) error {
+	bz, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	err = c.call(func(ctx context.Context, client pluginproto.FeatureClient) error {
+		_, err := client.Initialize(ctx, &pluginproto.InitializeRequest{Config: bz})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	c.mtx.Lock()
+	c.config = bz
+	c.mtx.Unlock()
+	return nil
+}
+
+func (c *featureClient) Start() error {
+	err := c.call(func(ctx context.Context, client pluginproto.FeatureClient) error {
+		_, err := client.Start(ctx, &pluginproto.StartRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	c.mtx.Lock()
+	c.started = true
+	c.mtx.Unlock()
+	return nil
+}
+
+func (c *featureClient) Stop() error {
+	err := c.call(func(ctx context.Context, client pluginproto.FeatureClient) error {
+		_, err := client.Stop(ctx, &pluginproto.StopRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	c.mtx.Lock()
+	c.started = false
+	c.mtx.Unlock()
+	return nil
+}
+
+func (c *featureClient) Reload() error {
+	return c.call(func(ctx context.Context, client pluginproto.FeatureClient) error {
+		_, err := client.Reload(ctx, &pluginproto.ReloadRequest{})
+		return err
+	})
+}
+
+func (c *featureClient) SetEnabled(enabled bool) {
+	c.mtx.Lock()
+	c.enabled = &enabled
+	c.mtx.Unlock()
+	_ = c.call(func(ctx context.Context, client pluginproto.FeatureClient) error {
+		_, err := client.SetEnabled(ctx, &pluginproto.SetEnabledRequest{Enabled: enabled})
+		return err
+	})
+}
+
+// restore brings a restarted process of the feature back to its lifecycle
+// state.
+func (c *featureClient) restore(ctx context.Context, conn *grpc.ClientConn) error {
+	c.mtx.Lock()
+	config, enabled, started := c.config, c.enabled, c.started
+	c.mtx.Unlock()
+
+	client := pluginproto.NewFeatureClient(conn)
+	if config != nil {
+		if _, err := client.Initialize(ctx, &pluginproto.InitializeRequest{Config: config}); err != nil {
+			return err
+		}
+	}
+	if enabled != nil {
+		if _, err := client.SetEnabled(ctx, &pluginproto.SetEnabledRequest{Enabled: *enabled}); err != nil {
+			return err
+		}
+	}
+	if started {
+		if _, err := client.Start(ctx, &pluginproto.StartRequest{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
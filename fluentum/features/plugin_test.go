@@ -0,0 +1,278 @@
+package features
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/fluentum-chain/fluentum/core/plugin"
+	pluginproto "github.com/fluentum-chain/fluentum/proto/fluentum/plugin"
+)
+
+// The test binary serves testFeature and testValidator when started as a
+// plugin process.
+func TestMain(m *testing.M) {
+	if os.Getenv(plugin.MagicCookieEnv) == plugin.MagicCookie {
+		err := plugin.Serve(plugin.ServeConfig{
+			AIValidator: &testValidator{},
+			Register: func(srv *grpc.Server) {
+				pluginproto.RegisterFeatureServer(srv, &featureServer{impl: newTestFeature()})
+			},
+		})
+		if err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+type testFeature struct {
+	*BaseFeature
+	reloadDelay time.Duration
+}
+
+func newTestFeature() *testFeature {
+	f := &testFeature{BaseFeature: NewBaseFeature("test", "1.0.0", "test feature")}
+	f.SetEnabled(false)
+	return f
+}
+
+func (f *testFeature) Initialize(config map[string]interface{}) error {
+	if config["crash"] == true {
+		os.Exit(2)
+	}
+	if delay, ok := config["reload_delay_ms"].(float64); ok {
+		f.reloadDelay = time.Duration(delay) * time.Millisecond
+	}
+	return f.BaseFeature.Initialize(config)
+}
+
+func (f *testFeature) Reload() error {
+	time.Sleep(f.reloadDelay)
+	return f.BaseFeature.Reload()
+}
+
+// testValidator is an AI validator plugin returning its configuration.
+type testValidator struct {
+	mtx         sync.Mutex
+	config      map[string]interface{}
+	weightsPath string
+}
+
+var _ plugin.AIValidatorPlugin = (*testValidator)(nil)
+
+func (v *testValidator) Initialize(config map[string]interface{}) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	v.config = config
+	return nil
+}
+
+func (v *testValidator) PredictBatch(txs []plugin.Transaction) (*plugin.BatchPrediction, error) {
+	return &plugin.BatchPrediction{OptimalBatch: txs, Confidence: 1}, nil
+}
+
+func (v *testValidator) PredictBatchAsync(
+	_ context.Context, txs []plugin.Transaction,
+) (*plugin.BatchPrediction, error) {
+	return v.PredictBatch(txs)
+}
+
+func (v *testValidator) ValidateBatch(*plugin.Batch) (bool, float64, error) { return true, 1, nil }
+
+func (v *testValidator) ValidateBatchAsync(_ context.Context, batch *plugin.Batch) (bool, float64, error) {
+	return v.ValidateBatch(batch)
+}
+
+func (v *testValidator) PredictExecutionPattern(plugin.Transaction) (string, error) { return "", nil }
+
+func (v *testValidator) EstimateCombinedGasSavings([]plugin.Transaction) (float64, error) {
+	return 0, nil
+}
+
+func (v *testValidator) GetModelMetrics() map[string]float64 { return nil }
+func (v *testValidator) VersionInfo() map[string]string      { return nil }
+func (v *testValidator) ResetMetrics()                       {}
+
+func (v *testValidator) UpdateWeights(weightsPath string) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	v.weightsPath = weightsPath
+	return nil
+}
+
+func (v *testValidator) GetConfig() map[string]interface{} {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	config := map[string]interface{}{"weights_path": v.weightsPath}
+	for k, val := range v.config {
+		config[k] = val
+	}
+	return config
+}
+
+func startTestProcess(t *testing.T) *plugin.Process {
+	t.Helper()
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := plugin.DefaultProcessConfig(exe)
+	config.HealthCheckInterval = 100 * time.Millisecond
+	p := plugin.NewProcess(config, nil)
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = p.Stop() })
+	return p
+}
+
+func TestFeatureClient(t *testing.T) {
+	p := startTestProcess(t)
+	feature := NewFeatureClient(p)
+
+	if err := feature.Initialize(map[string]interface{}{"key": "value"}); err != nil {
+		t.Fatal(err)
+	}
+	if feature.Name() != "test" || feature.Version() != "1.0.0" {
+		t.Fatalf("unexpected feature %s %s", feature.Name(), feature.Version())
+	}
+	feature.SetEnabled(true)
+	if !feature.IsEnabled() {
+		t.Fatal("feature should be enabled")
+	}
+
+	for _, step := range []func() error{feature.Start, feature.Reload, feature.Stop} {
+		if err := step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// crash makes the plugin process p crash, and waits for it to be restarted.
+func crash(t *testing.T, p *plugin.Process, feature FeatureInterface) {
+	t.Helper()
+	conn, err := p.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := feature.Initialize(map[string]interface{}{"crash": true}); err == nil {
+		t.Fatal("expected the plugin to crash")
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		if next, err := p.Conn(); err == nil && next != conn && feature.Name() == "test" {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("plugin was not restarted")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestProcessRestartsAfterCrash(t *testing.T) {
+	p := startTestProcess(t)
+	feature := NewFeatureClient(p)
+	if err := feature.Initialize(map[string]interface{}{"enabled": false}); err != nil {
+		t.Fatal(err)
+	}
+	feature.SetEnabled(true)
+	validator := plugin.NewAIValidatorClient(p)
+	if err := validator.Initialize(map[string]interface{}{"model": "qmoe"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := validator.UpdateWeights("weights.bin"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the restarted process gets the state of the crashed one back, the
+	// configuration that crashed it excepted
+	crash(t, p, feature)
+	if !feature.IsEnabled() {
+		t.Fatal("restarted feature should be enabled again")
+	}
+	config := validator.GetConfig()
+	if config["model"] != "qmoe" || config["weights_path"] != "weights.bin" {
+		t.Fatalf("restarted validator should be initialized again, got %v", config)
+	}
+}
+
+func TestProcessReload(t *testing.T) {
+	p := startTestProcess(t)
+	feature := NewFeatureClient(p)
+	feature.SetEnabled(true)
+
+	if err := p.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsRunning() || feature.Name() != "test" {
+		t.Fatal("reloaded plugin should serve")
+	}
+	if !feature.IsEnabled() {
+		t.Fatal("reloaded feature should be enabled again")
+	}
+}
+
+func TestProcessReloadDrainsCalls(t *testing.T) {
+	p := startTestProcess(t)
+	feature := NewFeatureClient(p)
+	if err := feature.Initialize(map[string]interface{}{"reload_delay_ms": 3000}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a call in flight completes on the process being replaced
+	conn, err := p.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	called := make(chan error, 1)
+	go func() { called <- feature.Reload() }()
+	time.Sleep(100 * time.Millisecond)
+
+	if err := p.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-called:
+		if err != nil {
+			t.Fatalf("call in flight failed: %v", err)
+		}
+	default:
+		t.Fatal("reload returned before the call in flight completed")
+	}
+	if next, err := p.Conn(); err != nil || next == conn {
+		t.Fatalf("expected a new process, got %v", err)
+	}
+}
+
+func TestProcessRestartHookFails(t *testing.T) {
+	p := startTestProcess(t)
+	feature := NewFeatureClient(p)
+	feature.SetEnabled(true)
+	conn, err := p.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a process which can't be restored doesn't replace the current one
+	p.AddRestartHook(func(context.Context, *grpc.ClientConn) error {
+		return errors.New("can't restore")
+	})
+	if err := p.Reload(); err == nil {
+		t.Fatal("expected reload to fail")
+	}
+	if next, err := p.Conn(); err != nil || next != conn {
+		t.Fatalf("expected the current process to keep serving, got %v", err)
+	}
+	if !feature.IsEnabled() {
+		t.Fatal("current process should keep its state")
+	}
+}
@@ -12,18 +12,6 @@ import (
 	"github.com/cloudflare/circl/sign/dilithium"
 )
 
-// Feature interface for modular features
-type Feature interface {
-	Name() string
-	Version() string
-	Init(config map[string]interface{}) error
-	Start() error
-	Stop() error
-	Reload() error
-	CheckCompatibility(nodeVersion string) error
-	IsEnabled() bool
-}
-
 // Signer defines the interface for cryptographic operations
 type Signer interface {
 	Sign(privateKey []byte, message []byte) ([]byte, error)
@@ -71,8 +59,18 @@ func (q *QuantumSigningFeature) Version() string {
 	return q.version
 }
 
-// Init initializes the quantum signing feature
-func (q *QuantumSigningFeature) Init(config map[string]interface{}) error {
+// Description returns the feature description
+func (q *QuantumSigningFeature) Description() string {
+	return "Quantum-resistant signatures using CRYSTALS-Dilithium"
+}
+
+// Dependencies returns the features this feature depends on
+func (q *QuantumSigningFeature) Dependencies() []string {
+	return nil
+}
+
+// Initialize initializes the quantum signing feature
+func (q *QuantumSigningFeature) Initialize(config map[string]interface{}) error {
 	q.config = config
 
 	// Check if feature is enabled
@@ -123,7 +121,7 @@ func (q *QuantumSigningFeature) Reload() error {
 	}
 
 	// Reinitialize the signer
-	return q.Init(q.config)
+	return q.Initialize(q.config)
 }
 
 // CheckCompatibility checks if the feature is compatible with the node version
@@ -138,6 +136,11 @@ func (q *QuantumSigningFeature) IsEnabled() bool {
 	return q.enabled
 }
 
+// SetEnabled enables or disables the feature
+func (q *QuantumSigningFeature) SetEnabled(enabled bool) {
+	q.enabled = enabled
+}
+
 // NewDilithiumSigner creates a new Dilithium signer
 func NewDilithiumSigner() (*DilithiumSigner, error) {
 	// Use Dilithium mode 3 by default (recommended security level)
@@ -26,11 +26,12 @@ func TestQuantumSigningIntegration(t *testing.T) {
 
 	// Create a new feature manager
 	nodeVersion := "1.0.0"
-	fm := core.NewFeatureManager(nodeVersion)
+	fm, err := core.NewFeatureManager(nodeVersion, t.TempDir())
+	require.NoError(t, err, "Failed to create feature manager")
 
 	// Register the quantum signing feature
 	quantumFeature := quantum.NewQuantumSigningFeature()
-	err := fm.RegisterFeature(quantumFeature)
+	err = fm.RegisterFeature(quantumFeature)
 	require.NoError(t, err, "Failed to register quantum signing feature")
 
 	// Initialize with test configuration
@@ -38,7 +39,7 @@ func TestQuantumSigningIntegration(t *testing.T) {
 		"enabled": true,
 		"mode":      "Dilithium3",
 	}
-	err = quantumFeature.Init(config)
+	err = quantumFeature.Initialize(config)
 	require.NoError(t, err, "Failed to initialize quantum signing feature")
 
 	// Start the feature
@@ -4,71 +4,25 @@
 package main
 
 import (
-	"crypto/rand"
-
 	"github.com/fluentum-chain/fluentum/core/crypto"
 )
 
-// DilithiumSigner implements the crypto.Signer interface for quantum-resistant signatures
-type DilithiumSigner struct {
-	mode string
-}
-
-// NewDilithiumSigner creates a new Dilithium signer with the specified mode
-func NewDilithiumSigner(mode string) *DilithiumSigner {
-	return &DilithiumSigner{mode: mode}
-}
-
-// GenerateKey generates a new Dilithium key pair
-func (d *DilithiumSigner) GenerateKey() ([]byte, []byte) {
-	// Stub implementation - generate random keys for now
-	privKey := make([]byte, 32)
-	pubKey := make([]byte, 32)
-	rand.Read(privKey)
-	rand.Read(pubKey)
-	return privKey, pubKey
-}
-
-// Sign signs a message using Dilithium
-func (d *DilithiumSigner) Sign(privateKey []byte, message []byte) []byte {
-	// Stub implementation - return random signature for now
-	signature := make([]byte, 64)
-	rand.Read(signature)
-	return signature
-}
-
-// Verify verifies a Dilithium signature
-func (d *DilithiumSigner) Verify(publicKey []byte, message []byte, signature []byte) bool {
-	// Stub implementation - always return true for now
-	return true
-}
-
-// Name returns the name of this signer
-func (d *DilithiumSigner) Name() string {
-	return "dilithium"
-}
-
 // ExportSigner is the function that must be exported by the plugin
 // This function returns a crypto.Signer implementation
 func ExportSigner() crypto.Signer {
 	// Use Dilithium Mode 3 (recommended for most use cases)
-	// Mode 1: Fastest but largest keys
+	// Mode 2: Fastest, with the smallest keys and signatures
 	// Mode 3: Balanced performance and security (recommended)
 	// Mode 5: Highest security but slower
-	return NewDilithiumSigner("mode3")
+	return ExportSignerMode(3)
 }
 
-// ExportSignerMode allows specifying a specific Dilithium mode
+// ExportSignerMode allows specifying a specific Dilithium mode. Modes 2, 3
+// and 5 are supported, other modes fall back to mode 3.
 func ExportSignerMode(mode int) crypto.Signer {
-	switch mode {
-	case 1:
-		return NewDilithiumSigner("mode1")
-	case 3:
-		return NewDilithiumSigner("mode3")
-	case 5:
-		return NewDilithiumSigner("mode5")
-	default:
-		// Default to Mode 3
-		return NewDilithiumSigner("mode3")
+	signer, err := crypto.NewDilithiumSigner(mode)
+	if err != nil {
+		signer, _ = crypto.NewDilithiumSigner(3)
 	}
+	return signer
 }
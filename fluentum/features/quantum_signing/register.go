@@ -7,6 +7,8 @@ import (
 	"github.com/fluentum-chain/fluentum/core"
 )
 
+var _ core.Feature = (*QuantumSigningFeature)(nil)
+
 // Register registers the quantum signing feature with the feature manager
 func Register(fm *core.FeatureManager) error {
 	// Create a new instance of the quantum signing feature
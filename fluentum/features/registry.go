@@ -0,0 +1,368 @@
+package features
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/fluentum-chain/fluentum/version"
+)
+
+// A registry serves feature releases with the following layout:
+//
+//	<name>/index.json               the RegistryIndex of the feature
+//	<name>/<version>/manifest.json  the SignedManifest of a release
+//	<name>/<version>/<file>         the artifacts of a release
+//
+// Features are installed in the local registry as:
+//
+//	<name>/<name>                   the feature binary
+//	<name>/manifest.json            the SignedManifest it was installed from
+//	<name>/config.json              the optional feature configuration
+
+const (
+	indexFile    = "index.json"
+	manifestFile = "manifest.json"
+
+	// maxMetadataSize bounds the size of index and manifest files.
+	maxMetadataSize = 1 << 20
+
+	// maxArtifactSize bounds the size a manifest can declare for a binary.
+	maxArtifactSize = 1 << 30
+)
+
+// ErrNoRegistry is returned when installing a feature without a registry or
+// trust store.
+var ErrNoRegistry = errors.New("no feature registry or trust store configured")
+
+// ErrNoTrustStore is returned when loading an installed feature binary
+// without a trust store to verify it with.
+var ErrNoTrustStore = errors.New("no trust store configured")
+
+// Registry is a remote feature registry.
+type Registry interface {
+	// Open opens the file at the given slash-separated path in the registry.
+	Open(ctx context.Context, path string) (io.ReadCloser, error)
+}
+
+// RegistryIndex lists the releases of a feature.
+type RegistryIndex struct {
+	Latest   string   `json:"latest"`
+	Versions []string `json:"versions"`
+}
+
+// dirRegistry is a registry backed by a local directory.
+type dirRegistry struct {
+	root string
+}
+
+// NewDirRegistry returns a registry backed by the directory at root.
+func NewDirRegistry(root string) Registry {
+	return &dirRegistry{root: root}
+}
+
+func (r *dirRegistry) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(r.root, filepath.FromSlash(name)))
+}
+
+// httpRegistry is a registry served over HTTP.
+type httpRegistry struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewHTTPRegistry returns a registry served at baseURL. insecureSkipVerify
+// skips the TLS verification of the server; manifests are verified
+// regardless.
+func NewHTTPRegistry(baseURL string, insecureSkipVerify bool) Registry {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecureSkipVerify {
+		//nolint:gosec // G402: TLS verification is disabled on request, manifests are still verified
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	return &httpRegistry{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Transport: transport, Timeout: 10 * time.Minute},
+	}
+}
+
+func (r *httpRegistry) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/"+name, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", req.URL, res.Status)
+	}
+	return res.Body, nil
+}
+
+// fetchJSON decodes the JSON file at the given path in a registry, and returns
+// its contents.
+func fetchJSON(ctx context.Context, registry Registry, name string, v interface{}) ([]byte, error) {
+	rc, err := registry.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxMetadataSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxMetadataSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", name, maxMetadataSize)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return data, nil
+}
+
+// SetRegistry sets the registry features are installed from, and the trust
+// store their manifests are verified with. Once a trust store is set,
+// installed features are verified against their manifest before being loaded.
+func (m *Manager) SetRegistry(registry Registry, trustStore *TrustStore) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.registry = registry
+	m.trustStore = trustStore
+}
+
+// TrustStore returns the trust store set with SetRegistry.
+func (m *Manager) TrustStore() *TrustStore {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.trustStore
+}
+
+// InstallFeature installs a release of a feature from the registry, "latest"
+// installing its latest release. The release manifest must be signed by a
+// trusted publisher key and accept the node version, and the downloaded
+// binary must match the digest pinned in the manifest. A release older than
+// the installed one is refused, so a registry can't roll a feature back. A
+// loaded feature is reloaded with the new binary.
+func (m *Manager) InstallFeature(name, release string) error {
+	m.mu.RLock()
+	registry, trustStore, nodeVersion := m.registry, m.trustStore, m.nodeVersion
+	m.mu.RUnlock()
+
+	if registry == nil || trustStore == nil {
+		return ErrNoRegistry
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf("invalid feature name %q", name)
+	}
+	if nodeVersion == "" {
+		nodeVersion = version.Version
+	}
+	ctx := context.Background()
+
+	if release == "" || release == "latest" {
+		var index RegistryIndex
+		if _, err := fetchJSON(ctx, registry, path.Join(name, indexFile), &index); err != nil {
+			return fmt.Errorf("failed to fetch index of feature %s: %w", name, err)
+		}
+		release = index.Latest
+	}
+	if !validName.MatchString(release) {
+		return fmt.Errorf("invalid version %q of feature %s", release, name)
+	}
+
+	// the manifest is saved as fetched, re-encoding it could alter the signed bytes
+	var signed SignedManifest
+	manifestData, err := fetchJSON(ctx, registry, path.Join(name, release, manifestFile), &signed)
+	if err != nil {
+		return fmt.Errorf("failed to fetch manifest of feature %s@%s: %w", name, release, err)
+	}
+	manifest, err := signed.Verify(trustStore)
+	if err != nil {
+		return err
+	}
+	if manifest.Name != name || manifest.Version != release {
+		return fmt.Errorf("manifest is for %s@%s, expected %s@%s", manifest.Name, manifest.Version, name, release)
+	}
+	if err := manifest.CheckNodeVersion(nodeVersion); err != nil {
+		return err
+	}
+	if err := m.checkNotDowngrade(manifest); err != nil {
+		return err
+	}
+	artifact, err := manifest.platformArtifact()
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(m.config.GetRegistry(), name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	binary, err := m.download(ctx, registry, path.Join(name, release, artifact.File), dir, artifact)
+	if err != nil {
+		return fmt.Errorf("failed to download feature %s@%s: %w", name, release, err)
+	}
+	defer os.Remove(binary)
+
+	if err := os.WriteFile(filepath.Join(dir, manifestFile), manifestData, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(binary, m.getPluginPath(name)); err != nil {
+		return err
+	}
+	m.logger.Info("Installed feature", "name", name, "version", release, "publisher", manifest.Publisher)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if feature, loaded := m.features[name]; loaded && feature.process != nil {
+		feature.Version = manifest.Version
+		return m.reloadFeature(feature)
+	}
+	return nil
+}
+
+// download downloads an artifact into a temporary file of dir, and checks its
+// SHA-256 digest. It reads at most one byte more than the declared size of
+// the artifact. It returns the path of the file.
+func (m *Manager) download(ctx context.Context, registry Registry, name, dir string, artifact Artifact) (string, error) {
+	rc, err := registry.Open(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	tmp, err := os.CreateTemp(dir, ".download-*")
+	if err != nil {
+		return "", err
+	}
+	defer tmp.Close()
+
+	hash := sha256.New()
+	n, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(rc, artifact.Size+1))
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if n > artifact.Size {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("artifact is larger than its declared size of %d bytes", artifact.Size)
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(actual, artifact.SHA256) {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("artifact digest mismatch: expected sha256 %s, got %s", artifact.SHA256, actual)
+	}
+	if err := tmp.Chmod(0755); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
+
+// verifyInstalled verifies an installed feature binary against the manifest
+// it was installed from. Without a trust store, installed features can't be
+// verified and aren't loaded. It must be called with the mutex held.
+func (m *Manager) verifyInstalled(name string) error {
+	if m.trustStore == nil {
+		return fmt.Errorf("can't verify feature %s: %w", name, ErrNoTrustStore)
+	}
+
+	var signed SignedManifest
+	registry := NewDirRegistry(m.config.GetRegistry())
+	if _, err := fetchJSON(context.Background(), registry, path.Join(name, manifestFile), &signed); err != nil {
+		return fmt.Errorf("failed to read manifest of feature %s: %w", name, err)
+	}
+	manifest, err := signed.Verify(m.trustStore)
+	if err != nil {
+		return err
+	}
+	nodeVersion := m.nodeVersion
+	if nodeVersion == "" {
+		nodeVersion = version.Version
+	}
+	if err := manifest.CheckNodeVersion(nodeVersion); err != nil {
+		return err
+	}
+	artifact, err := manifest.platformArtifact()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(m.getPluginPath(name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(actual, artifact.SHA256) {
+		return fmt.Errorf("feature %s binary doesn't match its manifest: expected sha256 %s, got %s",
+			name, artifact.SHA256, actual)
+	}
+	return nil
+}
+
+// installedVersion returns the version of an installed feature according to
+// its manifest, without verifying it. It returns "" if the manifest can't be
+// read.
+func (m *Manager) installedVersion(name string) string {
+	var signed SignedManifest
+	registry := NewDirRegistry(m.config.GetRegistry())
+	if _, err := fetchJSON(context.Background(), registry, path.Join(name, manifestFile), &signed); err != nil {
+		return ""
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(signed.Manifest, &manifest); err != nil {
+		return ""
+	}
+	return manifest.Version
+}
+
+// checkNotDowngrade returns an error if the release of manifest is older
+// than the installed release of the feature.
+func (m *Manager) checkNotDowngrade(manifest *Manifest) error {
+	installed := m.installedVersion(manifest.Name)
+	if installed == "" {
+		return nil
+	}
+	cmp, err := version.Compare(manifest.Version, installed)
+	if err != nil {
+		return fmt.Errorf("can't compare version %s of feature %s with installed version %s: %w",
+			manifest.Version, manifest.Name, installed, err)
+	}
+	if cmp < 0 {
+		return fmt.Errorf("feature %s@%s is older than installed version %s",
+			manifest.Name, manifest.Version, installed)
+	}
+	return nil
+}
+
+// platformArtifact returns the artifact of the release for the platform the
+// node runs on.
+func (m *Manifest) platformArtifact() (Artifact, error) {
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	artifact, ok := m.Artifacts[platform]
+	if !ok {
+		return Artifact{}, fmt.Errorf("feature %s@%s has no artifact for %s", m.Name, m.Version, platform)
+	}
+	return artifact, nil
+}
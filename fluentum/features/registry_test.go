@@ -0,0 +1,374 @@
+package features
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+)
+
+var testBinary = []byte("#!/bin/sh\necho feature\n")
+
+// testRegistry is a registry directory holding releases of the "test" feature.
+type testRegistry struct {
+	t    *testing.T
+	root string
+}
+
+func newTestRegistry(t *testing.T) *testRegistry {
+	return &testRegistry{t: t, root: t.TempDir()}
+}
+
+func testManifest(release string) *Manifest {
+	digest := sha256.Sum256(testBinary)
+	return &Manifest{
+		Name:           "test",
+		Version:        release,
+		Publisher:      "fluentum",
+		MinNodeVersion: "v0.1.0",
+		MaxNodeVersion: "v1.0.0",
+		Artifacts: map[string]Artifact{
+			runtime.GOOS + "/" + runtime.GOARCH: {
+				File:   "test",
+				SHA256: hex.EncodeToString(digest[:]),
+				Size:   int64(len(testBinary)),
+			},
+		},
+	}
+}
+
+// publish signs a release and adds it to the registry.
+func (r *testRegistry) publish(manifest *Manifest, binary []byte, privKeys ...crypto.PrivKey) {
+	signed, err := SignManifest(manifest, privKeys...)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	r.write(signed, binary)
+}
+
+// write adds a signed release to the registry, and makes it the latest.
+func (r *testRegistry) write(signed *SignedManifest, binary []byte) {
+	var manifest Manifest
+	if err := json.Unmarshal(signed.Manifest, &manifest); err != nil {
+		r.t.Fatal(err)
+	}
+	dir := filepath.Join(r.root, manifest.Name, manifest.Version)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		r.t.Fatal(err)
+	}
+	r.writeJSON(filepath.Join(manifest.Name, manifest.Version, manifestFile), signed)
+	if err := os.WriteFile(filepath.Join(dir, "test"), binary, 0644); err != nil {
+		r.t.Fatal(err)
+	}
+	r.writeJSON(filepath.Join(manifest.Name, indexFile), RegistryIndex{
+		Latest:   manifest.Version,
+		Versions: []string{manifest.Version},
+	})
+}
+
+func (r *testRegistry) writeJSON(name string, v interface{}) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(r.root, name), bz, 0644); err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+func newRegistryManager(t *testing.T, registry Registry, publisherKeys ...crypto.PubKey) *Manager {
+	t.Helper()
+	trustStore, err := LoadTrustStore(filepath.Join(t.TempDir(), "trusted_publishers.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pubKey := range publisherKeys {
+		if err := trustStore.Add("fluentum", TrustedKey{Algorithm: pubKey.Type(), PublicKey: pubKey.Bytes()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := NewManager(&testConfig{registry: t.TempDir()}, nil)
+	m.SetNodeVersion("v0.5.0")
+	m.SetRegistry(registry, trustStore)
+	return m
+}
+
+func TestInstallFeature(t *testing.T) {
+	for _, privKey := range []crypto.PrivKey{ed25519.GenPrivKey(), dilithium.GenPrivKey()} {
+		t.Run(privKey.Type(), func(t *testing.T) {
+			reg := newTestRegistry(t)
+			reg.publish(testManifest("1.0.0"), testBinary, privKey)
+			m := newRegistryManager(t, NewDirRegistry(reg.root), privKey.PubKey())
+
+			if err := m.InstallFeature("test", "latest"); err != nil {
+				t.Fatal(err)
+			}
+
+			binary, err := os.ReadFile(m.getPluginPath("test"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(binary) != string(testBinary) {
+				t.Fatal("installed binary differs from the artifact")
+			}
+			if version := m.installedVersion("test"); version != "1.0.0" {
+				t.Fatalf("expected installed version 1.0.0, got %q", version)
+			}
+			if err := m.verifyInstalled("test"); err != nil {
+				t.Fatal(err)
+			}
+
+			// the installed binary is pinned to its manifest
+			if err := os.WriteFile(m.getPluginPath("test"), []byte("tampered"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := m.verifyInstalled("test"); err == nil || !strings.Contains(err.Error(), "doesn't match") {
+				t.Fatalf("expected digest mismatch, got %v", err)
+			}
+		})
+	}
+}
+
+func TestInstallFeatureOverHTTP(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	reg := newTestRegistry(t)
+	reg.publish(testManifest("1.0.0"), testBinary, privKey)
+	srv := httptest.NewTLSServer(http.FileServer(http.Dir(reg.root)))
+	defer srv.Close()
+
+	m := newRegistryManager(t, NewHTTPRegistry(srv.URL, false), privKey.PubKey())
+	if err := m.InstallFeature("test", "1.0.0"); err == nil {
+		t.Fatal("expected TLS verification to fail")
+	}
+
+	m = newRegistryManager(t, NewHTTPRegistry(srv.URL, true), privKey.PubKey())
+	if err := m.InstallFeature("test", "1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.InstallFeature("test", "2.0.0"); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("expected missing release, got %v", err)
+	}
+}
+
+func TestInstallFeatureRejects(t *testing.T) {
+	trusted := ed25519.GenPrivKey()
+
+	testCases := []struct {
+		name    string
+		publish func(reg *testRegistry)
+		err     string
+	}{
+		{
+			"unsigned",
+			func(reg *testRegistry) { reg.publish(testManifest("1.0.0"), testBinary) },
+			ErrUntrustedManifest.Error(),
+		},
+		{
+			"untrusted key",
+			func(reg *testRegistry) { reg.publish(testManifest("1.0.0"), testBinary, dilithium.GenPrivKey()) },
+			ErrUntrustedManifest.Error(),
+		},
+		{
+			"other publisher",
+			func(reg *testRegistry) {
+				manifest := testManifest("1.0.0")
+				manifest.Publisher = "mallory"
+				reg.publish(manifest, testBinary, trusted)
+			},
+			ErrUntrustedManifest.Error(),
+		},
+		{
+			"tampered manifest",
+			func(reg *testRegistry) {
+				signed, err := SignManifest(testManifest("1.0.0"), trusted)
+				if err != nil {
+					t.Fatal(err)
+				}
+				signed.Manifest = json.RawMessage(strings.Replace(string(signed.Manifest), "v1.0.0", "v9.0.0", 1))
+				reg.write(signed, testBinary)
+			},
+			ErrUntrustedManifest.Error(),
+		},
+		{
+			"tampered artifact",
+			func(reg *testRegistry) { reg.publish(testManifest("1.0.0"), []byte("tampered"), trusted) },
+			"digest mismatch",
+		},
+		{
+			"oversized artifact",
+			func(reg *testRegistry) {
+				reg.publish(testManifest("1.0.0"), append(testBinary, make([]byte, 1<<20)...), trusted)
+			},
+			"larger than its declared size",
+		},
+		{
+			"missing artifact size",
+			func(reg *testRegistry) {
+				manifest := testManifest("1.0.0")
+				artifact := manifest.Artifacts[runtime.GOOS+"/"+runtime.GOARCH]
+				artifact.Size = 0
+				manifest.Artifacts[runtime.GOOS+"/"+runtime.GOARCH] = artifact
+				reg.publish(manifest, testBinary, trusted)
+			},
+			"invalid artifact size",
+		},
+		{
+			"node too old",
+			func(reg *testRegistry) {
+				manifest := testManifest("1.0.0")
+				manifest.MinNodeVersion = "v0.6.0"
+				reg.publish(manifest, testBinary, trusted)
+			},
+			"requires node version >= v0.6.0",
+		},
+		{
+			"node too new",
+			func(reg *testRegistry) {
+				manifest := testManifest("1.0.0")
+				manifest.MaxNodeVersion = "v0.5.0"
+				reg.publish(manifest, testBinary, trusted)
+			},
+			"requires node version < v0.5.0",
+		},
+		{
+			"other platform",
+			func(reg *testRegistry) {
+				manifest := testManifest("1.0.0")
+				manifest.Artifacts = map[string]Artifact{"plan9/arm": manifest.Artifacts[runtime.GOOS+"/"+runtime.GOARCH]}
+				reg.publish(manifest, testBinary, trusted)
+			},
+			"no artifact for",
+		},
+		{
+			"mismatched version",
+			func(reg *testRegistry) {
+				reg.publish(testManifest("1.0.0"), testBinary, trusted)
+				reg.writeJSON(filepath.Join("test", indexFile), RegistryIndex{Latest: "../1.0.0"})
+			},
+			"invalid version",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reg := newTestRegistry(t)
+			tc.publish(reg)
+			m := newRegistryManager(t, NewDirRegistry(reg.root), trusted.PubKey())
+
+			err := m.InstallFeature("test", "latest")
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+			if _, err := os.Stat(m.getPluginPath("test")); !errors.Is(err, os.ErrNotExist) {
+				t.Fatalf("expected no binary installed, got %v", err)
+			}
+			if downloads, _ := filepath.Glob(filepath.Join(m.config.GetRegistry(), "test", ".download-*")); len(downloads) > 0 {
+				t.Fatalf("expected no download left, got %v", downloads)
+			}
+		})
+	}
+}
+
+func TestInstallFeatureRejectsDowngrade(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	reg := newTestRegistry(t)
+	reg.publish(testManifest("1.0.0"), testBinary, privKey)
+	reg.publish(testManifest("1.1.0"), testBinary, privKey)
+	m := newRegistryManager(t, NewDirRegistry(reg.root), privKey.PubKey())
+
+	if err := m.InstallFeature("test", "1.1.0"); err != nil {
+		t.Fatal(err)
+	}
+	// reinstalling the installed release is allowed
+	if err := m.InstallFeature("test", "1.1.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the index now points back to 1.0.0
+	reg.publish(testManifest("1.0.0"), testBinary, privKey)
+	for _, release := range []string{"latest", "1.0.0"} {
+		err := m.InstallFeature("test", release)
+		if err == nil || !strings.Contains(err.Error(), "older than installed version 1.1.0") {
+			t.Fatalf("expected %s to be refused, got %v", release, err)
+		}
+	}
+	if version := m.installedVersion("test"); version != "1.1.0" {
+		t.Fatalf("expected installed version 1.1.0, got %q", version)
+	}
+}
+
+func TestInstallFeatureWithoutTrustStore(t *testing.T) {
+	m := NewManager(&testConfig{registry: t.TempDir()}, nil)
+	if err := m.InstallFeature("test", "latest"); !errors.Is(err, ErrNoRegistry) {
+		t.Fatalf("expected ErrNoRegistry, got %v", err)
+	}
+}
+
+func TestVerifyInstalledWithoutTrustStore(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	reg := newTestRegistry(t)
+	reg.publish(testManifest("1.0.0"), testBinary, privKey)
+	m := newRegistryManager(t, NewDirRegistry(reg.root), privKey.PubKey())
+	if err := m.InstallFeature("test", "latest"); err != nil {
+		t.Fatal(err)
+	}
+
+	// installed features are only loaded once verified
+	m.SetRegistry(nil, nil)
+	if err := m.verifyInstalled("test"); !errors.Is(err, ErrNoTrustStore) {
+		t.Fatalf("expected ErrNoTrustStore, got %v", err)
+	}
+	if err := m.loadFeature("test"); !errors.Is(err, ErrNoTrustStore) {
+		t.Fatalf("expected ErrNoTrustStore, got %v", err)
+	}
+}
+
+func TestTrustStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trust", "trusted_publishers.json")
+	ts, err := LoadTrustStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	key := TrustedKey{Algorithm: pubKey.Type(), PublicKey: pubKey.Bytes()}
+	if err := ts.Add("fluentum", key); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.Add("fluentum", TrustedKey{Algorithm: "rsa", PublicKey: pubKey.Bytes()}); err == nil {
+		t.Fatal("expected unsupported algorithm to be rejected")
+	}
+	if err := ts.Add("fluentum", TrustedKey{Algorithm: "ed25519", PublicKey: []byte{1}}); err == nil {
+		t.Fatal("expected invalid key to be rejected")
+	}
+
+	loaded, err := LoadTrustStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.IsTrusted("fluentum", key.Algorithm, key.PublicKey) {
+		t.Fatal("expected key to be trusted after reload")
+	}
+	if loaded.IsTrusted("other", key.Algorithm, key.PublicKey) {
+		t.Fatal("key must only be trusted for its publisher")
+	}
+
+	if err := loaded.Remove("fluentum"); err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Publishers()) != 0 {
+		t.Fatal("expected no publisher left")
+	}
+}
@@ -3,6 +3,8 @@ package state_sync
 import (
 	"fmt"
 	"time"
+
+	"github.com/fluentum-chain/fluentum/features"
 )
 
 // StateSyncFeature implements fast state synchronization
@@ -13,6 +15,8 @@ type StateSyncFeature struct {
 	version   string
 }
 
+var _ features.FeatureInterface = (*StateSyncFeature)(nil)
+
 // NewStateSyncFeature creates a new state sync feature instance
 func NewStateSyncFeature() *StateSyncFeature {
 	return &StateSyncFeature{
@@ -31,8 +35,18 @@ func (s *StateSyncFeature) Version() string {
 	return s.version
 }
 
-// Init initializes the state sync feature
-func (s *StateSyncFeature) Init(config map[string]interface{}) error {
+// Description returns the feature description
+func (s *StateSyncFeature) Description() string {
+	return "Fast state synchronization"
+}
+
+// Dependencies returns the features this feature depends on
+func (s *StateSyncFeature) Dependencies() []string {
+	return nil
+}
+
+// Initialize initializes the state sync feature
+func (s *StateSyncFeature) Initialize(config map[string]interface{}) error {
 	s.config = config
 
 	// Check if feature is enabled
@@ -72,7 +86,7 @@ func (s *StateSyncFeature) Reload() error {
 	}
 
 	// Reinitialize the feature
-	return s.Init(s.config)
+	return s.Initialize(s.config)
 }
 
 // CheckCompatibility checks if the feature is compatible with the node version
@@ -86,6 +100,11 @@ func (s *StateSyncFeature) IsEnabled() bool {
 	return s.enabled
 }
 
+// SetEnabled enables or disables the feature
+func (s *StateSyncFeature) SetEnabled(enabled bool) {
+	s.enabled = enabled
+}
+
 // SyncState performs state synchronization
 func (s *StateSyncFeature) SyncState(targetHeight int64) error {
 	if !s.enabled {
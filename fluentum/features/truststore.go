@@ -0,0 +1,144 @@
+package features
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// TrustStore holds the publisher keys trusted to sign feature manifests. It's
+// saved as a JSON file.
+type TrustStore struct {
+	path string
+
+	mu         sync.RWMutex
+	publishers map[string][]TrustedKey
+}
+
+// TrustedKey is a publisher key.
+type TrustedKey struct {
+	// Algorithm is the key type, "ed25519" or "dilithium"
+	Algorithm string `json:"algorithm"`
+	PublicKey []byte `json:"public_key"`
+}
+
+// trustStoreFile is the JSON encoding of a trust store.
+type trustStoreFile struct {
+	Publishers map[string][]TrustedKey `json:"publishers"`
+}
+
+// LoadTrustStore loads the trust store saved at path. A missing file is an
+// empty trust store, which trusts no publisher.
+func LoadTrustStore(path string) (*TrustStore, error) {
+	ts := &TrustStore{path: path, publishers: make(map[string][]TrustedKey)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ts, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust store %s: %w", path, err)
+	}
+
+	var file trustStoreFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse trust store %s: %w", path, err)
+	}
+	for publisher, keys := range file.Publishers {
+		for _, key := range keys {
+			if _, err := pubKeyFromBytes(key.Algorithm, key.PublicKey); err != nil {
+				return nil, fmt.Errorf("invalid key for publisher %s in trust store %s: %w", publisher, path, err)
+			}
+		}
+		ts.publishers[publisher] = keys
+	}
+	return ts, nil
+}
+
+// Add trusts a key to sign the manifests of a publisher, and saves the trust
+// store.
+func (ts *TrustStore) Add(publisher string, key TrustedKey) error {
+	if publisher == "" {
+		return fmt.Errorf("publisher name is required")
+	}
+	if _, err := pubKeyFromBytes(key.Algorithm, key.PublicKey); err != nil {
+		return err
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.isTrusted(publisher, key.Algorithm, key.PublicKey) {
+		return nil
+	}
+	ts.publishers[publisher] = append(ts.publishers[publisher], key)
+	return ts.save()
+}
+
+// Remove removes all keys of a publisher, and saves the trust store.
+func (ts *TrustStore) Remove(publisher string) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if _, ok := ts.publishers[publisher]; !ok {
+		return fmt.Errorf("publisher %s is not trusted", publisher)
+	}
+	delete(ts.publishers, publisher)
+	return ts.save()
+}
+
+// Publishers returns the names of the trusted publishers, sorted.
+func (ts *TrustStore) Publishers() []string {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	publishers := make([]string, 0, len(ts.publishers))
+	for publisher := range ts.publishers {
+		publishers = append(publishers, publisher)
+	}
+	sort.Strings(publishers)
+	return publishers
+}
+
+// Keys returns the trusted keys of a publisher.
+func (ts *TrustStore) Keys(publisher string) []TrustedKey {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	return append([]TrustedKey(nil), ts.publishers[publisher]...)
+}
+
+// IsTrusted returns whether a key is trusted to sign the manifests of a
+// publisher.
+func (ts *TrustStore) IsTrusted(publisher, algorithm string, pubKey []byte) bool {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	return ts.isTrusted(publisher, algorithm, pubKey)
+}
+
+func (ts *TrustStore) isTrusted(publisher, algorithm string, pubKey []byte) bool {
+	for _, key := range ts.publishers[publisher] {
+		if key.Algorithm == algorithm && bytes.Equal(key.PublicKey, pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// save writes the trust store to its file. It must be called with the mutex
+// held.
+func (ts *TrustStore) save() error {
+	data, err := json.MarshalIndent(trustStoreFile{Publishers: ts.publishers}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(ts.path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(ts.path, data, 0600)
+}
@@ -3,34 +3,27 @@ package zk_rollup
 import (
 	"fmt"
 	"time"
+
+	"github.com/fluentum-chain/fluentum/zkprover"
 )
 
 // ZKRollupFeature implements zero-knowledge rollup functionality
 type ZKRollupFeature struct {
 	enabled   bool
 	config    map[string]interface{}
-	rollup    *ZKRollup
+	rollup    *zkprover.ZKRollup
 	startTime time.Time
 	version   string
 }
 
 // ZKBatch represents a zero-knowledge proof batch
-type ZKBatch struct {
-	Proof         []byte `json:"proof"`
-	PublicSignals []byte `json:"public_signals"`
-}
-
-// ZKRollup represents a zero-knowledge rollup implementation
-type ZKRollup struct {
-	// TODO: Implement ZK rollup functionality
-}
+type ZKBatch = zkprover.ZKBatch
 
 // NewZKRollupFeature creates a new ZK rollup feature instance
 func NewZKRollupFeature() *ZKRollupFeature {
 	return &ZKRollupFeature{
 		version: "1.0.0",
 		config:  make(map[string]interface{}),
-		rollup:  NewZKRollup(),
 	}
 }
 
@@ -44,8 +37,18 @@ func (z *ZKRollupFeature) Version() string {
 	return z.version
 }
 
-// Init initializes the ZK rollup feature
-func (z *ZKRollupFeature) Init(config map[string]interface{}) error {
+// Description returns the feature description
+func (z *ZKRollupFeature) Description() string {
+	return "Zero-knowledge rollup batch verification"
+}
+
+// Dependencies returns the features this feature depends on
+func (z *ZKRollupFeature) Dependencies() []string {
+	return nil
+}
+
+// Initialize initializes the ZK rollup feature
+func (z *ZKRollupFeature) Initialize(config map[string]interface{}) error {
 	z.config = config
 
 	// Check if feature is enabled
@@ -54,6 +57,20 @@ func (z *ZKRollupFeature) Init(config map[string]interface{}) error {
 	} else {
 		z.enabled = false // Default to disabled
 	}
+	if !z.enabled {
+		return nil
+	}
+
+	// The verifying key is the snarkjs verification_key.json of the rollup circuit
+	vkPath, ok := config["verifying_key"].(string)
+	if !ok || vkPath == "" {
+		return fmt.Errorf("verifying_key must be set when the ZK rollup feature is enabled")
+	}
+	vk, err := zkprover.LoadVerifyingKey(vkPath)
+	if err != nil {
+		return err
+	}
+	z.rollup = zkprover.NewZKRollup(vk)
 
 	return nil
 }
@@ -85,7 +102,7 @@ func (z *ZKRollupFeature) Reload() error {
 	}
 
 	// Reinitialize the feature
-	return z.Init(z.config)
+	return z.Initialize(z.config)
 }
 
 // CheckCompatibility checks if the feature is compatible with the node version
@@ -99,19 +116,23 @@ func (z *ZKRollupFeature) IsEnabled() bool {
 	return z.enabled
 }
 
-// NewZKRollup creates a new ZK rollup instance
-func NewZKRollup() *ZKRollup {
-	return &ZKRollup{}
+// SetEnabled enables or disables the feature
+func (z *ZKRollupFeature) SetEnabled(enabled bool) {
+	z.enabled = enabled
 }
 
-// VerifyProof verifies a zero-knowledge proof
+// VerifyProof verifies a snarkjs-encoded Groth16 proof against the rollup's
+// verifying key. It returns false and the verification error if the proof is
+// invalid.
 func (z *ZKRollupFeature) VerifyProof(proof []byte, publicSignals []byte) (bool, error) {
 	if !z.enabled {
 		return false, fmt.Errorf("ZK rollup feature is disabled")
 	}
 
-	// TODO: Implement actual ZK proof verification
-	// For now, return true as a placeholder
+	batch := ZKBatch{Proof: proof, PublicSignals: publicSignals}
+	if err := z.rollup.VerifyBatch(batch); err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
 	"github.com/stretchr/testify/require"
 
 	"github.com/fluentum-chain/fluentum/liquidity"
@@ -26,8 +27,10 @@ func TestHybridLiquidityRouting(t *testing.T) {
 	dexClient := createTestDEXClient(t)
 
 	// Create router
-	router := liquidity.NewRouter(cexClient, dexClient)
-	require.NotNil(t, router)
+	db, err := dbm.NewDB("ledger", dbm.GoLevelDBBackend, cfg.DBDir())
+	require.NoError(t, err)
+	router, err := liquidity.NewRouter(cexClient, dexClient, liquidity.NewLedger(db))
+	require.NoError(t, err)
 
 	// Test cases
 	tests := []struct {
@@ -86,7 +89,10 @@ func TestDynamicThreshold(t *testing.T) {
 
 	cexClient := createTestCEXClient(t)
 	dexClient := createTestDEXClient(t)
-	router := liquidity.NewRouter(cexClient, dexClient)
+	db, err := dbm.NewDB("ledger", dbm.GoLevelDBBackend, cfg.DBDir())
+	require.NoError(t, err)
+	router, err := liquidity.NewRouter(cexClient, dexClient, liquidity.NewLedger(db))
+	require.NoError(t, err)
 
 	// Set initial market conditions
 	cexClient.SetLiquidity(big.NewInt(100000000000)) // 1000 FLU
@@ -107,7 +113,7 @@ func TestDynamicThreshold(t *testing.T) {
 		Timestamp: time.Now(),
 	}
 
-	err := router.RouteOrder(context.Background(), order)
+	err = router.RouteOrder(context.Background(), order)
 	require.NoError(t, err)
 
 	// Verify routing based on new threshold
@@ -2,78 +2,680 @@ package liquidity
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math"
+	"math/big"
+	"sync"
 	"time"
 
-	fluentumtypes "github.com/fluentum-chain/fluentum/types"
+	fluentumtypes "github.com/fluentum-chain/fluentum/fluentum/types"
+	tmsync "github.com/fluentum-chain/fluentum/libs/sync"
 	"github.com/fluentum-chain/fluentum/x/cex"
 	"github.com/fluentum-chain/fluentum/x/dex"
 )
 
+// Venues an order can be routed to.
+const (
+	VenueCEX = "CEX"
+	VenueDEX = "DEX"
+)
+
+const (
+	defaultSplitSteps = 20
+	defaultDecimals   = 8
+	rollbackTimeout   = 30 * time.Second
+)
+
+var (
+	// ErrNoRoute is returned when no venue, nor split across venues, can fill
+	// the order within its limit price.
+	ErrNoRoute = errors.New("no route for order")
+	// ErrRolledBack is returned when an order couldn't be completely filled
+	// and its fills were reversed.
+	ErrRolledBack = errors.New("order rolled back")
+	// ErrRollbackFailed is returned when an order couldn't be completely
+	// filled and some of its fills couldn't be reversed either. The ledger
+	// holds the fills left to reconcile.
+	ErrRollbackFailed = errors.New("order rollback failed")
+)
+
+// depthCurve returns the quote base units paid for a buy, or received for a
+// sell, of amount base units on a venue.
+type depthCurve func(amount int64) (*big.Int, error)
+
+// venue adapts the client of a trading venue to the router.
+type venue interface {
+	name() string
+	depthCurve(ctx context.Context, side fluentumtypes.Side) (depthCurve, error)
+	// execute executes the order and returns what was filled, including when
+	// it fails after a partial fill.
+	execute(ctx context.Context, order fluentumtypes.Order) (venueFill, error)
+	// wait waits for an order left open by execute to end, calling onUpdate
+	// as it fills, and returns what was filled.
+	wait(ctx context.Context, orderID string, onUpdate func(venueFill)) (venueFill, error)
+}
+
+type venueFill struct {
+	orderID  string
+	amount   int64
+	avgPrice int64
+	// open is set for orders resting on the venue until they are filled or
+	// canceled.
+	open bool
+}
+
+type cexVenue struct{ client *cex.Client }
+
+func (v cexVenue) name() string { return VenueCEX }
+
+func (v cexVenue) depthCurve(ctx context.Context, side fluentumtypes.Side) (depthCurve, error) {
+	return v.client.DepthCurve(ctx, side)
+}
+
+func (v cexVenue) execute(ctx context.Context, order fluentumtypes.Order) (venueFill, error) {
+	exec, err := v.client.ExecuteOrder(ctx, order)
+	if exec == nil {
+		return venueFill{}, err
+	}
+	return cexFill(exec), err
+}
+
+func (v cexVenue) wait(ctx context.Context, orderID string, onUpdate func(venueFill)) (venueFill, error) {
+	exec, err := v.client.WaitOrder(ctx, orderID, func(exec *cex.Execution) {
+		onUpdate(cexFill(exec))
+	})
+	if exec == nil {
+		return venueFill{orderID: orderID, open: true}, err
+	}
+	return cexFill(exec), err
+}
+
+func cexFill(exec *cex.Execution) venueFill {
+	return venueFill{
+		orderID:  exec.OrderID,
+		amount:   exec.FilledAmount,
+		avgPrice: exec.AvgPrice,
+		open:     !exec.Status.IsTerminal(),
+	}
+}
+
+type dexVenue struct{ client *dex.Client }
+
+func (v dexVenue) name() string { return VenueDEX }
+
+func (v dexVenue) depthCurve(ctx context.Context, side fluentumtypes.Side) (depthCurve, error) {
+	return v.client.DepthCurve(ctx, side)
+}
+
+func (v dexVenue) execute(ctx context.Context, order fluentumtypes.Order) (venueFill, error) {
+	exec, err := v.client.ExecuteOrder(ctx, order)
+	if exec == nil {
+		return venueFill{}, err
+	}
+	return venueFill{amount: exec.FilledAmount, avgPrice: exec.AvgPrice}, err
+}
+
+// wait is never called, as DEX orders end when they are executed.
+func (v dexVenue) wait(ctx context.Context, orderID string, onUpdate func(venueFill)) (venueFill, error) {
+	return venueFill{}, errors.New("DEX orders can't be left open")
+}
+
+// Leg is the part of a routed order sent to one venue.
+type Leg struct {
+	Venue  string
+	Amount int64
+	// Cost is the quote base units the leg is expected to pay for a buy, or
+	// receive for a sell, fees included. It is nil for stop orders.
+	Cost *big.Int
+
+	venue venue
+}
+
+// Route is the split of an order across venues.
+type Route struct {
+	Legs []Leg
+	// Cost is the total cost of the legs. It is nil for stop orders, which
+	// rest on the CEX until triggered.
+	Cost *big.Int
+}
+
 // Router handles order routing between CEX and DEX
 type Router struct {
-	cexClient    *cex.Client
-	dexClient    *dex.Client
+	cexClient      *cex.Client
+	dexClient      *dex.Client
+	venues         []venue
+	ledger         *Ledger
+	splitSteps     int
+	amountDecimals int
+
+	mtx          tmsync.Mutex
 	threshold    int64
 	lastUpdate   time.Time
 	updatePeriod time.Duration
+
+	// tracking of the orders left open on a venue
+	ctx     context.Context
+	cancel  context.CancelFunc
+	tracked sync.WaitGroup
+}
+
+// RouterOption sets an optional parameter on the Router.
+type RouterOption func(*Router)
+
+// WithSplitSteps sets the granularity of order splits: orders are split in
+// multiples of 1/steps of their amount. Default: 20.
+func WithSplitSteps(steps int) RouterOption {
+	return func(r *Router) { r.splitSteps = steps }
+}
+
+// WithAmountDecimals sets the number of decimals of base units of the base
+// token, used to check legs against limit prices. Default: 8.
+func WithAmountDecimals(decimals int) RouterOption {
+	return func(r *Router) { r.amountDecimals = decimals }
 }
 
-// NewRouter creates a new hybrid liquidity router
-func NewRouter(cexClient *cex.Client, dexClient *dex.Client) *Router {
-	return &Router{
-		cexClient:    cexClient,
-		dexClient:    dexClient,
-		threshold:    1000000000, // Initial threshold: 10 FLUX
-		lastUpdate:   time.Now(),
-		updatePeriod: 5 * time.Minute,
+// NewRouter creates a new hybrid liquidity router recording fills in ledger.
+// The ledger is what reconciles orders interrupted by a crash or left open
+// on a venue, so outside of tests it must be stored in a persistent
+// database, e.g. NewLedger(dbm.NewDB("ledger", dbm.GoLevelDBBackend, dir)).
+func NewRouter(cexClient *cex.Client, dexClient *dex.Client, ledger *Ledger, options ...RouterOption) (*Router, error) {
+	if ledger == nil {
+		return nil, errors.New("nil settlement ledger")
+	}
+	r := &Router{
+		cexClient:      cexClient,
+		dexClient:      dexClient,
+		venues:         []venue{cexVenue{cexClient}, dexVenue{dexClient}},
+		ledger:         ledger,
+		splitSteps:     defaultSplitSteps,
+		amountDecimals: defaultDecimals,
+		threshold:      1000000000, // Initial threshold: 10 FLUX
+		lastUpdate:     time.Now(),
+		updatePeriod:   5 * time.Minute,
+	}
+	for _, option := range options {
+		option(r)
 	}
+	if r.splitSteps < 1 {
+		r.splitSteps = 1
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	return r, nil
 }
 
-// RouteOrder routes an order to either CEX or DEX based on best price and dynamic threshold
+// Close stops tracking the orders left open on a venue, and waits for the
+// trackers to return. The orders stay open on their venue, and their
+// settlements open in the ledger, with the venue order ID of the open leg
+// in its fill.
+func (r *Router) Close() {
+	r.cancel()
+	r.tracked.Wait()
+}
+
+// Ledger returns the settlement ledger of the router.
+func (r *Router) Ledger() *Ledger {
+	return r.ledger
+}
+
+// RouteOrder executes an order across CEX and DEX along the cheapest route,
+// and records each fill in the settlement ledger. A leg that is only
+// partially filled has its remainder routed to the other venue. If the order
+// still can't be completely filled, the fills of an immediate or cancel
+// order are kept and the rest of the order canceled; those of other orders
+// are reversed and ErrRolledBack is returned. Order IDs must be unique.
+//
+// Reversals are market orders in the opposite direction, so a rollback is
+// not atomic: it trades at the prices of the moment, and may itself fail.
+// Fill or kill orders are therefore never split nor rerouted across venues,
+// and are only as atomic as the venue they are sent to.
+//
+// A good till cancel limit or stop order may rest on the CEX book. Its leg
+// is then left open, its settlement set to SettlementOpen, and RouteOrder
+// returns: the router tracks the leg in the background, and executes the
+// rest of the route once the leg ends, recording the fills and the outcome
+// in the ledger.
 func (r *Router) RouteOrder(ctx context.Context, order fluentumtypes.Order) error {
-	// Update threshold if needed
-	if time.Since(r.lastUpdate) > r.updatePeriod {
-		r.updateThreshold()
-	}
-
-	// Quote both venues
-	cexPrice, errCEX := r.cexClient.QuoteBestPrice(ctx, order)
-	dexPrice, errDEX := r.dexClient.QuoteBestPrice(ctx, order)
-
-	// Select best price (lower for buy, higher for sell)
-	venue := ""
-	var execErr error
-	if (order.Side == "buy" && (errCEX == nil && (errDEX != nil || cexPrice <= dexPrice))) ||
-		(order.Side == "sell" && (errCEX == nil && (errDEX != nil || cexPrice >= dexPrice))) {
-		execErr = r.cexClient.ExecuteOrder(ctx, order)
-		venue = "CEX"
-	} else if errDEX == nil {
-		execErr = r.dexClient.ExecuteOrder(ctx, order)
-		venue = "DEX"
+	r.mtx.Lock()
+	stale := time.Since(r.lastUpdate) > r.updatePeriod
+	r.mtx.Unlock()
+	if stale {
+		if err := r.updateThreshold(ctx); err != nil {
+			// keep routing with the previous threshold
+			r.mtx.Lock()
+			r.lastUpdate = time.Now()
+			r.mtx.Unlock()
+		}
+	}
+
+	if err := order.Validate(); err != nil {
+		return err
+	}
+	if order.ID == "" {
+		return fmt.Errorf("%w: no ID", fluentumtypes.ErrInvalidOrder)
+	}
+	if _, err := r.ledger.Settlement(order.ID); err == nil {
+		return fmt.Errorf("order %s already routed", order.ID)
+	} else if !errors.Is(err, ErrSettlementNotFound) {
+		return err
+	}
+
+	route, err := r.PlanRoute(ctx, order)
+	if err != nil {
+		return err
+	}
+	return r.executeRoute(ctx, order, route)
+}
+
+// PlanRoute returns the route that fills the order at the lowest total cost
+// for a buy, or the highest proceeds for a sell, given the depth of each
+// venue. Orders below the dynamic threshold are sent whole to a single venue;
+// larger ones may be split across venues. Each leg of a limit order must fill
+// within its limit price. Fill or kill orders are sent whole to a single
+// venue. Stop orders are sent whole to the CEX, as the DEX can't hold them
+// until they are triggered.
+func (r *Router) PlanRoute(ctx context.Context, order fluentumtypes.Order) (*Route, error) {
+	if err := order.Validate(); err != nil {
+		return nil, err
+	}
+	side := order.Side
+	if order.Type.IsStop() {
+		v := r.venueByName(VenueCEX)
+		return &Route{Legs: []Leg{{Venue: v.name(), Amount: order.Remaining(), venue: v}}}, nil
+	}
+
+	curves := make([]depthCurve, len(r.venues))
+	var errs []error
+	for i, v := range r.venues {
+		curve, err := v.depthCurve(ctx, side)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", v.name(), err))
+			continue
+		}
+		curves[i] = curve
+	}
+
+	steps := 1
+	r.mtx.Lock()
+	if order.Remaining() >= r.threshold && len(r.venues) == 2 &&
+		order.TimeInForce != fluentumtypes.FillOrKill {
+		steps = r.splitSteps
+	}
+	r.mtx.Unlock()
+
+	// The first venue gets i/steps of the order, the second the rest. On
+	// ties, fewer legs and the first venue are preferred.
+	var best *Route
+	for i := steps; i >= 0; i-- {
+		first := splitAmount(order.Remaining(), i, steps)
+		route, err := r.evaluate(order, curves, []int64{first, order.Remaining() - first})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if best == nil || r.better(side, route, best) {
+			best = route
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("%w %s: %w", ErrNoRoute, order.ID, errors.Join(errs...))
+	}
+	return best, nil
+}
+
+// evaluate returns the route sending amounts[i] to venue i.
+func (r *Router) evaluate(order fluentumtypes.Order, curves []depthCurve, amounts []int64) (*Route, error) {
+	route := &Route{Cost: new(big.Int)}
+	sell := order.Side == fluentumtypes.Sell
+	for i, amount := range amounts {
+		if amount == 0 {
+			continue
+		}
+		v := r.venues[i]
+		if curves[i] == nil {
+			return nil, fmt.Errorf("%s unavailable", v.name())
+		}
+		cost, err := curves[i](amount)
+		if err != nil {
+			return nil, fmt.Errorf("%s can't fill %d: %w", v.name(), amount, err)
+		}
+		if order.Type.HasLimitPrice() {
+			// compare cost*scale with price*amount
+			lhs := new(big.Int).Mul(cost, r.scale())
+			rhs := new(big.Int).Mul(big.NewInt(order.Price), big.NewInt(amount))
+			if (sell && lhs.Cmp(rhs) < 0) || (!sell && lhs.Cmp(rhs) > 0) {
+				return nil, fmt.Errorf("%s can't fill %d at %d", v.name(), amount, order.Price)
+			}
+		}
+		route.Legs = append(route.Legs, Leg{Venue: v.name(), Amount: amount, Cost: cost, venue: v})
+		route.Cost.Add(route.Cost, cost)
+	}
+	return route, nil
+}
+
+// better reports whether route a is strictly better than route b.
+func (r *Router) better(side fluentumtypes.Side, a, b *Route) bool {
+	if c := a.Cost.Cmp(b.Cost); c != 0 {
+		return (side == fluentumtypes.Buy) == (c < 0)
+	}
+	return len(a.Legs) < len(b.Legs)
+}
+
+// routeExecution is an order being executed along a route.
+type routeExecution struct {
+	order      fluentumtypes.Order
+	settlement Settlement
+	pending    []Leg
+	failed     map[string]bool
+	fills      []Fill
+}
+
+// executeRoute executes the legs of the route, rerouting the remainder of
+// partially filled legs, and settles or rolls back the order.
+func (r *Router) executeRoute(ctx context.Context, order fluentumtypes.Order, route *Route) error {
+	e := &routeExecution{
+		order: order,
+		settlement: Settlement{
+			OrderID:   order.ID,
+			Order:     order,
+			Status:    SettlementPending,
+			UpdatedAt: time.Now(),
+		},
+		pending: append([]Leg(nil), route.Legs...),
+		failed:  make(map[string]bool),
+	}
+	if err := r.ledger.SetSettlement(e.settlement); err != nil {
+		return fmt.Errorf("failed to record order %s: %w", order.ID, err)
+	}
+	return r.execute(ctx, e)
+}
+
+// execute executes the pending legs of e until the order is settled, rolled
+// back, or has a leg left open, which is then tracked in the background.
+func (r *Router) execute(ctx context.Context, e *routeExecution) error {
+	order := e.order
+	for len(e.pending) > 0 {
+		leg := e.pending[0]
+		e.pending = e.pending[1:]
+		if err := ctx.Err(); err != nil {
+			return r.rollback(ctx, e.settlement, e.fills, err)
+		}
+
+		legOrder := order
+		legOrder.ID = fmt.Sprintf("%s-%d", order.ID, len(e.fills))
+		legOrder.ClientOrderID = fmt.Sprintf("%s-%d", order.VenueOrderID(), len(e.fills))
+		legOrder.Amount = leg.Amount
+		legOrder.Status, legOrder.FilledAmount, legOrder.AvgPrice = fluentumtypes.OrderNew, 0, 0
+		res, execErr := leg.venue.execute(ctx, legOrder)
+
+		if res.open {
+			// the rest of the route waits for the leg to end
+			e.settlement.Status = SettlementOpen
+			err := r.recordFill(e, leg, res)
+			r.tracked.Add(1)
+			go r.track(e, leg)
+			if err != nil {
+				return fmt.Errorf("order %s left open on %s: %w", order.ID, leg.Venue, err)
+			}
+			return nil
+		}
+		if res.amount > 0 {
+			if err := r.recordFill(e, leg, res); err != nil {
+				return r.rollback(ctx, e.settlement, e.fills, err)
+			}
+		}
+		if done, err := r.endLeg(ctx, e, leg, res, execErr); done {
+			return err
+		}
+	}
+
+	e.settlement.Status = SettlementSettled
+	e.settlement.UpdatedAt = time.Now()
+	return r.ledger.SetSettlement(e.settlement)
+}
+
+// track waits for the open leg of e, the last of its fills, to end, and
+// executes the rest of the route. If the router is closed first, the leg is
+// left open.
+func (r *Router) track(e *routeExecution, leg Leg) {
+	defer r.tracked.Done()
+
+	seq := len(e.fills) - 1
+	res, err := leg.venue.wait(r.ctx, e.fills[seq].VenueOrderID, func(res venueFill) {
+		// the ledger is written again once the leg ends
+		_ = r.updateFill(e, seq, res)
+	})
+	if r.ctx.Err() != nil {
+		return
+	}
+	e.settlement.Status = SettlementPending
+	if recordErr := r.updateFill(e, seq, res); recordErr != nil {
+		_ = r.rollback(r.ctx, e.settlement, e.fills, recordErr)
+		return
+	}
+	if done, _ := r.endLeg(r.ctx, e, leg, res, err); done {
+		return
+	}
+	// the outcome is recorded in the ledger
+	_ = r.execute(r.ctx, e)
+}
+
+// recordFill records the fill of a leg of e.
+func (r *Router) recordFill(e *routeExecution, leg Leg, res venueFill) error {
+	e.fills = append(e.fills, Fill{
+		OrderID:      e.order.ID,
+		Seq:          len(e.fills),
+		Venue:        leg.Venue,
+		VenueOrderID: res.orderID,
+		Side:         e.order.Side,
+		Requested:    leg.Amount,
+	})
+	return r.updateFill(e, len(e.fills)-1, res)
+}
+
+// updateFill sets the fill seq of e to res, and records it and the
+// settlement of e.
+func (r *Router) updateFill(e *routeExecution, seq int, res venueFill) error {
+	fill := &e.fills[seq]
+	fill.Amount, fill.AvgPrice, fill.Timestamp = res.amount, res.avgPrice, time.Now()
+	switch {
+	case res.open:
+		fill.Status = FillOpen
+	case res.amount < fill.Requested:
+		fill.Status = FillPartial
+	default:
+		fill.Status = FillFilled
+	}
+
+	// a DEX buy may receive slightly more than ordered
+	e.settlement.Order = e.order
+	for _, f := range e.fills {
+		if amount := min(f.Amount, e.settlement.Order.Remaining()); amount > 0 {
+			_ = e.settlement.Order.Fill(amount, f.AvgPrice)
+		}
+	}
+	e.settlement.UpdatedAt = time.Now()
+
+	if err := r.ledger.PutFill(*fill); err != nil {
+		return fmt.Errorf("failed to record fill: %w", err)
+	}
+	if err := r.ledger.SetSettlement(e.settlement); err != nil {
+		return fmt.Errorf("failed to record fill: %w", err)
+	}
+	return nil
+}
+
+// endLeg routes the remainder of a leg that ended with res to another venue,
+// unless the order is fill or kill. If none is left, it keeps the fills of an immediate or cancel order and
+// rolls back those of other orders, and returns true with the outcome.
+func (r *Router) endLeg(ctx context.Context, e *routeExecution, leg Leg, res venueFill, execErr error) (bool, error) {
+	if res.amount >= leg.Amount {
+		return false, nil
+	}
+
+	// route the remainder to another venue that hasn't failed
+	e.failed[leg.Venue] = true
+	if execErr == nil {
+		execErr = fmt.Errorf("%s filled %d of %d", leg.Venue, res.amount, leg.Amount)
+	}
+	remainder := leg.Amount - res.amount
+	if e.order.TimeInForce != fluentumtypes.FillOrKill && r.reroute(&e.pending, e.failed, remainder) {
+		return false, nil
+	}
+	if e.order.TimeInForce == fluentumtypes.ImmediateOrCancel && e.settlement.Order.FilledAmount > 0 {
+		// keep what was filled and cancel the rest
+		_ = e.settlement.Order.SetStatus(fluentumtypes.OrderCanceled)
+		e.settlement.Status = SettlementPartial
+		e.settlement.Error = execErr.Error()
+		e.settlement.UpdatedAt = time.Now()
+		return true, r.ledger.SetSettlement(e.settlement)
+	}
+	return true, r.rollback(ctx, e.settlement, e.fills, execErr)
+}
+
+// reroute adds amount to a pending leg on a venue that hasn't failed, or to
+// a new leg. It returns false if every venue failed.
+func (r *Router) reroute(pending *[]Leg, failed map[string]bool, amount int64) bool {
+	for i, leg := range *pending {
+		if !failed[leg.Venue] {
+			(*pending)[i].Amount += amount
+			return true
+		}
+	}
+	for _, v := range r.venues {
+		if !failed[v.name()] {
+			*pending = append(*pending, Leg{Venue: v.name(), Amount: amount, venue: v})
+			return true
+		}
+	}
+	return false
+}
+
+// rollback reverses the fills of an order that couldn't be completed because
+// of cause, with market orders on the venues they were filled on. Reversals
+// are made even if ctx is done, but aren't atomic with the fills: prices may
+// have moved, and reversals that fail are left to reconcile in the ledger.
+func (r *Router) rollback(ctx context.Context, settlement Settlement, fills []Fill, cause error) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+
+	order := settlement.Order
+	opposite := order.Side.Opposite()
+
+	var errs []error
+	seq := len(fills)
+	for i := len(fills) - 1; i >= 0; i-- {
+		fill := fills[i]
+		if fill.Amount == 0 {
+			// an open leg that ended without a fill
+			continue
+		}
+		v := r.venueByName(fill.Venue)
+
+		reverse := fluentumtypes.Order{
+			ID:        fmt.Sprintf("%s-%d", order.ID, seq),
+			Type:      fluentumtypes.MarketOrder,
+			Side:      opposite,
+			Amount:    fill.Amount,
+			Timestamp: time.Now(),
+		}
+		res, err := v.execute(ctx, reverse)
+		if res.amount > 0 {
+			reversal := Fill{
+				OrderID:      order.ID,
+				Seq:          seq,
+				Venue:        fill.Venue,
+				VenueOrderID: res.orderID,
+				Side:         opposite,
+				Requested:    fill.Amount,
+				Amount:       res.amount,
+				AvgPrice:     res.avgPrice,
+				Status:       FillReversal,
+				Timestamp:    time.Now(),
+			}
+			if err := r.ledger.PutFill(reversal); err != nil {
+				errs = append(errs, fmt.Errorf("failed to record reversal of fill %d: %w", fill.Seq, err))
+			}
+			seq++
+		}
+		if res.amount < fill.Amount {
+			if err == nil {
+				err = fmt.Errorf("reversed %d of %d", res.amount, fill.Amount)
+			}
+			errs = append(errs, fmt.Errorf("failed to reverse fill %d on %s: %w", fill.Seq, fill.Venue, err))
+			continue
+		}
+
+		fill.Status = FillRolledBack
+		fill.ReversedBy = seq - 1
+		if err := r.ledger.PutFill(fill); err != nil {
+			errs = append(errs, fmt.Errorf("failed to record rollback of fill %d: %w", fill.Seq, err))
+		}
+	}
+
+	if order.FilledAmount > 0 {
+		_ = settlement.Order.SetStatus(fluentumtypes.OrderCanceled)
 	} else {
-		return errCEX // or errDEX, both failed
+		_ = settlement.Order.SetStatus(fluentumtypes.OrderRejected)
+	}
+	settlement.Status = SettlementRolledBack
+	settlement.Error = cause.Error()
+	if len(errs) > 0 {
+		settlement.Status = SettlementFailed
+		settlement.Error = errors.Join(append([]error{cause}, errs...)...).Error()
 	}
-	if execErr != nil {
-		return execErr
+	settlement.UpdatedAt = time.Now()
+	if err := r.ledger.SetSettlement(settlement); err != nil {
+		errs = append(errs, fmt.Errorf("failed to record settlement: %w", err))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: order %s: %w; %w", ErrRollbackFailed, order.ID, cause, errors.Join(errs...))
 	}
-	return r.settleOrder(ctx, order, venue)
+	return fmt.Errorf("%w: order %s: %w", ErrRolledBack, order.ID, cause)
 }
 
-// settleOrder records the unified trade in the settlement layer
-func (r *Router) settleOrder(ctx context.Context, order fluentumtypes.Order, venue string) error {
-	// TODO: Implement settlement logic (e.g., update trade records, emit event, etc.)
-	// Example: log.Printf("Settled order %s on %s", order.ID, venue)
+func (r *Router) venueByName(name string) venue {
+	for _, v := range r.venues {
+		if v.name() == name {
+			return v
+		}
+	}
 	return nil
 }
 
+func (r *Router) scale() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(r.amountDecimals)), nil)
+}
+
+// splitAmount returns i/n of amount, rounded down.
+func splitAmount(amount int64, i, n int) int64 {
+	x := new(big.Int).Mul(big.NewInt(amount), big.NewInt(int64(i)))
+	return x.Quo(x, big.NewInt(int64(n))).Int64()
+}
+
 // updateThreshold calculates new threshold based on market conditions
-func (r *Router) updateThreshold() {
+func (r *Router) updateThreshold(ctx context.Context) error {
 	// Get current market conditions
-	cexLiquidity := r.cexClient.GetTotalLiquidity()
-	dexLiquidity := r.dexClient.GetTotalLiquidity()
-	cexFees := r.cexClient.GetAverageFees()
-	dexFees := r.dexClient.GetAverageFees()
+	cexLiquidity, err := r.cexClient.GetTotalLiquidity(ctx)
+	if err != nil {
+		return err
+	}
+	cexFees, err := r.cexClient.GetAverageFees(ctx)
+	if err != nil {
+		return err
+	}
+	dexLiquidity, err := r.dexClient.GetTotalLiquidity(ctx)
+	if err != nil {
+		return err
+	}
+	dexFees, err := r.dexClient.GetAverageFees(ctx)
+	if err != nil {
+		return err
+	}
+	if dexLiquidity <= 0 || dexFees <= 0 {
+		return errors.New("no DEX liquidity")
+	}
 
 	// Calculate optimal threshold based on:
 	// 1. Relative liquidity between CEX and DEX
@@ -84,6 +686,9 @@ func (r *Router) updateThreshold() {
 
 	// Adjust threshold based on market conditions
 	adjustment := math.Sqrt(liquidityRatio * feeRatio)
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
 	newThreshold := int64(float64(r.threshold) * adjustment)
 
 	// Apply bounds to prevent extreme values
@@ -91,6 +696,7 @@ func (r *Router) updateThreshold() {
 	maxThreshold := int64(10000000000) // 100 FLUX
 	r.threshold = clamp(newThreshold, minThreshold, maxThreshold)
 	r.lastUpdate = time.Now()
+	return nil
 }
 
 // clamp ensures a value stays within specified bounds
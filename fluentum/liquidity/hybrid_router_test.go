@@ -0,0 +1,476 @@
+package liquidity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	fluentumtypes "github.com/fluentum-chain/fluentum/fluentum/types"
+	"github.com/fluentum-chain/fluentum/x/cex"
+	"github.com/fluentum-chain/fluentum/x/dex"
+)
+
+const unit = 1e8
+
+// stubExchange is an httptest stand-in for the exchange REST API. Orders
+// end as soon as they are placed, with the outcomes scripted in order:
+// "fill", "half" (canceled after filling half) or "reject". Orders past the
+// script fill. An "open" order rests on the book, and its status polls
+// return openStatus.
+type stubExchange struct {
+	*httptest.Server
+
+	mtx        sync.Mutex
+	outcomes   []string
+	placed     []map[string]string
+	openStatus map[string]string
+}
+
+func newStubExchange(t *testing.T, outcomes ...string) *stubExchange {
+	se := &stubExchange{outcomes: outcomes}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/depth", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"bids": [][2]string{{"1.00", "100"}, {"0.90", "100"}, {"0.50", "1000"}},
+			"asks": [][2]string{{"1.00", "100"}, {"1.10", "100"}, {"1.50", "1000"}},
+		})
+	})
+	mux.HandleFunc("/api/v1/fees", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{"maker": "0.0008", "taker": "0.001"})
+	})
+	mux.HandleFunc("/api/v1/order", se.handleOrder)
+	se.Server = httptest.NewServer(mux)
+	t.Cleanup(se.Close)
+	return se
+}
+
+func (se *stubExchange) handleOrder(w http.ResponseWriter, r *http.Request) {
+	se.mtx.Lock()
+	defer se.mtx.Unlock()
+
+	if r.Method == http.MethodGet {
+		writeJSON(w, se.openStatus)
+		return
+	}
+	var req map[string]string
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	se.placed = append(se.placed, req)
+
+	outcome := "fill"
+	if len(se.outcomes) > 0 {
+		outcome, se.outcomes = se.outcomes[0], se.outcomes[1:]
+	}
+	resp := map[string]string{"order_id": req["client_order_id"], "avg_price": "1.00"}
+	switch outcome {
+	case "fill":
+		resp["status"], resp["filled_amount"] = "FILLED", req["quantity"]
+	case "half":
+		qty, _ := new(big.Rat).SetString(req["quantity"])
+		resp["status"], resp["filled_amount"] = "CANCELED", qty.Quo(qty, big.NewRat(2, 1)).FloatString(8)
+	case "reject":
+		resp["status"], resp["filled_amount"] = "REJECTED", "0"
+	case "open":
+		resp["status"], resp["filled_amount"] = "NEW", "0"
+	}
+	writeJSON(w, resp)
+}
+
+func (se *stubExchange) sides() []string {
+	se.mtx.Lock()
+	defer se.mtx.Unlock()
+	sides := make([]string, len(se.placed))
+	for i, req := range se.placed {
+		sides[i] = req["side"]
+	}
+	return sides
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// failingPool is a pool whose swaps always fail.
+type failingPool struct{ *dex.NativePool }
+
+func (failingPool) Swap(context.Context, bool, *big.Int, *big.Int) (*big.Int, error) {
+	return nil, errors.New("swap reverted")
+}
+
+// newTestRouter returns a router over the stub exchange and a pool of 10,000
+// base and quote tokens.
+func newTestRouter(t *testing.T, se *stubExchange, pool dex.Pool) *Router {
+	cexClient := cex.NewClient("key", "secret", se.URL,
+		cex.WithPollInterval(time.Millisecond), cex.WithRateLimit(1000, 100))
+	r, err := NewRouter(cexClient, dex.NewClient(pool), NewLedger(dbm.NewMemDB()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func newTestPool() *dex.NativePool {
+	return dex.NewNativePool(big.NewInt(10_000*unit), big.NewInt(10_000*unit), 3000)
+}
+
+func TestPlanRouteSplitsLargeOrders(t *testing.T) {
+	r := newTestRouter(t, newStubExchange(t), newTestPool())
+	ctx := context.Background()
+
+	// the CEX is cheapest for the first 100 tokens, then the DEX
+	order := fluentumtypes.Order{ID: "1", Side: "buy", Amount: 300 * unit}
+	route, err := r.PlanRoute(ctx, order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(route.Legs) != 2 || route.Legs[0].Venue != VenueCEX || route.Legs[1].Venue != VenueDEX {
+		t.Fatalf("expected a CEX and a DEX leg, got %+v", route.Legs)
+	}
+	if route.Legs[0].Amount+route.Legs[1].Amount != order.Amount {
+		t.Errorf("legs don't add up to the order: %+v", route.Legs)
+	}
+	for _, amounts := range [][]int64{{order.Amount, 0}, {0, order.Amount}} {
+		curves := make([]depthCurve, 2)
+		for i, v := range r.venues {
+			curves[i], _ = v.depthCurve(ctx, "buy")
+		}
+		single, err := r.evaluate(order, curves, amounts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if route.Cost.Cmp(single.Cost) >= 0 {
+			t.Errorf("split cost %s isn't below single venue cost %s", route.Cost, single.Cost)
+		}
+	}
+
+	// orders below the threshold aren't split
+	route, err = r.PlanRoute(ctx, fluentumtypes.Order{ID: "2", Side: "sell", Amount: 5 * unit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(route.Legs) != 1 || route.Legs[0].Venue != VenueCEX {
+		t.Errorf("expected a single CEX leg, got %+v", route.Legs)
+	}
+
+	// legs of limit orders must fill within the limit
+	route, err = r.PlanRoute(ctx, fluentumtypes.Order{
+		ID: "3", Type: fluentumtypes.LimitOrder, Side: "buy", Amount: 150 * unit, Price: 1.01 * unit,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, leg := range route.Legs {
+		if leg.Cost.Cmp(big.NewInt(leg.Amount*101/100)) > 0 {
+			t.Errorf("leg %+v exceeds the limit price", leg)
+		}
+	}
+	_, err = r.PlanRoute(ctx, fluentumtypes.Order{
+		ID: "4", Type: fluentumtypes.LimitOrder, Side: "buy", Amount: 300 * unit, Price: 0.99 * unit,
+	})
+	if !errors.Is(err, ErrNoRoute) {
+		t.Errorf("expected ErrNoRoute, got %v", err)
+	}
+}
+
+func TestRouteOrderSettles(t *testing.T) {
+	se := newStubExchange(t)
+	pool := newTestPool()
+	r := newTestRouter(t, se, pool)
+	ctx := context.Background()
+
+	order := fluentumtypes.Order{ID: "1", Side: "buy", Amount: 300 * unit}
+	if err := r.RouteOrder(ctx, order); err != nil {
+		t.Fatalf("failed to route order: %v", err)
+	}
+
+	s, err := r.Ledger().Settlement("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Status != SettlementSettled || s.Order.Status != fluentumtypes.OrderFilled || s.Order.FilledAmount != order.Amount {
+		t.Errorf("expected order settled, got %+v", s)
+	}
+	fills, err := r.Ledger().Fills("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 2 || fills[0].Venue != VenueCEX || fills[1].Venue != VenueDEX {
+		t.Fatalf("expected a CEX and a DEX fill, got %+v", fills)
+	}
+	var filled int64
+	for _, f := range fills {
+		if f.Status != FillFilled {
+			t.Errorf("unexpected fill %+v", f)
+		}
+		filled += f.Amount
+	}
+	if filled < order.Amount {
+		t.Errorf("filled %d of %d", filled, order.Amount)
+	}
+	reserves, _ := pool.Reserves(ctx)
+	if bought := 10_000*unit - reserves.Base.Int64(); bought < fills[1].Requested {
+		t.Errorf("DEX leg bought %d, expected %d", bought, fills[1].Requested)
+	}
+
+	if err := r.RouteOrder(ctx, order); err == nil {
+		t.Error("expected an error routing the same order twice")
+	}
+}
+
+func TestRouteOrderReroutesPartialFills(t *testing.T) {
+	se := newStubExchange(t, "half")
+	r := newTestRouter(t, se, newTestPool())
+
+	if err := r.RouteOrder(context.Background(), fluentumtypes.Order{ID: "1", Side: "buy", Amount: 300 * unit}); err != nil {
+		t.Fatalf("failed to route order: %v", err)
+	}
+	fills, err := r.Ledger().Fills("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 2 {
+		t.Fatalf("expected 2 fills, got %+v", fills)
+	}
+	cexFill, dexFill := fills[0], fills[1]
+	if cexFill.Status != FillPartial || cexFill.Amount != cexFill.Requested/2 {
+		t.Errorf("unexpected CEX fill %+v", cexFill)
+	}
+	if dexFill.Venue != VenueDEX || dexFill.Requested != 300*unit-cexFill.Amount {
+		t.Errorf("expected the DEX to fill the remainder, got %+v", dexFill)
+	}
+	if s, _ := r.Ledger().Settlement("1"); s.Status != SettlementSettled {
+		t.Errorf("expected order settled, got %+v", s)
+	}
+}
+
+func TestRouteOrderRollsBack(t *testing.T) {
+	// the CEX leg fills, the DEX leg fails and so does its remainder on the CEX
+	se := newStubExchange(t, "fill", "reject")
+	r := newTestRouter(t, se, failingPool{newTestPool()})
+
+	err := r.RouteOrder(context.Background(), fluentumtypes.Order{ID: "1", Side: "buy", Amount: 300 * unit})
+	if !errors.Is(err, ErrRolledBack) {
+		t.Fatalf("expected ErrRolledBack, got %v", err)
+	}
+	if sides := se.sides(); len(sides) != 3 || sides[0] != "BUY" || sides[1] != "BUY" || sides[2] != "SELL" {
+		t.Errorf("expected the CEX fill to be sold back, got orders %v", sides)
+	}
+
+	fills, err := r.Ledger().Fills("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 2 {
+		t.Fatalf("expected a fill and its reversal, got %+v", fills)
+	}
+	if fills[0].Status != FillRolledBack || fills[0].ReversedBy != 1 {
+		t.Errorf("unexpected rolled back fill %+v", fills[0])
+	}
+	if fills[1].Status != FillReversal || fills[1].Side != "sell" || fills[1].Amount != fills[0].Amount {
+		t.Errorf("unexpected reversal %+v", fills[1])
+	}
+	if s, _ := r.Ledger().Settlement("1"); s.Status != SettlementRolledBack || s.Error == "" ||
+		s.Order.Status != fluentumtypes.OrderCanceled {
+		t.Errorf("expected order rolled back, got %+v", s)
+	}
+}
+
+func TestRouteOrderKeepsImmediateOrCancelFills(t *testing.T) {
+	se := newStubExchange(t, "fill", "reject")
+	r := newTestRouter(t, se, failingPool{newTestPool()})
+
+	order := fluentumtypes.Order{ID: "1", Side: "buy", Amount: 300 * unit, TimeInForce: fluentumtypes.ImmediateOrCancel}
+	if err := r.RouteOrder(context.Background(), order); err != nil {
+		t.Fatalf("failed to route order: %v", err)
+	}
+	if sides := se.sides(); len(sides) != 2 {
+		t.Errorf("expected no reversal, got orders %v", sides)
+	}
+	s, err := r.Ledger().Settlement("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fills, _ := r.Ledger().Fills("1")
+	if s.Status != SettlementPartial || s.Order.Status != fluentumtypes.OrderCanceled ||
+		len(fills) != 1 || s.Order.FilledAmount != fills[0].Amount {
+		t.Errorf("expected the CEX fill kept and the rest canceled, got %+v and fills %+v", s, fills)
+	}
+}
+
+func TestRouteFillOrKillOrderToOneVenue(t *testing.T) {
+	se := newStubExchange(t, "half")
+	r := newTestRouter(t, se, failingPool{newTestPool()})
+
+	// large enough to be split, were it not fill or kill
+	order := fluentumtypes.Order{
+		ID: "1", Type: fluentumtypes.LimitOrder, Side: "buy", Amount: 300 * unit, Price: 2 * unit,
+		TimeInForce: fluentumtypes.FillOrKill,
+	}
+	route, err := r.PlanRoute(context.Background(), order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(route.Legs) != 1 {
+		t.Fatalf("expected a single leg, got %+v", route.Legs)
+	}
+
+	// nor is what its venue didn't fill rerouted to the other one
+	if err := r.RouteOrder(context.Background(), order); !errors.Is(err, ErrRolledBack) {
+		t.Fatalf("expected ErrRolledBack, got %v", err)
+	}
+	fills, err := r.Ledger().Fills("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fill := range fills {
+		if fill.Venue != route.Legs[0].Venue {
+			t.Errorf("expected fills on %s only, got %+v", route.Legs[0].Venue, fill)
+		}
+	}
+}
+
+func TestRouteStopOrderToCEX(t *testing.T) {
+	se := newStubExchange(t)
+	r := newTestRouter(t, se, newTestPool())
+
+	order := fluentumtypes.Order{ID: "1", Type: fluentumtypes.StopOrder, Side: "sell", Amount: 300 * unit, StopPrice: 0.9 * unit}
+	if err := r.RouteOrder(context.Background(), order); err != nil {
+		t.Fatalf("failed to route order: %v", err)
+	}
+	se.mtx.Lock()
+	defer se.mtx.Unlock()
+	if len(se.placed) != 1 || se.placed[0]["type"] != "STOP_MARKET" || se.placed[0]["quantity"] != "300.00000000" {
+		t.Errorf("expected the whole stop order on the CEX, got %v", se.placed)
+	}
+
+	if err := r.RouteOrder(context.Background(), fluentumtypes.Order{ID: "2", Side: "sell"}); !errors.Is(err, fluentumtypes.ErrInvalidOrder) {
+		t.Errorf("expected ErrInvalidOrder, got %v", err)
+	}
+}
+
+func TestRouteOrderTracksOpenOrders(t *testing.T) {
+	se := newStubExchange(t, "open")
+	se.openStatus = map[string]string{"order_id": "1-0", "status": "NEW", "filled_amount": "0"}
+	r := newTestRouter(t, se, newTestPool())
+	defer r.Close()
+
+	order := fluentumtypes.Order{ID: "1", Type: fluentumtypes.StopOrder, Side: "sell", Amount: 300 * unit, StopPrice: 0.9 * unit}
+	if err := r.RouteOrder(context.Background(), order); err != nil {
+		t.Fatalf("failed to route order: %v", err)
+	}
+	if s, _ := r.Ledger().Settlement("1"); s.Status != SettlementOpen {
+		t.Fatalf("expected order open, got %+v", s)
+	}
+
+	se.mtx.Lock()
+	se.openStatus = map[string]string{"order_id": "1-0", "status": "PARTIALLY_FILLED", "filled_amount": "100", "avg_price": "0.90"}
+	se.mtx.Unlock()
+	waitForSettlement(t, r, "1", func(s *Settlement) bool {
+		return s.Status == SettlementOpen && s.Order.FilledAmount == 100*unit
+	})
+	if fills, _ := r.Ledger().Fills("1"); len(fills) != 1 || fills[0].Status != FillOpen || fills[0].VenueOrderID != "1-0" {
+		t.Errorf("expected the open leg recorded, got %+v", fills)
+	}
+
+	se.mtx.Lock()
+	se.openStatus = map[string]string{"order_id": "1-0", "status": "FILLED", "filled_amount": "300", "avg_price": "0.90"}
+	se.mtx.Unlock()
+	waitForSettlement(t, r, "1", func(s *Settlement) bool {
+		return s.Status == SettlementSettled && s.Order.Status == fluentumtypes.OrderFilled
+	})
+	if fills, _ := r.Ledger().Fills("1"); len(fills) != 1 || fills[0].Status != FillFilled || fills[0].Amount != 300*unit {
+		t.Errorf("expected the leg filled, got %+v", fills)
+	}
+}
+
+// waitForSettlement waits for the settlement of an order to satisfy cond.
+func waitForSettlement(t *testing.T, r *Router, orderID string, cond func(*Settlement) bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s, err := r.Ledger().Settlement(orderID)
+		if err == nil && cond(s) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("settlement of order %s didn't reach the expected state, got %+v (%v)", orderID, s, err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRouteOrderRollbackFails(t *testing.T) {
+	se := newStubExchange(t, "fill", "reject", "reject")
+	r := newTestRouter(t, se, failingPool{newTestPool()})
+
+	err := r.RouteOrder(context.Background(), fluentumtypes.Order{ID: "1", Side: "buy", Amount: 300 * unit})
+	if !errors.Is(err, ErrRollbackFailed) {
+		t.Fatalf("expected ErrRollbackFailed, got %v", err)
+	}
+	fills, _ := r.Ledger().Fills("1")
+	if len(fills) != 1 || fills[0].Status != FillFilled {
+		t.Errorf("expected the fill left to reconcile, got %+v", fills)
+	}
+	if s, _ := r.Ledger().Settlement("1"); s.Status != SettlementFailed {
+		t.Errorf("expected order failed, got %+v", s)
+	}
+}
+
+func TestLedgerPersists(t *testing.T) {
+	dir := t.TempDir()
+	db, err := dbm.NewDB("ledger", dbm.GoLevelDBBackend, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := NewLedger(db)
+	if err := l.SetSettlement(Settlement{OrderID: "1", Order: fluentumtypes.Order{ID: "1", Side: "buy", Amount: 10}, Status: SettlementPending}); err != nil {
+		t.Fatal(err)
+	}
+	for seq := 0; seq < 12; seq++ {
+		if err := l.PutFill(Fill{OrderID: "1", Seq: seq, Venue: VenueCEX, Amount: int64(seq + 1), Status: FillFilled}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a fill of another order sharing the prefix
+	if err := l.PutFill(Fill{OrderID: "10", Seq: 0, Venue: VenueDEX, Status: FillFilled}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = dbm.NewDB("ledger", dbm.GoLevelDBBackend, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	l = NewLedger(db)
+
+	s, err := l.Settlement("1")
+	if err != nil || s.Status != SettlementPending {
+		t.Fatalf("expected the pending settlement, got %+v, %v", s, err)
+	}
+	fills, err := l.Fills("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 12 {
+		t.Fatalf("expected 12 fills, got %d", len(fills))
+	}
+	for i, f := range fills {
+		if f.Seq != i {
+			t.Errorf("fill %d out of order: %+v", i, f)
+		}
+	}
+	if _, err := l.Settlement("2"); !errors.Is(err, ErrSettlementNotFound) {
+		t.Errorf("expected ErrSettlementNotFound, got %v", err)
+	}
+}
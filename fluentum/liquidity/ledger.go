@@ -0,0 +1,175 @@
+package liquidity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	fluentumtypes "github.com/fluentum-chain/fluentum/fluentum/types"
+	tmsync "github.com/fluentum-chain/fluentum/libs/sync"
+)
+
+// SettlementStatus is the state of a routed order in the settlement ledger.
+type SettlementStatus string
+
+const (
+	// SettlementPending is set before the first leg of an order is executed.
+	// An order left pending was interrupted and must be reconciled.
+	SettlementPending SettlementStatus = "pending"
+	// SettlementOpen is set while a leg of the order rests on a venue, e.g. a
+	// good till cancel stop order on the CEX book. The router tracks the leg
+	// and records its fills as they happen.
+	SettlementOpen SettlementStatus = "open"
+	// SettlementSettled is set once the order is completely filled.
+	SettlementSettled SettlementStatus = "settled"
+	// SettlementPartial is set once an immediate or cancel order that
+	// couldn't be completely filled keeps its fills and cancels the rest.
+	SettlementPartial SettlementStatus = "partial"
+	// SettlementRolledBack is set once the fills of an order that couldn't be
+	// completed have been reversed.
+	SettlementRolledBack SettlementStatus = "rolled_back"
+	// SettlementFailed is set when the fills of an order that couldn't be
+	// completed couldn't all be reversed either.
+	SettlementFailed SettlementStatus = "failed"
+)
+
+// FillStatus is the state of a single fill in the settlement ledger.
+type FillStatus string
+
+const (
+	// FillFilled is a leg completely filled.
+	FillFilled FillStatus = "filled"
+	// FillPartial is a leg that ended partially filled. Its remainder is
+	// routed to the other venue.
+	FillPartial FillStatus = "partial"
+	// FillOpen is a leg resting on its venue. Amount is what it filled so
+	// far.
+	FillOpen FillStatus = "open"
+	// FillRolledBack is a fill reversed by the fill referenced by ReversedBy.
+	FillRolledBack FillStatus = "rolled_back"
+	// FillReversal is the opposite trade placed to roll back another fill.
+	FillReversal FillStatus = "reversal"
+)
+
+// ErrSettlementNotFound is returned for orders missing from the ledger.
+var ErrSettlementNotFound = errors.New("settlement not found")
+
+// Fill is a trade executed on one venue for a routed order. Amounts are in
+// base units of the base token and prices in quote base units per whole base
+// token.
+type Fill struct {
+	OrderID string `json:"order_id"`
+	// Seq orders the fills of an order.
+	Seq          int                `json:"seq"`
+	Venue        string             `json:"venue"`
+	VenueOrderID string             `json:"venue_order_id,omitempty"`
+	Side         fluentumtypes.Side `json:"side"`
+	Requested    int64              `json:"requested"`
+	Amount       int64              `json:"amount"`
+	AvgPrice     int64              `json:"avg_price"`
+	Status       FillStatus         `json:"status"`
+	// ReversedBy is the Seq of the reversal of a rolled back fill.
+	ReversedBy int       `json:"reversed_by,omitempty"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// Settlement is the record of a routed order. Order tracks the lifecycle of
+// the order across its fills.
+type Settlement struct {
+	OrderID   string              `json:"order_id"`
+	Order     fluentumtypes.Order `json:"order"`
+	Status    SettlementStatus    `json:"status"`
+	Error     string              `json:"error,omitempty"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// Ledger is the settlement ledger of the router, persisted in a database.
+// Every write is synced to disk before it returns. It is safe for concurrent
+// use.
+type Ledger struct {
+	mtx tmsync.Mutex
+	db  dbm.DB
+}
+
+// NewLedger returns a ledger stored in db.
+func NewLedger(db dbm.DB) *Ledger {
+	return &Ledger{db: db}
+}
+
+func settlementKey(orderID string) []byte {
+	return []byte(fmt.Sprintf("settlement/%s", orderID))
+}
+
+func fillPrefix(orderID string) []byte {
+	return []byte(fmt.Sprintf("fill/%s/", orderID))
+}
+
+func fillKey(orderID string, seq int) []byte {
+	return []byte(fmt.Sprintf("fill/%s/%08d", orderID, seq))
+}
+
+// SetSettlement creates or updates the record of an order.
+func (l *Ledger) SetSettlement(s Settlement) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.put(settlementKey(s.OrderID), s)
+}
+
+// Settlement returns the record of an order.
+func (l *Ledger) Settlement(orderID string) (*Settlement, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	bz, err := l.db.Get(settlementKey(orderID))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("%w: order %s", ErrSettlementNotFound, orderID)
+	}
+	var s Settlement
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return nil, fmt.Errorf("invalid settlement of order %s: %w", orderID, err)
+	}
+	return &s, nil
+}
+
+// PutFill creates or updates a fill.
+func (l *Ledger) PutFill(f Fill) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.put(fillKey(f.OrderID, f.Seq), f)
+}
+
+// Fills returns the fills of an order by Seq.
+func (l *Ledger) Fills(orderID string) ([]Fill, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	it, err := dbm.IteratePrefix(l.db, fillPrefix(orderID))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var fills []Fill
+	for ; it.Valid(); it.Next() {
+		var f Fill
+		if err := json.Unmarshal(it.Value(), &f); err != nil {
+			return nil, fmt.Errorf("invalid fill %s: %w", it.Key(), err)
+		}
+		fills = append(fills, f)
+	}
+	return fills, it.Error()
+}
+
+func (l *Ledger) put(key []byte, v interface{}) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return l.db.SetSync(key, bz)
+}
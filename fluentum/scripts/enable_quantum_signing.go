@@ -26,11 +26,14 @@ func main() {
 	flag.Parse()
 
 	// Create feature manager
-	fm := core.NewFeatureManager(*nodeVersion)
+	fm, err := core.NewFeatureManager(*nodeVersion, filepath.Dir(filepath.Dir(*configPath)))
+	if err != nil {
+		log.Fatalf("Failed to create feature manager: %v", err)
+	}
 
 	// Register quantum signing feature
 	quantumFeature := quantum.NewQuantumSigningFeature()
-	err := fm.RegisterFeature(quantumFeature)
+	err = fm.RegisterFeature(quantumFeature)
 	if err != nil {
 		log.Fatalf("Failed to register quantum signing feature: %v", err)
 	}
@@ -48,7 +51,7 @@ func main() {
 	fm.SetFeatureConfig("quantum_signing", config)
 
 	// Initialize the feature
-	err = quantumFeature.Init(config)
+	err = quantumFeature.Initialize(config)
 	if err != nil {
 		log.Fatalf("Failed to initialize quantum signing feature: %v", err)
 	}
@@ -1,8 +1,21 @@
 package types
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+var (
+	// ErrInvalidOrder is returned by Order.Validate for malformed orders.
+	ErrInvalidOrder = errors.New("invalid order")
+	// ErrInvalidTransition is returned when an order can't move to a status
+	// from its current one.
+	ErrInvalidTransition = errors.New("invalid order status transition")
+)
 
-// OrderType represents the type of order (market or limit)
+// OrderType represents the type of order
 type OrderType int
 
 const (
@@ -10,17 +23,277 @@ const (
 	MarketOrder OrderType = iota
 	// LimitOrder is an order that executes at a specified price or better
 	LimitOrder
+	// StopOrder is a market order placed once the market reaches its stop
+	// price
+	StopOrder
+	// StopLimitOrder is a limit order placed once the market reaches its stop
+	// price
+	StopLimitOrder
+)
+
+func (t OrderType) String() string {
+	switch t {
+	case MarketOrder:
+		return "market"
+	case LimitOrder:
+		return "limit"
+	case StopOrder:
+		return "stop"
+	case StopLimitOrder:
+		return "stop_limit"
+	default:
+		return fmt.Sprintf("OrderType(%d)", int(t))
+	}
+}
+
+// IsValid reports whether t is a known order type.
+func (t OrderType) IsValid() bool {
+	return t >= MarketOrder && t <= StopLimitOrder
+}
+
+// HasLimitPrice reports whether orders of type t have a limit price.
+func (t OrderType) HasLimitPrice() bool {
+	return t == LimitOrder || t == StopLimitOrder
+}
+
+// IsStop reports whether orders of type t are triggered by a stop price.
+func (t OrderType) IsStop() bool {
+	return t == StopOrder || t == StopLimitOrder
+}
+
+// Side is the side of an order
+type Side string
+
+const (
+	// Buy orders buy the base token with the quote token
+	Buy Side = "buy"
+	// Sell orders sell the base token for the quote token
+	Sell Side = "sell"
+)
+
+// IsValid reports whether s is Buy or Sell.
+func (s Side) IsValid() bool {
+	return s == Buy || s == Sell
+}
+
+// Opposite returns the other side.
+func (s Side) Opposite() Side {
+	if s == Buy {
+		return Sell
+	}
+	return Buy
+}
+
+// TimeInForce is how long an order remains active before it is executed or
+// expires
+type TimeInForce int
+
+const (
+	// GoodTillCancel orders remain active until filled or canceled
+	GoodTillCancel TimeInForce = iota
+	// ImmediateOrCancel orders fill what they can immediately and cancel the
+	// rest
+	ImmediateOrCancel
+	// FillOrKill orders are filled completely and immediately, or not at all
+	FillOrKill
 )
 
+func (tif TimeInForce) String() string {
+	switch tif {
+	case GoodTillCancel:
+		return "GTC"
+	case ImmediateOrCancel:
+		return "IOC"
+	case FillOrKill:
+		return "FOK"
+	default:
+		return fmt.Sprintf("TimeInForce(%d)", int(tif))
+	}
+}
+
+// IsValid reports whether tif is a known time in force.
+func (tif TimeInForce) IsValid() bool {
+	return tif >= GoodTillCancel && tif <= FillOrKill
+}
+
+// OrderStatus is a state of the order lifecycle:
+//
+//	New -> PartiallyFilled -> Filled
+//	  |          |
+//	  |          +-> Canceled
+//	  +-> Filled, Canceled or Rejected
+type OrderStatus int
+
+const (
+	// OrderNew is an order accepted but not filled yet
+	OrderNew OrderStatus = iota
+	// OrderPartiallyFilled is an active order filled in part
+	OrderPartiallyFilled
+	// OrderFilled is an order filled completely
+	OrderFilled
+	// OrderCanceled is an order canceled before it was filled completely,
+	// including expired orders
+	OrderCanceled
+	// OrderRejected is an order refused by the venue
+	OrderRejected
+)
+
+func (s OrderStatus) String() string {
+	switch s {
+	case OrderNew:
+		return "new"
+	case OrderPartiallyFilled:
+		return "partially_filled"
+	case OrderFilled:
+		return "filled"
+	case OrderCanceled:
+		return "canceled"
+	case OrderRejected:
+		return "rejected"
+	default:
+		return fmt.Sprintf("OrderStatus(%d)", int(s))
+	}
+}
+
+// IsTerminal reports whether an order in status s can't change anymore.
+func (s OrderStatus) IsTerminal() bool {
+	return s == OrderFilled || s == OrderCanceled || s == OrderRejected
+}
+
+// CanTransition reports whether an order may move from status s to next.
+// A partially filled order stays partially filled as it gets more fills.
+func (s OrderStatus) CanTransition(next OrderStatus) bool {
+	switch s {
+	case OrderNew:
+		return next != OrderNew
+	case OrderPartiallyFilled:
+		return next == OrderPartiallyFilled || next == OrderFilled || next == OrderCanceled
+	default:
+		return false
+	}
+}
+
 // Order represents a trading order
 type Order struct {
-	ID        string    `json:"id"`
-	Type      OrderType `json:"type"`
-	Amount    int64     `json:"amount"`     // Amount in base units (e.g., satoshis)
-	Price     int64     `json:"price"`      // Price in base units
-	Side      string    `json:"side"`       // "buy" or "sell"
-	Timestamp time.Time `json:"timestamp"`
+	ID string `json:"id"`
+	// ClientOrderID is the ID the order is placed under on venues that
+	// accept one. Defaults to ID.
+	ClientOrderID string      `json:"client_order_id,omitempty"`
+	Type          OrderType   `json:"type"`
+	Side          Side        `json:"side"`
+	Amount        int64       `json:"amount"`     // Amount in base units (e.g., satoshis)
+	Price         int64       `json:"price"`      // Limit price in base units
+	StopPrice     int64       `json:"stop_price"` // Trigger price of stop orders
+	TimeInForce   TimeInForce `json:"time_in_force"`
+	Timestamp     time.Time   `json:"timestamp"`
 	// Additional fields for order routing
 	MaxSlippage int64  `json:"max_slippage"` // Maximum allowed slippage in basis points
 	RouteHint   string `json:"route_hint"`   // Optional hint for preferred route
-} 
+	// Lifecycle of the order
+	Status       OrderStatus `json:"status"`
+	FilledAmount int64       `json:"filled_amount"`
+	AvgPrice     int64       `json:"avg_price"` // Average fill price in base units
+}
+
+// Validate checks that the order is well formed. Limit and stop limit orders
+// need a limit price, stop orders a stop price, and market orders can't be
+// fill or kill.
+func (o Order) Validate() error {
+	if o.ID == "" && o.ClientOrderID == "" {
+		return fmt.Errorf("%w: no ID", ErrInvalidOrder)
+	}
+	if o.Amount <= 0 {
+		return fmt.Errorf("%w: amount %d", ErrInvalidOrder, o.Amount)
+	}
+	if !o.Side.IsValid() {
+		return fmt.Errorf("%w: side %q", ErrInvalidOrder, o.Side)
+	}
+	if !o.Type.IsValid() {
+		return fmt.Errorf("%w: type %s", ErrInvalidOrder, o.Type)
+	}
+	if !o.TimeInForce.IsValid() {
+		return fmt.Errorf("%w: time in force %s", ErrInvalidOrder, o.TimeInForce)
+	}
+	if o.Type.HasLimitPrice() {
+		if o.Price <= 0 {
+			return fmt.Errorf("%w: %s order with price %d", ErrInvalidOrder, o.Type, o.Price)
+		}
+	} else if o.Price != 0 {
+		return fmt.Errorf("%w: %s order with a limit price", ErrInvalidOrder, o.Type)
+	}
+	if o.Type.IsStop() {
+		if o.StopPrice <= 0 {
+			return fmt.Errorf("%w: %s order with stop price %d", ErrInvalidOrder, o.Type, o.StopPrice)
+		}
+	} else if o.StopPrice != 0 {
+		return fmt.Errorf("%w: %s order with a stop price", ErrInvalidOrder, o.Type)
+	}
+	if o.Type == MarketOrder && o.TimeInForce == FillOrKill {
+		return fmt.Errorf("%w: fill or kill market order", ErrInvalidOrder)
+	}
+	if o.MaxSlippage < 0 || o.MaxSlippage > 10000 {
+		return fmt.Errorf("%w: max slippage %d bps", ErrInvalidOrder, o.MaxSlippage)
+	}
+	if o.FilledAmount < 0 || o.FilledAmount > o.Amount {
+		return fmt.Errorf("%w: filled %d of %d", ErrInvalidOrder, o.FilledAmount, o.Amount)
+	}
+	return nil
+}
+
+// VenueOrderID returns the ID to place the order under: ClientOrderID if set,
+// ID otherwise.
+func (o Order) VenueOrderID() string {
+	if o.ClientOrderID != "" {
+		return o.ClientOrderID
+	}
+	return o.ID
+}
+
+// Remaining returns the amount left to fill.
+func (o Order) Remaining() int64 {
+	return o.Amount - o.FilledAmount
+}
+
+// Triggered reports whether a stop order is triggered at the market price:
+// buy stops trigger at or above their stop price, sell stops at or below.
+// Other orders are always triggered.
+func (o Order) Triggered(price int64) bool {
+	if !o.Type.IsStop() {
+		return true
+	}
+	if o.Side == Buy {
+		return price >= o.StopPrice
+	}
+	return price <= o.StopPrice
+}
+
+// SetStatus moves the order to status next, or returns ErrInvalidTransition.
+func (o *Order) SetStatus(next OrderStatus) error {
+	if !o.Status.CanTransition(next) {
+		return fmt.Errorf("%w: order %s %s to %s", ErrInvalidTransition, o.ID, o.Status, next)
+	}
+	o.Status = next
+	return nil
+}
+
+// Fill records a fill of amount at price, updating the average price and
+// moving the order to partially filled or filled.
+func (o *Order) Fill(amount, price int64) error {
+	if amount <= 0 || amount > o.Remaining() {
+		return fmt.Errorf("%w: fill of %d with %d remaining", ErrInvalidOrder, amount, o.Remaining())
+	}
+	next := OrderPartiallyFilled
+	if amount == o.Remaining() {
+		next = OrderFilled
+	}
+	if err := o.SetStatus(next); err != nil {
+		return err
+	}
+
+	// volume weighted average, in big ints as amount*price overflows
+	total := new(big.Int).Mul(big.NewInt(o.AvgPrice), big.NewInt(o.FilledAmount))
+	total.Add(total, new(big.Int).Mul(big.NewInt(price), big.NewInt(amount)))
+	o.FilledAmount += amount
+	o.AvgPrice = total.Quo(total, big.NewInt(o.FilledAmount)).Int64()
+	return nil
+}
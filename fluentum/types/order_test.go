@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderValidate(t *testing.T) {
+	valid := Order{ID: "1", Type: LimitOrder, Side: Buy, Amount: 100, Price: 10}
+	require.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name   string
+		modify func(*Order)
+	}{
+		{"no ID", func(o *Order) { o.ID = "" }},
+		{"zero amount", func(o *Order) { o.Amount = 0 }},
+		{"invalid side", func(o *Order) { o.Side = "BUY" }},
+		{"invalid type", func(o *Order) { o.Type = 7 }},
+		{"invalid time in force", func(o *Order) { o.TimeInForce = 7 }},
+		{"limit without price", func(o *Order) { o.Price = 0 }},
+		{"market with price", func(o *Order) { o.Type = MarketOrder }},
+		{"stop without stop price", func(o *Order) { o.Type = StopLimitOrder }},
+		{"limit with stop price", func(o *Order) { o.StopPrice = 9 }},
+		{"fill or kill market", func(o *Order) { o.Type, o.Price, o.TimeInForce = MarketOrder, 0, FillOrKill }},
+		{"negative slippage", func(o *Order) { o.MaxSlippage = -1 }},
+		{"overfilled", func(o *Order) { o.FilledAmount = 101 }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := valid
+			tc.modify(&o)
+			assert.ErrorIs(t, o.Validate(), ErrInvalidOrder)
+		})
+	}
+
+	stop := Order{ClientOrderID: "desk-1", Type: StopOrder, Side: Sell, Amount: 100, StopPrice: 9, TimeInForce: ImmediateOrCancel}
+	require.NoError(t, stop.Validate())
+	assert.Equal(t, "desk-1", stop.VenueOrderID())
+}
+
+func TestOrderLifecycle(t *testing.T) {
+	o := Order{ID: "1", Side: Buy, Amount: 100}
+
+	require.NoError(t, o.Fill(40, 10))
+	assert.Equal(t, OrderPartiallyFilled, o.Status)
+	require.NoError(t, o.Fill(20, 13))
+	assert.Equal(t, OrderPartiallyFilled, o.Status)
+	assert.EqualValues(t, 11, o.AvgPrice)
+	assert.EqualValues(t, 40, o.Remaining())
+
+	assert.ErrorIs(t, o.Fill(41, 10), ErrInvalidOrder)
+	require.NoError(t, o.Fill(40, 11))
+	assert.Equal(t, OrderFilled, o.Status)
+	assert.True(t, o.Status.IsTerminal())
+
+	assert.ErrorIs(t, o.SetStatus(OrderCanceled), ErrInvalidTransition)
+
+	rejected := Order{ID: "2", Side: Sell, Amount: 100}
+	require.NoError(t, rejected.SetStatus(OrderRejected))
+	assert.ErrorIs(t, rejected.SetStatus(OrderNew), ErrInvalidTransition)
+
+	assert.False(t, OrderPartiallyFilled.CanTransition(OrderRejected))
+	assert.True(t, OrderPartiallyFilled.CanTransition(OrderCanceled))
+}
+
+func TestStopTrigger(t *testing.T) {
+	buyStop := Order{Type: StopOrder, Side: Buy, StopPrice: 100}
+	assert.False(t, buyStop.Triggered(99))
+	assert.True(t, buyStop.Triggered(100))
+
+	sellStop := Order{Type: StopLimitOrder, Side: Sell, StopPrice: 100}
+	assert.True(t, sellStop.Triggered(99))
+	assert.False(t, sellStop.Triggered(101))
+
+	assert.True(t, Order{Type: LimitOrder, Side: Buy}.Triggered(1))
+}
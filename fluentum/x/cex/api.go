@@ -0,0 +1,145 @@
+package cex
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// REST API of the exchange. Prices and amounts are decimal strings, fees are
+// decimal fractions (0.001 = 0.1%).
+//
+// Private endpoints are authenticated with the X-API-KEY, X-TIMESTAMP and
+// X-SIGNATURE headers, where the signature is the hex encoded
+// HMAC-SHA256(secret, timestamp + method + path + query + body) and the
+// timestamp is in Unix milliseconds.
+const (
+	pathDepth = "/api/v1/depth"
+	pathFees  = "/api/v1/fees"
+	pathOrder = "/api/v1/order"
+
+	headerAPIKey    = "X-API-KEY"
+	headerTimestamp = "X-TIMESTAMP"
+	headerSignature = "X-SIGNATURE"
+)
+
+// Order statuses reported by the exchange.
+const (
+	StatusNew             = "NEW"
+	StatusPartiallyFilled = "PARTIALLY_FILLED"
+	StatusFilled          = "FILLED"
+	StatusCanceled        = "CANCELED"
+	StatusRejected        = "REJECTED"
+	StatusExpired         = "EXPIRED"
+)
+
+type depthResponse struct {
+	Bids [][2]string `json:"bids"`
+	Asks [][2]string `json:"asks"`
+}
+
+type feesResponse struct {
+	Maker string `json:"maker"`
+	Taker string `json:"taker"`
+}
+
+type placeOrderRequest struct {
+	Symbol        string `json:"symbol"`
+	Side          string `json:"side"`
+	Type          string `json:"type"`
+	Quantity      string `json:"quantity"`
+	Price         string `json:"price,omitempty"`
+	StopPrice     string `json:"stop_price,omitempty"`
+	TimeInForce   string `json:"time_in_force"`
+	ClientOrderID string `json:"client_order_id,omitempty"`
+}
+
+type orderResponse struct {
+	OrderID      string `json:"order_id"`
+	Status       string `json:"status"`
+	FilledAmount string `json:"filled_amount"`
+	AvgPrice     string `json:"avg_price"`
+}
+
+// apiError is the error body returned by the exchange.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// do sends a request to the exchange and decodes the JSON response into out.
+// Private requests are signed. Every request waits for the rate limiter.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out interface{}, private bool) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("rate limiter: %w", err)
+	}
+
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		if bodyBytes, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	rawQuery := ""
+	if len(query) > 0 {
+		rawQuery = "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path+rawQuery, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if private {
+		ts := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		req.Header.Set(headerAPIKey, c.apiKey)
+		req.Header.Set(headerTimestamp, ts)
+		req.Header.Set(headerSignature, c.sign(ts, method, path+rawQuery, bodyBytes))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr apiError
+		if json.Unmarshal(respBody, &apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("%s %s: %s (code %d)", method, path, apiErr.Message, apiErr.Code)
+		}
+		return fmt.Errorf("%s %s: %s", method, path, resp.Status)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("%s %s: invalid response: %w", method, path, err)
+	}
+	return nil
+}
+
+// sign returns the signature of a private request.
+func (c *Client) sign(timestamp, method, pathAndQuery string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(c.apiSecret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte(method))
+	mac.Write([]byte(pathAndQuery))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
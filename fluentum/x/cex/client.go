@@ -2,59 +2,605 @@ package cex
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
-	"github.com/fluentum-chain/fluentum/types"
+	"golang.org/x/time/rate"
+
+	"github.com/fluentum-chain/fluentum/fluentum/types"
+	tmsync "github.com/fluentum-chain/fluentum/libs/sync"
 )
 
+const (
+	defaultSymbol         = "FLUMX-USDT"
+	defaultDecimals       = 8
+	defaultDepthLimit     = 100
+	defaultPollInterval   = 500 * time.Millisecond
+	defaultRequestsPerSec = 10
+	feeScheduleTTL        = 5 * time.Minute
+	maxResponseSize       = 4 << 20
+
+	// FeeDenominator is the denominator of fee rates: fees are expressed in
+	// parts per million, so 1000 is 0.1%.
+	FeeDenominator = 1_000_000
+)
+
+var (
+	// ErrInsufficientLiquidity is returned when the order book is too thin to
+	// fill the order.
+	ErrInsufficientLiquidity = errors.New("insufficient order book liquidity")
+	// ErrOrderNotFilled is returned when an order ended without being
+	// completely filled.
+	ErrOrderNotFilled = errors.New("order not filled")
+)
+
+// PriceLevel is an aggregated order book level. Price is in quote base
+// units per whole base token, Amount in base units.
+type PriceLevel struct {
+	Price  int64
+	Amount int64
+}
+
+// OrderBook is a snapshot of the order book: bids from the highest price
+// down, asks from the lowest price up.
+type OrderBook struct {
+	Bids []PriceLevel
+	Asks []PriceLevel
+}
+
+// FeeSchedule holds the exchange's trading fees in parts per million.
+type FeeSchedule struct {
+	MakerFee int64
+	TakerFee int64
+}
+
+// Execution is the state of an order placed on the exchange.
+type Execution struct {
+	OrderID      string
+	Status       types.OrderStatus
+	FilledAmount int64
+	AvgPrice     int64
+}
+
 // Client handles interactions with centralized exchanges
 type Client struct {
 	apiKey    string
 	apiSecret string
 	baseURL   string
+
+	symbol         string
+	amountDecimals int
+	priceDecimals  int
+	depthLimit     int
+	pollInterval   time.Duration
+	httpClient     *http.Client
+	limiter        *rate.Limiter
+
+	mtx         tmsync.Mutex
+	fees        *FeeSchedule
+	feesFetched time.Time
+}
+
+// ClientOption sets an optional parameter on the Client.
+type ClientOption func(*Client)
+
+// WithSymbol sets the market the client trades on. Default: FLUMX-USDT.
+func WithSymbol(symbol string) ClientOption {
+	return func(c *Client) { c.symbol = symbol }
+}
+
+// WithDecimals sets the number of decimals of base units of the traded
+// amount and of the price. Default: 8 for both.
+func WithDecimals(amountDecimals, priceDecimals int) ClientOption {
+	return func(c *Client) {
+		c.amountDecimals = amountDecimals
+		c.priceDecimals = priceDecimals
+	}
+}
+
+// WithDepthLimit sets the number of order book levels fetched per side.
+func WithDepthLimit(limit int) ClientOption {
+	return func(c *Client) { c.depthLimit = limit }
+}
+
+// WithPollInterval sets how often the status of a placed order is polled.
+func WithPollInterval(d time.Duration) ClientOption {
+	return func(c *Client) { c.pollInterval = d }
+}
+
+// WithHTTPClient sets the HTTP client used to reach the exchange.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithRateLimit limits the requests sent to the exchange to requestsPerSec,
+// with bursts of up to burst requests. Default: 10 per second.
+func WithRateLimit(requestsPerSec float64, burst int) ClientOption {
+	return func(c *Client) { c.limiter = rate.NewLimiter(rate.Limit(requestsPerSec), burst) }
 }
 
 // NewClient creates a new CEX client
-func NewClient(apiKey, apiSecret, baseURL string) *Client {
-	return &Client{
-		apiKey:    apiKey,
-		apiSecret: apiSecret,
-		baseURL:   baseURL,
+func NewClient(apiKey, apiSecret, baseURL string, options ...ClientOption) *Client {
+	c := &Client{
+		apiKey:         apiKey,
+		apiSecret:      apiSecret,
+		baseURL:        strings.TrimSuffix(baseURL, "/"),
+		symbol:         defaultSymbol,
+		amountDecimals: defaultDecimals,
+		priceDecimals:  defaultDecimals,
+		depthLimit:     defaultDepthLimit,
+		pollInterval:   defaultPollInterval,
+		httpClient:     &http.Client{Timeout: 10 * time.Second},
+		limiter:        rate.NewLimiter(defaultRequestsPerSec, defaultRequestsPerSec),
 	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
 }
 
-// ExecuteOrder executes an order on the CEX
-func (c *Client) ExecuteOrder(ctx context.Context, order types.Order) error {
-	// TODO: Implement actual CEX order execution
-	// This would typically involve:
-	// 1. Order validation
-	// 2. Rate limiting
-	// 3. API authentication
-	// 4. Order placement
-	// 5. Order status monitoring
-	return nil
+// ExecuteOrder places the order on the CEX and polls its status until it is
+// filled or ends otherwise. If ctx is done first, or its status can't be
+// fetched, the order is canceled. The returned Execution reflects the last
+// known state of the order, including partial fills, and is non-nil whenever
+// the order was placed.
+//
+// Good till cancel limit and stop orders may rest on the book for a long
+// time, so they aren't polled: if they don't end as soon as they are placed,
+// ExecuteOrder returns their open Execution, to be tracked with WaitOrder.
+func (c *Client) ExecuteOrder(ctx context.Context, order types.Order) (*Execution, error) {
+	req, err := c.newPlaceOrderRequest(order)
+	if err != nil {
+		return nil, err
+	}
+
+	var placed orderResponse
+	if err := c.do(ctx, http.MethodPost, pathOrder, nil, req, &placed, true); err != nil {
+		return nil, fmt.Errorf("failed to place order %s: %w", order.ID, err)
+	}
+	exec, err := c.parseExecution(placed)
+	if err != nil {
+		return nil, err
+	}
+	if rests(order) && !exec.Status.IsTerminal() {
+		return exec, nil
+	}
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+	for {
+		switch exec.Status {
+		case types.OrderFilled:
+			return exec, nil
+		case types.OrderRejected:
+			return exec, fmt.Errorf("order %s rejected by the exchange", order.ID)
+		case types.OrderCanceled:
+			return exec, fmt.Errorf("%w: order %s %s after filling %d of %d",
+				ErrOrderNotFilled, order.ID, exec.Status, exec.FilledAmount, order.Amount)
+		}
+
+		select {
+		case <-ctx.Done():
+			return c.cancelOrder(exec, ctx.Err())
+		case <-ticker.C:
+		}
+
+		status, err := c.GetOrderStatus(ctx, exec.OrderID)
+		if err != nil {
+			// don't leave an order we can't track open on the book
+			return c.cancelOrder(exec, err)
+		}
+		exec = status
+	}
 }
 
-// GetTotalLiquidity returns the total available liquidity on the CEX
-func (c *Client) GetTotalLiquidity() int64 {
-	// TODO: Implement actual liquidity calculation
-	// This would typically involve:
-	// 1. Fetching order book depth
-	// 2. Calculating available liquidity at different price levels
-	return 100000000000 // Placeholder: 1000 FLUX
+// WaitOrder polls the status of a placed order until it ends, and returns its
+// final state. onUpdate, if not nil, is called with every new state of the
+// order. Unlike ExecuteOrder, WaitOrder keeps polling when a status can't be
+// fetched, and leaves the order on the book if ctx is done: it then returns
+// the last known state of the order, nil if there is none, and the error of
+// ctx.
+func (c *Client) WaitOrder(ctx context.Context, orderID string, onUpdate func(*Execution)) (*Execution, error) {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	var exec *Execution
+	for {
+		select {
+		case <-ctx.Done():
+			return exec, ctx.Err()
+		case <-ticker.C:
+		}
+
+		status, err := c.GetOrderStatus(ctx, orderID)
+		if err != nil {
+			continue
+		}
+		if exec == nil || *status != *exec {
+			exec = status
+			if onUpdate != nil {
+				onUpdate(exec)
+			}
+		}
+
+		switch exec.Status {
+		case types.OrderFilled:
+			return exec, nil
+		case types.OrderRejected:
+			return exec, fmt.Errorf("order %s rejected by the exchange", orderID)
+		case types.OrderCanceled:
+			return exec, fmt.Errorf("%w: order %s %s after filling %d",
+				ErrOrderNotFilled, orderID, exec.Status, exec.FilledAmount)
+		}
+	}
+}
+
+// rests reports whether the order may rest on the book until it is filled or
+// canceled.
+func rests(order types.Order) bool {
+	return order.TimeInForce == types.GoodTillCancel && order.Type != types.MarketOrder
 }
 
-// GetAverageFees returns the average trading fees on the CEX
-func (c *Client) GetAverageFees() int64 {
-	// TODO: Implement actual fee calculation
-	// This would typically involve:
-	// 1. Fetching current fee schedule
-	// 2. Calculating weighted average based on recent trades
-	return 1000 // Placeholder: 0.001%
+// cancelOrder cancels an order whose execution was interrupted by cause, and
+// returns its final state. The state is fetched again after the cancel, as
+// the order may have filled further, or completely, before it was canceled.
+func (c *Client) cancelOrder(exec *Execution, cause error) (*Execution, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	query := url.Values{"symbol": {c.symbol}, "order_id": {exec.OrderID}}
+	var resp orderResponse
+	cancelErr := c.do(ctx, http.MethodDelete, pathOrder, query, nil, &resp, true)
+	if cancelErr == nil {
+		if canceled, err := c.parseExecution(resp); err == nil {
+			exec = canceled
+		}
+	}
+	if final, err := c.GetOrderStatus(ctx, exec.OrderID); err == nil {
+		exec = final
+	}
+
+	switch {
+	case exec.Status == types.OrderFilled:
+		return exec, nil
+	case cancelErr != nil && !exec.Status.IsTerminal():
+		return exec, fmt.Errorf("%w; failed to cancel order %s: %v", cause, exec.OrderID, cancelErr)
+	}
+	return exec, fmt.Errorf("%w: order %s canceled after filling %d: %v",
+		ErrOrderNotFilled, exec.OrderID, exec.FilledAmount, cause)
 }
 
-// QuoteBestPrice returns the best price for the given order on the CEX
+// GetOrderStatus returns the state of a placed order.
+func (c *Client) GetOrderStatus(ctx context.Context, orderID string) (*Execution, error) {
+	query := url.Values{"symbol": {c.symbol}, "order_id": {orderID}}
+	var resp orderResponse
+	if err := c.do(ctx, http.MethodGet, pathOrder, query, nil, &resp, true); err != nil {
+		return nil, fmt.Errorf("failed to get status of order %s: %w", orderID, err)
+	}
+	return c.parseExecution(resp)
+}
+
+func (c *Client) newPlaceOrderRequest(order types.Order) (*placeOrderRequest, error) {
+	if err := order.Validate(); err != nil {
+		return nil, err
+	}
+	side, err := exchangeSide(order.Side)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &placeOrderRequest{
+		Symbol:        c.symbol,
+		Side:          side,
+		Quantity:      formatDecimal(order.Amount, c.amountDecimals),
+		ClientOrderID: order.VenueOrderID(),
+		TimeInForce:   order.TimeInForce.String(),
+	}
+	switch order.Type {
+	case types.MarketOrder:
+		req.Type = "MARKET"
+	case types.LimitOrder:
+		req.Type = "LIMIT"
+	case types.StopOrder:
+		req.Type = "STOP_MARKET"
+	case types.StopLimitOrder:
+		req.Type = "STOP_LIMIT"
+	}
+	if order.Type.HasLimitPrice() {
+		req.Price = formatDecimal(order.Price, c.priceDecimals)
+	}
+	if order.Type.IsStop() {
+		req.StopPrice = formatDecimal(order.StopPrice, c.priceDecimals)
+	}
+	return req, nil
+}
+
+func (c *Client) parseExecution(resp orderResponse) (*Execution, error) {
+	if resp.OrderID == "" {
+		return nil, errors.New("exchange returned no order id")
+	}
+	status, err := parseStatus(resp.Status)
+	if err != nil {
+		return nil, err
+	}
+	exec := &Execution{OrderID: resp.OrderID, Status: status}
+	if resp.FilledAmount != "" {
+		if exec.FilledAmount, err = parseDecimal(resp.FilledAmount, c.amountDecimals); err != nil {
+			return nil, fmt.Errorf("invalid filled amount: %w", err)
+		}
+	}
+	if resp.AvgPrice != "" {
+		if exec.AvgPrice, err = parseDecimal(resp.AvgPrice, c.priceDecimals); err != nil {
+			return nil, fmt.Errorf("invalid average price: %w", err)
+		}
+	}
+	return exec, nil
+}
+
+// GetOrderBook fetches the current order book of the client's market.
+func (c *Client) GetOrderBook(ctx context.Context) (*OrderBook, error) {
+	query := url.Values{"symbol": {c.symbol}, "limit": {fmt.Sprint(c.depthLimit)}}
+	var resp depthResponse
+	if err := c.do(ctx, http.MethodGet, pathDepth, query, nil, &resp, false); err != nil {
+		return nil, fmt.Errorf("failed to fetch order book: %w", err)
+	}
+
+	book := &OrderBook{}
+	var err error
+	if book.Bids, err = c.parseLevels(resp.Bids); err != nil {
+		return nil, fmt.Errorf("invalid bids: %w", err)
+	}
+	if book.Asks, err = c.parseLevels(resp.Asks); err != nil {
+		return nil, fmt.Errorf("invalid asks: %w", err)
+	}
+	return book, nil
+}
+
+func (c *Client) parseLevels(raw [][2]string) ([]PriceLevel, error) {
+	levels := make([]PriceLevel, 0, len(raw))
+	for _, l := range raw {
+		price, err := parseDecimal(l[0], c.priceDecimals)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := parseDecimal(l[1], c.amountDecimals)
+		if err != nil {
+			return nil, err
+		}
+		if price <= 0 || amount <= 0 {
+			continue
+		}
+		levels = append(levels, PriceLevel{Price: price, Amount: amount})
+	}
+	return levels, nil
+}
+
+// GetFeeSchedule returns the exchange's trading fees. The schedule is cached
+// for 5 minutes.
+func (c *Client) GetFeeSchedule(ctx context.Context) (FeeSchedule, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.fees != nil && time.Since(c.feesFetched) < feeScheduleTTL {
+		return *c.fees, nil
+	}
+
+	var resp feesResponse
+	query := url.Values{"symbol": {c.symbol}}
+	if err := c.do(ctx, http.MethodGet, pathFees, query, nil, &resp, true); err != nil {
+		return FeeSchedule{}, fmt.Errorf("failed to fetch fee schedule: %w", err)
+	}
+	maker, err := parseDecimal(resp.Maker, 6)
+	if err != nil {
+		return FeeSchedule{}, fmt.Errorf("invalid maker fee: %w", err)
+	}
+	taker, err := parseDecimal(resp.Taker, 6)
+	if err != nil {
+		return FeeSchedule{}, fmt.Errorf("invalid taker fee: %w", err)
+	}
+
+	c.fees = &FeeSchedule{MakerFee: maker, TakerFee: taker}
+	c.feesFetched = time.Now()
+	return *c.fees, nil
+}
+
+// GetTotalLiquidity returns the total available liquidity on the CEX: the
+// amount resting on both sides of the order book, in base units.
+func (c *Client) GetTotalLiquidity(ctx context.Context) (int64, error) {
+	book, err := c.GetOrderBook(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, l := range book.Bids {
+		total += l.Amount
+	}
+	for _, l := range book.Asks {
+		total += l.Amount
+	}
+	return total, nil
+}
+
+// GetAverageFees returns the average of the maker and taker fees on the CEX,
+// in parts per million.
+func (c *Client) GetAverageFees(ctx context.Context) (int64, error) {
+	fees, err := c.GetFeeSchedule(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return (fees.MakerFee + fees.TakerFee) / 2, nil
+}
+
+// QuoteBestPrice returns the average price the order would fill at if it
+// were executed now as a taker: the volume weighted price of the order book
+// levels it would consume, including the taker fee. It returns
+// ErrInsufficientLiquidity if the book can't fill the order.
 func (c *Client) QuoteBestPrice(ctx context.Context, order types.Order) (int64, error) {
-	// TODO: Implement actual price quoting logic
-	// This would typically involve querying the order book or price feed
-	return order.Price, nil // Placeholder: returns requested price
+	if order.Amount <= 0 {
+		return 0, fmt.Errorf("invalid order amount %d", order.Amount)
+	}
+	side, err := exchangeSide(order.Side)
+	if err != nil {
+		return 0, err
+	}
+	book, err := c.GetOrderBook(ctx)
+	if err != nil {
+		return 0, err
+	}
+	fees, err := c.GetFeeSchedule(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	cost, err := takeLevels(book.levels(side), order.Amount)
+	if err != nil {
+		return 0, err
+	}
+
+	// average price, with the taker fee added for buys and deducted for sells
+	num := cost.Mul(cost, big.NewInt(takerFeeFactor(side, fees)))
+	den := new(big.Int).Mul(big.NewInt(order.Amount), big.NewInt(FeeDenominator))
+	price, mod := new(big.Int).QuoRem(num, den, new(big.Int))
+	if side == "BUY" && mod.Sign() > 0 {
+		price.Add(price, big.NewInt(1))
+	}
+	return price.Int64(), nil
+}
+
+// DepthCurve returns the cost of filling orders of any size on the given side
+// as a taker, against a single snapshot of the order book: for an amount in
+// base units, the quote base units paid for a buy, rounded up, or received
+// for a sell, rounded down, including the taker fee. The curve returns
+// ErrInsufficientLiquidity for amounts the book can't fill.
+func (c *Client) DepthCurve(ctx context.Context, orderSide types.Side) (func(amount int64) (*big.Int, error), error) {
+	side, err := exchangeSide(orderSide)
+	if err != nil {
+		return nil, err
+	}
+	book, err := c.GetOrderBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := c.GetFeeSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := book.levels(side)
+	den := new(big.Int).Mul(c.scale(), big.NewInt(FeeDenominator))
+	factor := big.NewInt(takerFeeFactor(side, fees))
+	return func(amount int64) (*big.Int, error) {
+		if amount <= 0 {
+			return nil, fmt.Errorf("invalid amount %d", amount)
+		}
+		cost, err := takeLevels(levels, amount)
+		if err != nil {
+			return nil, err
+		}
+		total, mod := new(big.Int).QuoRem(cost.Mul(cost, factor), den, new(big.Int))
+		if side == "BUY" && mod.Sign() > 0 {
+			total.Add(total, big.NewInt(1))
+		}
+		return total, nil
+	}, nil
+}
+
+// levels returns the side of the book a taker order consumes.
+func (b *OrderBook) levels(side string) []PriceLevel {
+	if side == "SELL" {
+		return b.Bids
+	}
+	return b.Asks
+}
+
+// takeLevels returns the sum of price*amount over the levels consumed by a
+// taker order of amount, best price first.
+func takeLevels(levels []PriceLevel, amount int64) (*big.Int, error) {
+	remaining := amount
+	cost := new(big.Int)
+	for _, l := range levels {
+		if remaining == 0 {
+			break
+		}
+		fill := l.Amount
+		if fill > remaining {
+			fill = remaining
+		}
+		cost.Add(cost, new(big.Int).Mul(big.NewInt(l.Price), big.NewInt(fill)))
+		remaining -= fill
+	}
+	if remaining > 0 {
+		return nil, fmt.Errorf("%w: %d of %d unfilled", ErrInsufficientLiquidity, remaining, amount)
+	}
+	return cost, nil
+}
+
+// takerFeeFactor returns the multiplier, over FeeDenominator, applied to the
+// cost of a taker order: the fee is added for buys and deducted for sells.
+func takerFeeFactor(side string, fees FeeSchedule) int64 {
+	if side == "SELL" {
+		return FeeDenominator - fees.TakerFee
+	}
+	return FeeDenominator + fees.TakerFee
+}
+
+func (c *Client) scale() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.amountDecimals)), nil)
+}
+
+func exchangeSide(side types.Side) (string, error) {
+	switch side {
+	case types.Buy:
+		return "BUY", nil
+	case types.Sell:
+		return "SELL", nil
+	default:
+		return "", fmt.Errorf("invalid order side %q", side)
+	}
+}
+
+// parseStatus maps an exchange order status to the order lifecycle. Expired
+// orders are canceled.
+func parseStatus(status string) (types.OrderStatus, error) {
+	switch status {
+	case StatusNew:
+		return types.OrderNew, nil
+	case StatusPartiallyFilled:
+		return types.OrderPartiallyFilled, nil
+	case StatusFilled:
+		return types.OrderFilled, nil
+	case StatusCanceled, StatusExpired:
+		return types.OrderCanceled, nil
+	case StatusRejected:
+		return types.OrderRejected, nil
+	default:
+		return 0, fmt.Errorf("unknown order status %q", status)
+	}
+}
+
+// parseDecimal converts a decimal string to an integer number of base units
+// with the given number of decimals, rounding down.
+func parseDecimal(s string, decimals int) (int64, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, fmt.Errorf("invalid decimal %q", s)
+	}
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	v := new(big.Int).Quo(r.Num(), r.Denom())
+	if !v.IsInt64() || v.Sign() < 0 {
+		return 0, fmt.Errorf("decimal %q out of range", s)
+	}
+	return v.Int64(), nil
+}
+
+// formatDecimal formats an integer number of base units as a decimal string.
+func formatDecimal(v int64, decimals int) string {
+	return new(big.Rat).SetFrac(
+		big.NewInt(v), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil),
+	).FloatString(decimals)
 }
@@ -0,0 +1,373 @@
+package cex
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fluentum-chain/fluentum/fluentum/types"
+)
+
+const (
+	testKey    = "key"
+	testSecret = "secret"
+)
+
+// stubExchange is an httptest stand-in for the exchange REST API, serving the
+// depth order book. Placed orders fill after fillAfter status polls, unless
+// fillAfter is negative.
+// Canceled orders fill some more before the cancel goes through, or fill
+// completely if fillOnCancel is set, failing the cancel.
+type stubExchange struct {
+	*httptest.Server
+	t *testing.T
+
+	mtx          sync.Mutex
+	depth        depthResponse
+	fillAfter    int
+	fillOnCancel bool
+	polls        int
+	placed       []placeOrderRequest
+	canceled     []string
+}
+
+func newStubExchange(t *testing.T) *stubExchange {
+	se := &stubExchange{
+		t: t,
+		depth: depthResponse{
+			Bids: [][2]string{{"0.99", "100"}, {"0.98", "200"}, {"0.95", "500"}},
+			Asks: [][2]string{{"1.01", "100"}, {"1.02", "200"}, {"1.05", "500"}},
+		},
+		fillAfter: 2,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathDepth, func(w http.ResponseWriter, r *http.Request) {
+		se.mtx.Lock()
+		defer se.mtx.Unlock()
+		writeJSON(w, se.depth)
+	})
+	mux.HandleFunc(pathFees, se.authenticated(func(w http.ResponseWriter, r *http.Request, _ []byte) {
+		writeJSON(w, feesResponse{Maker: "0.0008", Taker: "0.001"})
+	}))
+	mux.HandleFunc(pathOrder, se.authenticated(se.handleOrder))
+	se.Server = httptest.NewServer(mux)
+	t.Cleanup(se.Close)
+	return se
+}
+
+func (se *stubExchange) authenticated(h func(http.ResponseWriter, *http.Request, []byte)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mac := hmac.New(sha256.New, []byte(testSecret))
+		mac.Write([]byte(r.Header.Get(headerTimestamp) + r.Method + r.URL.RequestURI()))
+		mac.Write(body)
+		if r.Header.Get(headerAPIKey) != testKey ||
+			!hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(r.Header.Get(headerSignature))) {
+			w.WriteHeader(http.StatusUnauthorized)
+			writeJSON(w, apiError{Code: 401, Message: "invalid signature"})
+			return
+		}
+		h(w, r, body)
+	}
+}
+
+func (se *stubExchange) handleOrder(w http.ResponseWriter, r *http.Request, body []byte) {
+	se.mtx.Lock()
+	defer se.mtx.Unlock()
+
+	switch r.Method {
+	case http.MethodPost:
+		var req placeOrderRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			se.t.Errorf("invalid order request: %v", err)
+		}
+		se.placed = append(se.placed, req)
+		writeJSON(w, orderResponse{OrderID: "ex-1", Status: StatusNew, FilledAmount: "0"})
+	case http.MethodGet:
+		se.polls++
+		if len(se.canceled) > 0 && !se.fillOnCancel {
+			writeJSON(w, orderResponse{OrderID: "ex-1", Status: StatusCanceled, FilledAmount: "80", AvgPrice: "1.01"})
+			return
+		}
+		if (se.fillAfter >= 0 && se.polls >= se.fillAfter) || len(se.canceled) > 0 {
+			writeJSON(w, orderResponse{OrderID: "ex-1", Status: StatusFilled, FilledAmount: "150", AvgPrice: "1.0133"})
+			return
+		}
+		writeJSON(w, orderResponse{OrderID: "ex-1", Status: StatusPartiallyFilled, FilledAmount: "50", AvgPrice: "1.01"})
+	case http.MethodDelete:
+		se.canceled = append(se.canceled, r.URL.Query().Get("order_id"))
+		if se.fillOnCancel {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, apiError{Code: 400, Message: "order already filled"})
+			return
+		}
+		writeJSON(w, orderResponse{OrderID: "ex-1", Status: StatusCanceled, FilledAmount: "50", AvgPrice: "1.01"})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestClient(se *stubExchange, options ...ClientOption) *Client {
+	options = append([]ClientOption{WithPollInterval(time.Millisecond), WithRateLimit(1000, 100)}, options...)
+	return NewClient(testKey, testSecret, se.URL, options...)
+}
+
+func TestQuoteBestPrice(t *testing.T) {
+	c := newTestClient(newStubExchange(t))
+
+	// 100 @ 1.01 + 50 @ 1.02, plus the 0.1% taker fee
+	price, err := c.QuoteBestPrice(context.Background(), types.Order{Side: "buy", Amount: 150 * 1e8})
+	if err != nil {
+		t.Fatalf("failed to quote: %v", err)
+	}
+	if want := int64(101434667); price != want {
+		t.Errorf("expected buy quote %d, got %d", want, price)
+	}
+
+	// 100 @ 0.99 + 200 @ 0.98, minus the taker fee
+	price, err = c.QuoteBestPrice(context.Background(), types.Order{Side: "sell", Amount: 300 * 1e8})
+	if err != nil {
+		t.Fatalf("failed to quote: %v", err)
+	}
+	if want := int64(98235000); price != want {
+		t.Errorf("expected sell quote %d, got %d", want, price)
+	}
+
+	_, err = c.QuoteBestPrice(context.Background(), types.Order{Side: "buy", Amount: 1000 * 1e8})
+	if !errors.Is(err, ErrInsufficientLiquidity) {
+		t.Errorf("expected ErrInsufficientLiquidity, got %v", err)
+	}
+}
+
+func TestQuoteBestPriceLargeOrder(t *testing.T) {
+	se := newStubExchange(t)
+	se.depth.Asks = [][2]string{{"1.01", "1000000"}}
+	c := newTestClient(se)
+
+	// the amount times the fee denominator doesn't fit in an int64
+	price, err := c.QuoteBestPrice(context.Background(), types.Order{Side: "buy", Amount: 200_000 * 1e8})
+	if err != nil {
+		t.Fatalf("failed to quote: %v", err)
+	}
+	if want := int64(101101000); price != want {
+		t.Errorf("expected buy quote %d, got %d", want, price)
+	}
+}
+
+func TestDepthCurve(t *testing.T) {
+	c := newTestClient(newStubExchange(t))
+
+	buy, err := c.DepthCurve(context.Background(), "buy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// (100 @ 1.01 + 50 @ 1.02) * 1.001
+	cost, err := buy(150 * 1e8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := int64(15215200000); cost.Int64() != want {
+		t.Errorf("expected buy cost %d, got %s", want, cost)
+	}
+
+	sell, err := c.DepthCurve(context.Background(), "sell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// (100 @ 0.99 + 200 @ 0.98) * 0.999
+	proceeds, err := sell(300 * 1e8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := int64(29470500000); proceeds.Int64() != want {
+		t.Errorf("expected sell proceeds %d, got %s", want, proceeds)
+	}
+
+	if _, err := buy(1000 * 1e8); !errors.Is(err, ErrInsufficientLiquidity) {
+		t.Errorf("expected ErrInsufficientLiquidity, got %v", err)
+	}
+}
+
+func TestLiquidityAndFees(t *testing.T) {
+	c := newTestClient(newStubExchange(t))
+
+	liquidity, err := c.GetTotalLiquidity(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get liquidity: %v", err)
+	}
+	if want := int64(1600 * 1e8); liquidity != want {
+		t.Errorf("expected liquidity %d, got %d", want, liquidity)
+	}
+
+	fees, err := c.GetAverageFees(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get fees: %v", err)
+	}
+	if fees != 900 {
+		t.Errorf("expected average fee of 900 ppm, got %d", fees)
+	}
+
+	bad := NewClient(testKey, "wrong", c.baseURL)
+	if _, err := bad.GetAverageFees(context.Background()); err == nil {
+		t.Error("expected request with a bad signature to fail")
+	}
+}
+
+func TestExecuteOrder(t *testing.T) {
+	se := newStubExchange(t)
+	c := newTestClient(se)
+
+	order := types.Order{
+		ID: "o-1", Type: types.LimitOrder, Side: "buy", Amount: 150 * 1e8, Price: 102000000,
+		TimeInForce: types.ImmediateOrCancel,
+	}
+	exec, err := c.ExecuteOrder(context.Background(), order)
+	if err != nil {
+		t.Fatalf("failed to execute order: %v", err)
+	}
+	if exec.Status != types.OrderFilled || exec.FilledAmount != 150*1e8 || exec.AvgPrice != 101330000 {
+		t.Errorf("unexpected execution %+v", exec)
+	}
+
+	se.mtx.Lock()
+	placed := se.placed[0]
+	se.mtx.Unlock()
+	want := placeOrderRequest{
+		Symbol: defaultSymbol, Side: "BUY", Type: "LIMIT",
+		Quantity: "150.00000000", Price: "1.02000000", TimeInForce: "IOC", ClientOrderID: "o-1",
+	}
+	if placed != want {
+		t.Errorf("expected placed order %+v, got %+v", want, placed)
+	}
+
+	if _, err := c.ExecuteOrder(context.Background(), types.Order{ID: "o-3", Side: "hold", Amount: 1}); !errors.Is(err, types.ErrInvalidOrder) {
+		t.Errorf("expected ErrInvalidOrder for invalid side, got %v", err)
+	}
+}
+
+func TestPlaceStopLimitOrder(t *testing.T) {
+	se := newStubExchange(t)
+	c := newTestClient(se)
+
+	order := types.Order{
+		ID: "o-4", ClientOrderID: "desk-4", Type: types.StopLimitOrder, Side: "sell", Amount: 150 * 1e8,
+		Price: 95000000, StopPrice: 96000000, TimeInForce: types.ImmediateOrCancel,
+	}
+	if _, err := c.ExecuteOrder(context.Background(), order); err != nil {
+		t.Fatalf("failed to execute order: %v", err)
+	}
+
+	se.mtx.Lock()
+	placed := se.placed[0]
+	se.mtx.Unlock()
+	want := placeOrderRequest{
+		Symbol: defaultSymbol, Side: "SELL", Type: "STOP_LIMIT", Quantity: "150.00000000",
+		Price: "0.95000000", StopPrice: "0.96000000", TimeInForce: "IOC", ClientOrderID: "desk-4",
+	}
+	if placed != want {
+		t.Errorf("expected placed order %+v, got %+v", want, placed)
+	}
+}
+
+func TestExecuteOrderCanceledOnTimeout(t *testing.T) {
+	se := newStubExchange(t)
+	se.fillAfter = -1
+	c := newTestClient(se)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	exec, err := c.ExecuteOrder(ctx, types.Order{ID: "o-2", Side: "sell", Amount: 150 * 1e8})
+	if !errors.Is(err, ErrOrderNotFilled) {
+		t.Fatalf("expected ErrOrderNotFilled, got %v", err)
+	}
+	// the final state is fetched after the cancel response, which missed
+	// the last fill
+	if exec == nil || exec.Status != types.OrderCanceled || exec.FilledAmount != 80*1e8 {
+		t.Errorf("unexpected execution %+v", exec)
+	}
+
+	se.mtx.Lock()
+	defer se.mtx.Unlock()
+	if len(se.canceled) != 1 || se.canceled[0] != "ex-1" {
+		t.Errorf("expected order ex-1 to be canceled, got %v", se.canceled)
+	}
+}
+
+func TestExecuteOrderFilledBeforeCancel(t *testing.T) {
+	se := newStubExchange(t)
+	se.fillAfter = -1
+	se.fillOnCancel = true
+	c := newTestClient(se)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	exec, err := c.ExecuteOrder(ctx, types.Order{ID: "o-6", Side: "sell", Amount: 150 * 1e8})
+	if err != nil {
+		t.Fatalf("expected the order filled before the cancel, got %v", err)
+	}
+	if exec.Status != types.OrderFilled || exec.FilledAmount != 150*1e8 {
+		t.Errorf("unexpected execution %+v", exec)
+	}
+}
+
+func TestExecuteRestingOrder(t *testing.T) {
+	se := newStubExchange(t)
+	c := newTestClient(se)
+
+	order := types.Order{ID: "o-5", Type: types.LimitOrder, Side: "buy", Amount: 150 * 1e8, Price: 102000000}
+	exec, err := c.ExecuteOrder(context.Background(), order)
+	if err != nil {
+		t.Fatalf("failed to execute order: %v", err)
+	}
+	se.mtx.Lock()
+	polls := se.polls
+	se.mtx.Unlock()
+	if exec.Status != types.OrderNew || polls != 0 {
+		t.Fatalf("expected the open order back without polling, got %+v after %d polls", exec, polls)
+	}
+
+	var updates []types.OrderStatus
+	exec, err = c.WaitOrder(context.Background(), exec.OrderID, func(e *Execution) {
+		updates = append(updates, e.Status)
+	})
+	if err != nil {
+		t.Fatalf("failed to wait for order: %v", err)
+	}
+	if exec.Status != types.OrderFilled || exec.FilledAmount != 150*1e8 {
+		t.Errorf("unexpected execution %+v", exec)
+	}
+	if len(updates) != 2 || updates[0] != types.OrderPartiallyFilled || updates[1] != types.OrderFilled {
+		t.Errorf("unexpected updates %v", updates)
+	}
+
+	// an order still open when ctx is done stays on the book
+	se.mtx.Lock()
+	se.fillAfter, se.polls = -1, 0
+	se.mtx.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	exec, err = c.WaitOrder(ctx, "ex-1", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if exec == nil || exec.Status != types.OrderPartiallyFilled {
+		t.Errorf("expected the last known state of the order, got %+v", exec)
+	}
+	se.mtx.Lock()
+	defer se.mtx.Unlock()
+	if len(se.canceled) != 0 {
+		t.Errorf("expected the order left open, got cancels %v", se.canceled)
+	}
+}
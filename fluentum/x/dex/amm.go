@@ -0,0 +1,132 @@
+package dex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	tmsync "github.com/fluentum-chain/fluentum/libs/sync"
+)
+
+// FeeDenominator is the denominator of fee rates: fees are expressed in parts
+// per million, so 3000 is 0.3%.
+const FeeDenominator = 1_000_000
+
+var (
+	// ErrInsufficientLiquidity is returned when the pool can't fill an order.
+	ErrInsufficientLiquidity = errors.New("insufficient pool liquidity")
+	// ErrSlippage is returned when a swap would return less than the minimum
+	// amount out.
+	ErrSlippage = errors.New("slippage too high")
+)
+
+// Reserves are the balances of a pool, in base units of each token.
+type Reserves struct {
+	Base  *big.Int
+	Quote *big.Int
+}
+
+// Pool is a constant-product pool of a base and a quote token.
+type Pool interface {
+	// Reserves returns the current reserves of the pool.
+	Reserves(ctx context.Context) (Reserves, error)
+	// Fee returns the swap fee in parts per million.
+	Fee() int64
+	// Swap swaps amountIn of the base token for the quote token if sellBase
+	// is set, and of the quote token for the base token otherwise. It fails
+	// with ErrSlippage if less than minAmountOut would be returned, and
+	// returns the amount out.
+	Swap(ctx context.Context, sellBase bool, amountIn, minAmountOut *big.Int) (*big.Int, error)
+}
+
+// GetAmountOut returns the amount of the other token a swap of amountIn
+// returns, given the reserves of the token swapped in and out and the fee:
+//
+//	out = in*(1-fee)*reserveOut / (reserveIn + in*(1-fee))
+//
+// rounded down. With a fee of 3000 it matches FluentumDEX.calculateOutputAmount.
+func GetAmountOut(amountIn, reserveIn, reserveOut *big.Int, fee int64) (*big.Int, error) {
+	if amountIn.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid amount in %s", amountIn)
+	}
+	if reserveIn.Sign() <= 0 || reserveOut.Sign() <= 0 {
+		return nil, ErrInsufficientLiquidity
+	}
+	inWithFee := new(big.Int).Mul(amountIn, big.NewInt(FeeDenominator-fee))
+	num := new(big.Int).Mul(inWithFee, reserveOut)
+	den := new(big.Int).Mul(reserveIn, big.NewInt(FeeDenominator))
+	den.Add(den, inWithFee)
+	return num.Quo(num, den), nil
+}
+
+// GetAmountIn returns the amount of a token that must be swapped in to
+// receive amountOut of the other, rounded up. It returns
+// ErrInsufficientLiquidity if amountOut isn't less than reserveOut.
+func GetAmountIn(amountOut, reserveIn, reserveOut *big.Int, fee int64) (*big.Int, error) {
+	if amountOut.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid amount out %s", amountOut)
+	}
+	if reserveIn.Sign() <= 0 || amountOut.Cmp(reserveOut) >= 0 {
+		return nil, ErrInsufficientLiquidity
+	}
+	num := new(big.Int).Mul(reserveIn, amountOut)
+	num.Mul(num, big.NewInt(FeeDenominator))
+	den := new(big.Int).Sub(reserveOut, amountOut)
+	den.Mul(den, big.NewInt(FeeDenominator-fee))
+	in := num.Quo(num, den)
+	return in.Add(in, big.NewInt(1)), nil
+}
+
+// NativePool is a constant-product pool whose reserves are held in process,
+// e.g. by a Cosmos module keeper. It is safe for concurrent use.
+type NativePool struct {
+	fee int64
+
+	mtx      tmsync.Mutex
+	reserves Reserves
+}
+
+var _ Pool = (*NativePool)(nil)
+
+// NewNativePool creates a pool with the given reserves and fee in parts per
+// million.
+func NewNativePool(base, quote *big.Int, fee int64) *NativePool {
+	return &NativePool{
+		fee:      fee,
+		reserves: Reserves{Base: new(big.Int).Set(base), Quote: new(big.Int).Set(quote)},
+	}
+}
+
+// Reserves implements Pool.
+func (p *NativePool) Reserves(ctx context.Context) (Reserves, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return Reserves{Base: new(big.Int).Set(p.reserves.Base), Quote: new(big.Int).Set(p.reserves.Quote)}, nil
+}
+
+// Fee implements Pool.
+func (p *NativePool) Fee() int64 {
+	return p.fee
+}
+
+// Swap implements Pool.
+func (p *NativePool) Swap(ctx context.Context, sellBase bool, amountIn, minAmountOut *big.Int) (*big.Int, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	reserveIn, reserveOut := p.reserves.Quote, p.reserves.Base
+	if sellBase {
+		reserveIn, reserveOut = p.reserves.Base, p.reserves.Quote
+	}
+	out, err := GetAmountOut(amountIn, reserveIn, reserveOut, p.fee)
+	if err != nil {
+		return nil, err
+	}
+	if out.Cmp(minAmountOut) < 0 {
+		return nil, fmt.Errorf("%w: %s < %s", ErrSlippage, out, minAmountOut)
+	}
+	reserveIn.Add(reserveIn, amountIn)
+	reserveOut.Sub(reserveOut, out)
+	return out, nil
+}
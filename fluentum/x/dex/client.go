@@ -2,57 +2,275 @@ package dex
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/big"
 
-	"github.com/fluentum-chain/fluentum/types"
+	"github.com/fluentum-chain/fluentum/fluentum/types"
 )
 
+const defaultDecimals = 8
+
+var (
+	// ErrLimitPrice is returned when an order can't be filled at its limit
+	// price.
+	ErrLimitPrice = errors.New("limit price not reachable")
+	// ErrStopNotTriggered is returned for stop orders whose stop price the
+	// pool hasn't reached.
+	ErrStopNotTriggered = errors.New("stop price not reached")
+)
+
+// Quote is the result of simulating an order against the pool. Prices are
+// in quote base units per whole base token.
+type Quote struct {
+	// AmountIn is the amount swapped in: quote tokens for a buy, base tokens
+	// for a sell.
+	AmountIn *big.Int
+	// AmountOut is the amount received: base tokens for a buy, quote tokens
+	// for a sell.
+	AmountOut *big.Int
+	// SpotPrice is the marginal price of the pool before the swap.
+	SpotPrice int64
+	// AvgPrice is the average price of the order, including the fee and the
+	// price impact of the order size.
+	AvgPrice int64
+	// Slippage is the deviation of AvgPrice from SpotPrice, in basis points.
+	Slippage int64
+}
+
+// Execution is the result of an order executed against the pool. Swaps are
+// atomic, so executed orders are always filled.
+type Execution struct {
+	Status       types.OrderStatus
+	FilledAmount int64
+	AvgPrice     int64
+}
+
 // Client handles interactions with decentralized exchanges
 type Client struct {
-	contractAddress string
-	chainID         int64
+	pool           Pool
+	amountDecimals int
 }
 
-// NewClient creates a new DEX client
-func NewClient(contractAddress string, chainID int64) *Client {
-	return &Client{
-		contractAddress: contractAddress,
-		chainID:         chainID,
+// ClientOption sets an optional parameter on the Client.
+type ClientOption func(*Client)
+
+// WithAmountDecimals sets the number of decimals of base units of the base
+// token, used to express prices per whole token. Default: 8.
+func WithAmountDecimals(decimals int) ClientOption {
+	return func(c *Client) { c.amountDecimals = decimals }
+}
+
+// NewClient creates a new DEX client trading against pool, which is either a
+// NativePool or a ContractPool of the FluentumDEX contract.
+func NewClient(pool Pool, options ...ClientOption) *Client {
+	c := &Client{
+		pool:           pool,
+		amountDecimals: defaultDecimals,
 	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
 }
 
-// ExecuteOrder executes an order on the DEX
-func (c *Client) ExecuteOrder(ctx context.Context, order types.Order) error {
-	// TODO: Implement actual DEX order execution
-	// This would typically involve:
-	// 1. Order validation
-	// 2. Gas estimation
-	// 3. Transaction signing
-	// 4. Transaction submission
-	// 5. Transaction confirmation
-	return nil
+// Quote simulates the order against the current reserves of the pool.
+// Orders are filled exactly in base tokens: a buy of order.Amount base units
+// quotes the quote tokens to pay, a sell the quote tokens received.
+func (c *Client) Quote(ctx context.Context, order types.Order) (*Quote, error) {
+	if order.Amount <= 0 {
+		return nil, fmt.Errorf("invalid order amount %d", order.Amount)
+	}
+	sellBase, err := isSell(order.Side)
+	if err != nil {
+		return nil, err
+	}
+	reserves, err := c.pool.Reserves(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if reserves.Base.Sign() <= 0 || reserves.Quote.Sign() <= 0 {
+		return nil, ErrInsufficientLiquidity
+	}
+
+	amount := big.NewInt(order.Amount)
+	q := &Quote{}
+	var quoteAmount *big.Int
+	if sellBase {
+		q.AmountIn = amount
+		if q.AmountOut, err = GetAmountOut(amount, reserves.Base, reserves.Quote, c.pool.Fee()); err != nil {
+			return nil, err
+		}
+		if q.AmountOut.Sign() == 0 {
+			return nil, ErrInsufficientLiquidity
+		}
+		quoteAmount = q.AmountOut
+	} else {
+		q.AmountOut = amount
+		if q.AmountIn, err = GetAmountIn(amount, reserves.Quote, reserves.Base, c.pool.Fee()); err != nil {
+			return nil, err
+		}
+		quoteAmount = q.AmountIn
+	}
+
+	scale := c.scale()
+	spot := new(big.Int).Mul(reserves.Quote, scale)
+	spot.Quo(spot, reserves.Base)
+	avg := new(big.Int).Mul(quoteAmount, scale)
+	if sellBase {
+		avg.Quo(avg, amount)
+	} else {
+		avg = ceilDiv(avg, amount)
+	}
+	if !spot.IsInt64() || !avg.IsInt64() || spot.Sign() == 0 {
+		return nil, fmt.Errorf("price out of range for reserves %s/%s", reserves.Base, reserves.Quote)
+	}
+	q.SpotPrice = spot.Int64()
+	q.AvgPrice = avg.Int64()
+
+	diff := new(big.Int).Sub(avg, spot)
+	diff.Abs(diff).Mul(diff, big.NewInt(10000))
+	q.Slippage = diff.Quo(diff, spot).Int64()
+	return q, nil
 }
 
-// GetTotalLiquidity returns the total available liquidity on the DEX
-func (c *Client) GetTotalLiquidity() int64 {
-	// TODO: Implement actual liquidity calculation
-	// This would typically involve:
-	// 1. Querying liquidity pools
-	// 2. Calculating available liquidity across all pools
-	return 50000000000 // Placeholder: 500 FLUX
+// DepthCurve returns the cost of filling orders of any size on the given side
+// against a single snapshot of the pool reserves: for an amount in base units
+// of the base token, the quote tokens swapped in for a buy or received for a
+// sell, including the fee. The curve returns ErrInsufficientLiquidity for
+// amounts the pool can't fill.
+func (c *Client) DepthCurve(ctx context.Context, side types.Side) (func(amount int64) (*big.Int, error), error) {
+	sellBase, err := isSell(side)
+	if err != nil {
+		return nil, err
+	}
+	reserves, err := c.pool.Reserves(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fee := c.pool.Fee()
+	return func(amount int64) (*big.Int, error) {
+		if amount <= 0 {
+			return nil, fmt.Errorf("invalid amount %d", amount)
+		}
+		if !sellBase {
+			return GetAmountIn(big.NewInt(amount), reserves.Quote, reserves.Base, fee)
+		}
+		out, err := GetAmountOut(big.NewInt(amount), reserves.Base, reserves.Quote, fee)
+		if err != nil {
+			return nil, err
+		}
+		if out.Sign() == 0 {
+			return nil, ErrInsufficientLiquidity
+		}
+		return out, nil
+	}, nil
 }
 
-// GetAverageFees returns the average trading fees on the DEX
-func (c *Client) GetAverageFees() int64 {
-	// TODO: Implement actual fee calculation
-	// This would typically involve:
-	// 1. Querying pool fees
-	// 2. Calculating weighted average based on pool sizes
-	return 3000 // Placeholder: 0.003%
+// ExecuteOrder executes an order on the DEX. A limit order is rejected with
+// ErrLimitPrice if its average price would be worse than order.Price, and a
+// market order with a MaxSlippage if the slippage would exceed it. Stop
+// orders are rejected with ErrStopNotTriggered unless the spot price of the
+// pool has reached their stop price. The pool is given a minimum amount out,
+// so the swap fails rather than fill at a worse price if the reserves change
+// meanwhile.
+//
+// Orders can't rest on the pool: swaps fill completely or not at all, so
+// every order executes as fill or kill whatever its time in force.
+func (c *Client) ExecuteOrder(ctx context.Context, order types.Order) (*Execution, error) {
+	if err := order.Validate(); err != nil {
+		return nil, err
+	}
+	q, err := c.Quote(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	sellBase := order.Side == types.Sell
+
+	if !order.Triggered(q.SpotPrice) {
+		return nil, fmt.Errorf("%w: %s stop at %d, pool spot price %d",
+			ErrStopNotTriggered, order.Side, order.StopPrice, q.SpotPrice)
+	}
+	if order.Type.HasLimitPrice() {
+		if (sellBase && q.AvgPrice < order.Price) || (!sellBase && q.AvgPrice > order.Price) {
+			return nil, fmt.Errorf("%w: %s at %d, pool average price %d",
+				ErrLimitPrice, order.Side, order.Price, q.AvgPrice)
+		}
+	} else if order.MaxSlippage > 0 && q.Slippage > order.MaxSlippage {
+		return nil, fmt.Errorf("%w: %d bps > %d bps", ErrSlippage, q.Slippage, order.MaxSlippage)
+	}
+
+	// A buy pays the quoted amount in and must receive the full order
+	// amount, which bounds its average price by the quote. A limit sell must
+	// receive at least its limit price, a market sell the quoted amount.
+	minOut := q.AmountOut
+	if sellBase && order.Type.HasLimitPrice() {
+		minOut = ceilDiv(new(big.Int).Mul(big.NewInt(order.Price), q.AmountIn), c.scale())
+	}
+
+	out, err := c.pool.Swap(ctx, sellBase, q.AmountIn, minOut)
+	if err != nil {
+		return nil, fmt.Errorf("swap failed for order %s: %w", order.ID, err)
+	}
+
+	// a buy may receive slightly more than ordered, as amounts in round up
+	baseAmount, quoteAmount := out, q.AmountIn
+	if sellBase {
+		baseAmount, quoteAmount = q.AmountIn, out
+	}
+	avg := new(big.Int).Mul(quoteAmount, c.scale())
+	avg.Quo(avg, baseAmount)
+	return &Execution{Status: types.OrderFilled, FilledAmount: baseAmount.Int64(), AvgPrice: avg.Int64()}, nil
+}
+
+// GetTotalLiquidity returns the total available liquidity on the DEX: both
+// sides of the pool valued in base units of the base token.
+func (c *Client) GetTotalLiquidity(ctx context.Context) (int64, error) {
+	reserves, err := c.pool.Reserves(ctx)
+	if err != nil {
+		return 0, err
+	}
+	total := new(big.Int).Lsh(reserves.Base, 1)
+	if !total.IsInt64() {
+		return 0, fmt.Errorf("liquidity %s out of range", total)
+	}
+	return total.Int64(), nil
+}
+
+// GetAverageFees returns the trading fee of the pool in parts per million.
+func (c *Client) GetAverageFees(ctx context.Context) (int64, error) {
+	return c.pool.Fee(), nil
 }
 
-// QuoteBestPrice returns the best price for the given order on the DEX
+// QuoteBestPrice returns the average price the order would fill at on the
+// DEX, including the fee and the slippage of the order size.
 func (c *Client) QuoteBestPrice(ctx context.Context, order types.Order) (int64, error) {
-	// TODO: Implement actual price quoting logic
-	// This would typically involve querying the AMM or price oracle
-	return order.Price, nil // Placeholder: returns requested price
+	q, err := c.Quote(ctx, order)
+	if err != nil {
+		return 0, err
+	}
+	return q.AvgPrice, nil
+}
+
+func (c *Client) scale() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.amountDecimals)), nil)
+}
+
+func isSell(side types.Side) (bool, error) {
+	switch side {
+	case types.Buy:
+		return false, nil
+	case types.Sell:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid order side %q", side)
+	}
+}
+
+func ceilDiv(x, y *big.Int) *big.Int {
+	q, m := new(big.Int).QuoRem(x, y, new(big.Int))
+	if m.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
 }
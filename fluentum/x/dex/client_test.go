@@ -0,0 +1,199 @@
+package dex
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/fluentum-chain/fluentum/fluentum/types"
+)
+
+const unit = 1e8
+
+// newTestPool returns a pool of 10,000 base tokens and 10,000 quote tokens,
+// for a spot price of 1.
+func newTestPool() *NativePool {
+	return NewNativePool(big.NewInt(10_000*unit), big.NewInt(10_000*unit), fluentumDEXFee)
+}
+
+func TestGetAmountOutMatchesContract(t *testing.T) {
+	amountIn, reserveIn, reserveOut := big.NewInt(12345), big.NewInt(1_000_000), big.NewInt(2_000_000)
+
+	// FluentumDEX.calculateOutputAmount
+	inWithFee := new(big.Int).Mul(amountIn, big.NewInt(997))
+	want := new(big.Int).Mul(inWithFee, reserveOut)
+	want.Quo(want, new(big.Int).Add(new(big.Int).Mul(reserveIn, big.NewInt(1000)), inWithFee))
+
+	out, err := GetAmountOut(amountIn, reserveIn, reserveOut, fluentumDEXFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Cmp(want) != 0 {
+		t.Errorf("expected %s, got %s", want, out)
+	}
+
+	in, err := GetAmountIn(out, reserveIn, reserveOut, fluentumDEXFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in.Cmp(amountIn) > 0 {
+		t.Errorf("GetAmountIn(%s) = %s exceeds the original amount in %s", out, in, amountIn)
+	}
+	if again, _ := GetAmountOut(in, reserveIn, reserveOut, fluentumDEXFee); again.Cmp(out) < 0 {
+		t.Errorf("swapping GetAmountIn(%s) returns only %s", out, again)
+	}
+
+	if _, err := GetAmountIn(reserveOut, reserveIn, reserveOut, fluentumDEXFee); !errors.Is(err, ErrInsufficientLiquidity) {
+		t.Errorf("expected ErrInsufficientLiquidity, got %v", err)
+	}
+}
+
+func TestQuoteSlippage(t *testing.T) {
+	c := NewClient(newTestPool())
+
+	small, err := c.Quote(context.Background(), types.Order{Side: "buy", Amount: 1 * unit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	large, err := c.Quote(context.Background(), types.Order{Side: "buy", Amount: 1000 * unit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if small.SpotPrice != unit || large.SpotPrice != unit {
+		t.Errorf("expected spot price %d, got %d and %d", int64(unit), small.SpotPrice, large.SpotPrice)
+	}
+	// the fee alone costs 30 bps; buying 10% of the pool costs ~11.4%
+	if small.Slippage < 30 || small.Slippage > 32 {
+		t.Errorf("unexpected slippage %d bps for a small order", small.Slippage)
+	}
+	if large.Slippage < 1140 || large.Slippage > 1150 {
+		t.Errorf("unexpected slippage %d bps for a large order", large.Slippage)
+	}
+	if large.AvgPrice <= small.AvgPrice {
+		t.Errorf("large buy should quote a higher price: %d <= %d", large.AvgPrice, small.AvgPrice)
+	}
+
+	sell, err := c.QuoteBestPrice(context.Background(), types.Order{Side: "sell", Amount: 1000 * unit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sell >= unit {
+		t.Errorf("sell should quote below the spot price, got %d", sell)
+	}
+
+	if _, err := c.Quote(context.Background(), types.Order{Side: "buy", Amount: 10_000 * unit}); !errors.Is(err, ErrInsufficientLiquidity) {
+		t.Errorf("expected ErrInsufficientLiquidity, got %v", err)
+	}
+}
+
+func TestDepthCurveMatchesQuote(t *testing.T) {
+	c := NewClient(newTestPool())
+	ctx := context.Background()
+
+	for _, side := range []types.Side{types.Buy, types.Sell} {
+		curve, err := c.DepthCurve(ctx, side)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, amount := range []int64{100, unit, 1000 * unit} {
+			q, err := c.Quote(ctx, types.Order{Side: side, Amount: amount})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := q.AmountIn
+			if side == "sell" {
+				want = q.AmountOut
+			}
+			if got, err := curve(amount); err != nil || got.Cmp(want) != 0 {
+				t.Errorf("%s %d: expected %s, got %v, %v", side, amount, want, got, err)
+			}
+		}
+	}
+}
+
+func TestExecuteOrder(t *testing.T) {
+	pool := newTestPool()
+	c := NewClient(pool)
+	ctx := context.Background()
+
+	// a buy of 100 tokens averages ~1.0132
+	_, err := c.ExecuteOrder(ctx, types.Order{ID: "1", Type: types.LimitOrder, Side: "buy", Amount: 100 * unit, Price: 1.01 * unit})
+	if !errors.Is(err, ErrLimitPrice) {
+		t.Fatalf("expected ErrLimitPrice, got %v", err)
+	}
+	_, err = c.ExecuteOrder(ctx, types.Order{ID: "2", Type: types.MarketOrder, Side: "buy", Amount: 100 * unit, MaxSlippage: 100})
+	if !errors.Is(err, ErrSlippage) {
+		t.Fatalf("expected ErrSlippage, got %v", err)
+	}
+	if r, _ := pool.Reserves(ctx); r.Base.Cmp(big.NewInt(10_000*unit)) != 0 {
+		t.Fatalf("rejected orders changed the reserves: %v", r)
+	}
+
+	exec, err := c.ExecuteOrder(ctx, types.Order{ID: "3", Type: types.LimitOrder, Side: "buy", Amount: 100 * unit, Price: 1.02 * unit})
+	if err != nil {
+		t.Fatalf("failed to execute buy: %v", err)
+	}
+	if exec.FilledAmount < 100*unit || exec.AvgPrice > 1.02*unit || exec.AvgPrice < unit {
+		t.Errorf("unexpected execution %+v", exec)
+	}
+	r, _ := pool.Reserves(ctx)
+	if want := big.NewInt(9_900 * unit); r.Base.Cmp(want) < 0 || r.Base.Cmp(new(big.Int).Add(want, big.NewInt(1))) > 0 {
+		t.Errorf("expected ~%s base tokens left in the pool, got %s", want, r.Base)
+	}
+
+	exec, err = c.ExecuteOrder(ctx, types.Order{ID: "4", Type: types.LimitOrder, Side: "sell", Amount: 100 * unit, Price: 0.99 * unit})
+	if err != nil {
+		t.Fatalf("failed to execute sell: %v", err)
+	}
+	if exec.AvgPrice < 0.99*unit {
+		t.Errorf("sell filled below its limit: %+v", exec)
+	}
+}
+
+func TestExecuteStopOrder(t *testing.T) {
+	c := NewClient(newTestPool())
+	ctx := context.Background()
+
+	// the spot price is 1: a buy stop above it isn't triggered
+	stop := types.Order{ID: "1", Type: types.StopOrder, Side: "buy", Amount: unit, StopPrice: 1.05 * unit}
+	if _, err := c.ExecuteOrder(ctx, stop); !errors.Is(err, ErrStopNotTriggered) {
+		t.Fatalf("expected ErrStopNotTriggered, got %v", err)
+	}
+
+	stop.StopPrice = 0.95 * unit
+	exec, err := c.ExecuteOrder(ctx, stop)
+	if err != nil {
+		t.Fatalf("failed to execute triggered stop: %v", err)
+	}
+	if exec.Status != types.OrderFilled || exec.FilledAmount < unit {
+		t.Errorf("unexpected execution %+v", exec)
+	}
+
+	stopLimit := types.Order{
+		ID: "2", Type: types.StopLimitOrder, Side: "sell", Amount: unit, StopPrice: 1.05 * unit, Price: 1.01 * unit,
+	}
+	if _, err := c.ExecuteOrder(ctx, stopLimit); !errors.Is(err, ErrLimitPrice) {
+		t.Errorf("expected ErrLimitPrice, got %v", err)
+	}
+
+	if _, err := c.ExecuteOrder(ctx, types.Order{ID: "3", Type: types.StopOrder, Side: "sell", Amount: unit}); !errors.Is(err, types.ErrInvalidOrder) {
+		t.Errorf("expected ErrInvalidOrder for a stop order without stop price, got %v", err)
+	}
+}
+
+func TestPoolEnforcesMinAmountOut(t *testing.T) {
+	pool := newTestPool()
+	out, err := GetAmountOut(big.NewInt(unit), big.NewInt(10_000*unit), big.NewInt(10_000*unit), fluentumDEXFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the reserves move between the quote and the swap
+	if _, err := pool.Swap(context.Background(), true, big.NewInt(500*unit), big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pool.Swap(context.Background(), true, big.NewInt(unit), out); !errors.Is(err, ErrSlippage) {
+		t.Errorf("expected ErrSlippage, got %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+package dex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// fluentumDEXABI is the subset of the FluentumDEX contract ABI used by
+// ContractPool.
+const fluentumDEXABI = `[
+	{"type":"function","name":"getReserves","stateMutability":"view",
+	 "inputs":[{"name":"tokenA","type":"address"},{"name":"tokenB","type":"address"}],
+	 "outputs":[{"name":"reserveA","type":"uint256"},{"name":"reserveB","type":"uint256"}]},
+	{"type":"function","name":"swap","stateMutability":"nonpayable",
+	 "inputs":[{"name":"tokenIn","type":"address"},{"name":"tokenOut","type":"address"},
+	           {"name":"amountIn","type":"uint256"},{"name":"minAmountOut","type":"uint256"}],
+	 "outputs":[]},
+	{"type":"event","name":"SwapExecuted","anonymous":false,
+	 "inputs":[{"name":"user","type":"address","indexed":true},
+	           {"name":"tokenIn","type":"address","indexed":true},
+	           {"name":"tokenOut","type":"address","indexed":true},
+	           {"name":"amountIn","type":"uint256","indexed":false},
+	           {"name":"amountOut","type":"uint256","indexed":false}]}
+]`
+
+// fluentumDEXFee is the fee hardcoded in FluentumDEX.calculateOutputAmount.
+const fluentumDEXFee = 3000
+
+// ContractBackend is the access to the EVM chain needed by ContractPool. It
+// is implemented by *ethclient.Client.
+type ContractBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// ContractPool is a pool of the FluentumDEX contract on an EVM chain. Swaps
+// are sent from the account of the transactor, which must have approved the
+// contract to spend its base and quote tokens.
+type ContractPool struct {
+	contract   *bind.BoundContract
+	backend    ContractBackend
+	abi        abi.ABI
+	address    common.Address
+	baseToken  common.Address
+	quoteToken common.Address
+	transactor *bind.TransactOpts
+}
+
+var _ Pool = (*ContractPool)(nil)
+
+// NewContractPool creates a pool for the baseToken/quoteToken pair of the
+// FluentumDEX contract at address. transactor may be nil for a read-only
+// pool, which can quote but not swap.
+func NewContractPool(
+	backend ContractBackend,
+	address, baseToken, quoteToken common.Address,
+	transactor *bind.TransactOpts,
+) (*ContractPool, error) {
+	parsed, err := abi.JSON(strings.NewReader(fluentumDEXABI))
+	if err != nil {
+		return nil, err
+	}
+	return &ContractPool{
+		contract:   bind.NewBoundContract(address, parsed, backend, backend, backend),
+		backend:    backend,
+		abi:        parsed,
+		address:    address,
+		baseToken:  baseToken,
+		quoteToken: quoteToken,
+		transactor: transactor,
+	}, nil
+}
+
+// Reserves implements Pool.
+func (p *ContractPool) Reserves(ctx context.Context) (Reserves, error) {
+	var out []interface{}
+	err := p.contract.Call(&bind.CallOpts{Context: ctx}, &out, "getReserves", p.baseToken, p.quoteToken)
+	if err != nil {
+		return Reserves{}, fmt.Errorf("failed to get reserves from %s: %w", p.address, err)
+	}
+	if len(out) != 2 {
+		return Reserves{}, fmt.Errorf("unexpected getReserves result %v", out)
+	}
+	base, ok1 := out[0].(*big.Int)
+	quote, ok2 := out[1].(*big.Int)
+	if !ok1 || !ok2 {
+		return Reserves{}, fmt.Errorf("unexpected getReserves result %v", out)
+	}
+	return Reserves{Base: base, Quote: quote}, nil
+}
+
+// Fee implements Pool.
+func (p *ContractPool) Fee() int64 {
+	return fluentumDEXFee
+}
+
+// Swap implements Pool. It sends a swap transaction, waits for it to be
+// mined and returns the amount out reported by the SwapExecuted event. The
+// contract enforces minAmountOut.
+func (p *ContractPool) Swap(ctx context.Context, sellBase bool, amountIn, minAmountOut *big.Int) (*big.Int, error) {
+	if p.transactor == nil {
+		return nil, errors.New("read-only contract pool")
+	}
+	tokenIn, tokenOut := p.quoteToken, p.baseToken
+	if sellBase {
+		tokenIn, tokenOut = p.baseToken, p.quoteToken
+	}
+
+	opts := *p.transactor
+	opts.Context = ctx
+	tx, err := p.contract.Transact(&opts, "swap", tokenIn, tokenOut, amountIn, minAmountOut)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send swap: %w", err)
+	}
+	receipt, err := bind.WaitMined(ctx, p.backend, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed waiting for swap %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("swap %s reverted", tx.Hash())
+	}
+
+	event := p.abi.Events["SwapExecuted"]
+	for _, log := range receipt.Logs {
+		if log.Address != p.address || len(log.Topics) == 0 || log.Topics[0] != event.ID {
+			continue
+		}
+		var swapped struct {
+			AmountIn  *big.Int
+			AmountOut *big.Int
+		}
+		if err := p.contract.UnpackLog(&swapped, "SwapExecuted", *log); err != nil {
+			return nil, fmt.Errorf("invalid SwapExecuted event: %w", err)
+		}
+		return swapped.AmountOut, nil
+	}
+	return nil, fmt.Errorf("swap %s emitted no SwapExecuted event", tx.Hash())
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fluentum-chain/fluentum/x/kyc/types"
+)
+
+// InitGenesis initializes the registry from the genesis state. The root is
+// recomputed from the merchants' commitments rather than imported.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, m := range genState.Merchants {
+		if _, _, err := k.RegisterMerchant(ctx, m.MerchantID, m.Commitment); err != nil {
+			panic(err)
+		}
+	}
+	for _, r := range genState.Revocations {
+		if _, err := k.RevokeMerchant(ctx, r.MerchantID, r.Reason); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the registry as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.Merchants = k.GetAllMerchants(ctx)
+	genesis.Revocations = k.GetAllRevocations(ctx)
+	return genesis
+}
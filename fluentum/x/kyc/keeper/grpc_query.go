@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fluentum-chain/fluentum/x/kyc/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Root implements the Query/Root gRPC method
+func (k Keeper) Root(goCtx context.Context, req *types.QueryRootRequest) (*types.QueryRootResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	root, count := k.GetRoot(ctx)
+	return &types.QueryRootResponse{Root: root, LeafCount: count}, nil
+}
+
+// Merchant implements the Query/Merchant gRPC method
+func (k Keeper) Merchant(goCtx context.Context, req *types.QueryMerchantRequest) (*types.QueryMerchantResponse, error) {
+	if req == nil || req.MerchantID == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	merchant, found := k.GetMerchant(ctx, req.MerchantID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "merchant %s not found", req.MerchantID)
+	}
+	return &types.QueryMerchantResponse{Merchant: merchant}, nil
+}
+
+// Revocations implements the Query/Revocations gRPC method
+func (k Keeper) Revocations(goCtx context.Context, req *types.QueryRevocationsRequest) (*types.QueryRevocationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var revocations []types.Revocation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevocationKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var r types.Revocation
+		if err := k.cdc.Unmarshal(value, &r); err != nil {
+			return err
+		}
+		revocations = append(revocations, r)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRevocationsResponse{Revocations: revocations, Pagination: pageRes}, nil
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fluentum-chain/fluentum/x/kyc/types"
+)
+
+// Keeper maintains the KYC registry: the merchants' commitments, the Merkle
+// root over them and the revocation list.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+}
+
+var _ types.KYCKeeper = Keeper{}
+
+// NewKeeper creates a new kyc Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// GetRoot returns the current root of the registry and its number of leaves.
+func (k Keeper) GetRoot(ctx sdk.Context) (root []byte, leafCount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	root = store.Get(types.RootKey)
+	if root == nil {
+		root = make([]byte, types.CommitmentSize)
+	}
+	return root, k.getLeafCount(ctx)
+}
+
+func (k Keeper) getLeafCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LeafCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLeafCount(ctx sdk.Context, count uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	ctx.KVStore(k.storeKey).Set(types.LeafCountKey, bz)
+}
+
+// GetMerchant returns a registered merchant.
+func (k Keeper) GetMerchant(ctx sdk.Context, merchantID string) (merchant types.Merchant, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MerchantKey(merchantID))
+	if bz == nil {
+		return merchant, false
+	}
+	k.cdc.MustUnmarshal(bz, &merchant)
+	return merchant, true
+}
+
+// GetAllMerchants returns all registered merchants, including revoked ones,
+// ordered by leaf index.
+func (k Keeper) GetAllMerchants(ctx sdk.Context) []types.Merchant {
+	store := ctx.KVStore(k.storeKey)
+	count := k.getLeafCount(ctx)
+	merchants := make([]types.Merchant, count)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.MerchantKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var m types.Merchant
+		k.cdc.MustUnmarshal(iterator.Value(), &m)
+		merchants[m.LeafIndex] = m
+	}
+	return merchants
+}
+
+// RegisterMerchant appends the merchant's commitment to the registry and
+// updates the root. It returns the new root and the merchant's leaf index.
+func (k Keeper) RegisterMerchant(ctx sdk.Context, merchantID string, commitment []byte) ([]byte, uint64, error) {
+	if err := types.ValidateCommitment(commitment); err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", types.ErrInvalidCommitment, err)
+	}
+	if _, found := k.GetMerchant(ctx, merchantID); found {
+		return nil, 0, fmt.Errorf("%w: %s", types.ErrMerchantExists, merchantID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	index := k.getLeafCount(ctx)
+	merchant := types.Merchant{
+		MerchantID:       merchantID,
+		Commitment:       commitment,
+		LeafIndex:        index,
+		RegisteredHeight: ctx.BlockHeight(),
+	}
+	store.Set(types.MerchantKey(merchantID), k.cdc.MustMarshal(&merchant))
+	store.Set(types.LeafKey(index), commitment)
+	k.setLeafCount(ctx, index+1)
+
+	root, err := k.updateRoot(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return root, index, nil
+}
+
+// RevokeMerchant zeroes the merchant's leaf, so that proofs against the new
+// root cannot include it, and records the revocation.
+func (k Keeper) RevokeMerchant(ctx sdk.Context, merchantID, reason string) ([]byte, error) {
+	merchant, found := k.GetMerchant(ctx, merchantID)
+	if !found {
+		return nil, fmt.Errorf("%w: %s", types.ErrMerchantNotFound, merchantID)
+	}
+	if _, revoked := k.GetRevocation(ctx, merchantID); revoked {
+		return nil, fmt.Errorf("%w: %s", types.ErrMerchantRevoked, merchantID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LeafKey(merchant.LeafIndex), make([]byte, types.CommitmentSize))
+	k.setRevocation(ctx, types.Revocation{
+		MerchantID:    merchantID,
+		Commitment:    merchant.Commitment,
+		Reason:        reason,
+		RevokedHeight: ctx.BlockHeight(),
+	})
+
+	return k.updateRoot(ctx)
+}
+
+// GetRevocation returns a merchant's revocation.
+func (k Keeper) GetRevocation(ctx sdk.Context, merchantID string) (revocation types.Revocation, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RevocationKey(merchantID))
+	if bz == nil {
+		return revocation, false
+	}
+	k.cdc.MustUnmarshal(bz, &revocation)
+	return revocation, true
+}
+
+// GetAllRevocations returns all revocations.
+func (k Keeper) GetAllRevocations(ctx sdk.Context) (list []types.Revocation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RevocationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var r types.Revocation
+		k.cdc.MustUnmarshal(iterator.Value(), &r)
+		list = append(list, r)
+	}
+	return list
+}
+
+func (k Keeper) setRevocation(ctx sdk.Context, revocation types.Revocation) {
+	ctx.KVStore(k.storeKey).Set(types.RevocationKey(revocation.MerchantID), k.cdc.MustMarshal(&revocation))
+}
+
+// updateRoot recomputes the registry root from all leaves. This is linear in
+// the number of merchants, which is fine for registries updated by a single
+// issuer a few times per block.
+func (k Keeper) updateRoot(ctx sdk.Context) ([]byte, error) {
+	store := ctx.KVStore(k.storeKey)
+	var leaves [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, types.LeafKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		leaves = append(leaves, iterator.Value())
+	}
+
+	root, err := types.MerkleRoot(leaves)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute KYC root: %w", err)
+	}
+	store.Set(types.RootKey, root)
+	return root, nil
+}
@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fluentum-chain/fluentum/x/kyc/keeper"
+	"github.com/fluentum-chain/fluentum/x/kyc/types"
+)
+
+var (
+	issuer   = sdk.AccAddress([]byte("kyc_issuer__________")).String()
+	stranger = sdk.AccAddress([]byte("not_the_issuer______")).String()
+)
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, key)
+	k.SetParams(ctx, types.Params{Issuer: issuer})
+	return k, ctx
+}
+
+func commitment(n int64) []byte {
+	return big.NewInt(n).FillBytes(make([]byte, types.CommitmentSize))
+}
+
+func TestRegisterAndRevokeMerchant(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	_, err := srv.RegisterMerchant(ctx, types.NewMsgRegisterMerchant(stranger, "m1", commitment(1)))
+	require.ErrorIs(t, err, types.ErrUnauthorizedIssuer)
+
+	res1, err := srv.RegisterMerchant(ctx, types.NewMsgRegisterMerchant(issuer, "m1", commitment(1)))
+	require.NoError(t, err)
+	require.EqualValues(t, 0, res1.LeafIndex)
+	res2, err := srv.RegisterMerchant(ctx, types.NewMsgRegisterMerchant(issuer, "m2", commitment(2)))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, res2.LeafIndex)
+	require.NotEqual(t, res1.Root, res2.Root)
+
+	_, err = srv.RegisterMerchant(ctx, types.NewMsgRegisterMerchant(issuer, "m1", commitment(3)))
+	require.ErrorIs(t, err, types.ErrMerchantExists)
+
+	want, err := types.MerkleRoot([][]byte{commitment(1), commitment(2)})
+	require.NoError(t, err)
+	rootRes, err := k.Root(ctx, &types.QueryRootRequest{})
+	require.NoError(t, err)
+	require.Equal(t, want, rootRes.Root)
+	require.EqualValues(t, 2, rootRes.LeafCount)
+
+	_, err = srv.RevokeMerchant(ctx, types.NewMsgRevokeMerchant(stranger, "m1", "fraud"))
+	require.ErrorIs(t, err, types.ErrUnauthorizedIssuer)
+	_, err = srv.RevokeMerchant(ctx, types.NewMsgRevokeMerchant(issuer, "m3", "fraud"))
+	require.ErrorIs(t, err, types.ErrMerchantNotFound)
+
+	revokeRes, err := srv.RevokeMerchant(ctx, types.NewMsgRevokeMerchant(issuer, "m1", "fraud"))
+	require.NoError(t, err)
+	want, err = types.MerkleRoot([][]byte{make([]byte, types.CommitmentSize), commitment(2)})
+	require.NoError(t, err)
+	require.Equal(t, want, revokeRes.Root)
+
+	_, err = srv.RevokeMerchant(ctx, types.NewMsgRevokeMerchant(issuer, "m1", "fraud"))
+	require.ErrorIs(t, err, types.ErrMerchantRevoked)
+
+	revs, err := k.Revocations(ctx, &types.QueryRevocationsRequest{Pagination: &query.PageRequest{Limit: 10}})
+	require.NoError(t, err)
+	require.Len(t, revs.Revocations, 1)
+	require.Equal(t, "m1", revs.Revocations[0].MerchantID)
+	require.Equal(t, "fraud", revs.Revocations[0].Reason)
+	require.EqualValues(t, 10, revs.Revocations[0].RevokedHeight)
+
+	merchant, err := k.Merchant(ctx, &types.QueryMerchantRequest{MerchantID: "m2"})
+	require.NoError(t, err)
+	require.Equal(t, commitment(2), merchant.Merchant.Commitment)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	for i, id := range []string{"a", "b", "c"} {
+		_, err := srv.RegisterMerchant(ctx, types.NewMsgRegisterMerchant(issuer, id, commitment(int64(i+1))))
+		require.NoError(t, err)
+	}
+	_, err := srv.RevokeMerchant(ctx, types.NewMsgRevokeMerchant(issuer, "b", "expired"))
+	require.NoError(t, err)
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+
+	k2, ctx2 := setupKeeper(t)
+	k2.InitGenesis(ctx2, *exported)
+
+	root, count := k.GetRoot(ctx)
+	root2, count2 := k2.GetRoot(ctx2)
+	require.Equal(t, root, root2)
+	require.Equal(t, count, count2)
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+}
+
+func TestVerifyKYC(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	_, _, err := k.RegisterMerchant(ctx, "m1", commitment(1))
+	require.NoError(t, err)
+	root, _ := k.GetRoot(ctx)
+
+	caller := sdk.AccAddress([]byte("kyc_checking_contract"))
+	other := sdk.AccAddress([]byte("other_contract_______"))
+	nullifier := big.NewInt(42)
+	circuit := newTestCircuit(t, 5)
+	rootSignal := new(big.Int).SetBytes(root)
+	validAt := big.NewInt(ctx.BlockTime().Unix() + 60)
+	prove := func(root, validAt *big.Int, caller sdk.AccAddress) (proof, public []byte) {
+		return circuit.prove(t, root, big.NewInt(2), validAt, types.CallerSignal(caller), nullifier)
+	}
+	proof, public := prove(rootSignal, validAt, caller)
+
+	_, err = k.VerifyKYC(ctx, caller, 2, proof, public)
+	require.ErrorIs(t, err, types.ErrNoVerifyingKey)
+
+	params := k.GetParams(ctx)
+	params.VerifyingKey = circuit.verifyingKeyJSON(t)
+	k.SetParams(ctx, params)
+
+	got, err := k.VerifyKYC(ctx, caller, 2, proof, public)
+	require.NoError(t, err)
+	require.Equal(t, nullifier.FillBytes(make([]byte, types.CommitmentSize)), got)
+	_, err = k.VerifyKYC(ctx, caller, 1, proof, public)
+	require.NoError(t, err)
+	_, err = k.VerifyKYC(ctx, caller, 3, proof, public)
+	require.ErrorIs(t, err, types.ErrInsufficientLevel)
+	_, err = k.VerifyKYC(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), caller, 2, proof, public)
+	require.ErrorIs(t, err, types.ErrProofExpired)
+
+	// the proof can't be replayed by another caller
+	_, err = k.VerifyKYC(ctx, other, 2, proof, public)
+	require.ErrorIs(t, err, types.ErrCallerMismatch)
+
+	// tampering with a public signal breaks the pairing check
+	_, tampered := prove(rootSignal, new(big.Int).Add(validAt, big.NewInt(1)), caller)
+	_, err = k.VerifyKYC(ctx, caller, 2, proof, tampered)
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+
+	// a new registration changes the root, invalidating older proofs
+	_, _, err = k.RegisterMerchant(ctx, "m2", commitment(2))
+	require.NoError(t, err)
+	_, err = k.VerifyKYC(ctx, caller, 2, proof, public)
+	require.ErrorIs(t, err, types.ErrRootMismatch)
+
+	querier := keeper.NewWasmQuerier(k)
+	req, err := json.Marshal(keeper.KYCQuery{VerifyKYC: &keeper.VerifyKYCQuery{
+		MinLevel: 2, Proof: proof, PublicSignals: public,
+	}})
+	require.NoError(t, err)
+	bz, err := querier(ctx, caller, req)
+	require.NoError(t, err)
+	var resp keeper.VerifyKYCResponse
+	require.NoError(t, json.Unmarshal(bz, &resp))
+	require.False(t, resp.Valid)
+	require.NotEmpty(t, resp.Error)
+
+	root, _ = k.GetRoot(ctx)
+	proof, public = prove(new(big.Int).SetBytes(root), validAt, caller)
+	req, err = json.Marshal(keeper.KYCQuery{VerifyKYC: &keeper.VerifyKYCQuery{
+		MinLevel: 2, Proof: proof, PublicSignals: public,
+	}})
+	require.NoError(t, err)
+	bz, err = querier(ctx, caller, req)
+	require.NoError(t, err)
+	resp = keeper.VerifyKYCResponse{}
+	require.NoError(t, json.Unmarshal(bz, &resp))
+	require.True(t, resp.Valid)
+	require.Equal(t, got, resp.Nullifier)
+
+	// the querier checks the proof against the contract querying it
+	bz, err = querier(ctx, other, req)
+	require.NoError(t, err)
+	resp = keeper.VerifyKYCResponse{}
+	require.NoError(t, json.Unmarshal(bz, &resp))
+	require.False(t, resp.Valid)
+}
+
+// testCircuit holds a Groth16 verifying key built from known trapdoor
+// scalars, so that valid proofs can be simulated without a prover.
+type testCircuit struct {
+	alpha, beta, gamma, delta *big.Int
+	ic                        []*big.Int
+}
+
+func newTestCircuit(t *testing.T, nPublic int) *testCircuit {
+	c := &testCircuit{
+		alpha: randScalar(t),
+		beta:  randScalar(t),
+		gamma: randScalar(t),
+		delta: randScalar(t),
+	}
+	for i := 0; i <= nPublic; i++ {
+		c.ic = append(c.ic, randScalar(t))
+	}
+	return c
+}
+
+func (c *testCircuit) verifyingKeyJSON(t *testing.T) []byte {
+	ic := make([][]string, len(c.ic))
+	for i, s := range c.ic {
+		ic[i] = g1Coords(new(bn256.G1).ScalarBaseMult(s))
+	}
+	bz, err := json.Marshal(map[string]interface{}{
+		"protocol":   "groth16",
+		"curve":      "bn128",
+		"nPublic":    len(c.ic) - 1,
+		"vk_alpha_1": g1Coords(new(bn256.G1).ScalarBaseMult(c.alpha)),
+		"vk_beta_2":  g2Coords(new(bn256.G2).ScalarBaseMult(c.beta)),
+		"vk_gamma_2": g2Coords(new(bn256.G2).ScalarBaseMult(c.gamma)),
+		"vk_delta_2": g2Coords(new(bn256.G2).ScalarBaseMult(c.delta)),
+		"IC":         ic,
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+// prove simulates a proof by choosing A and B at random and solving the
+// verification equation for C.
+func (c *testCircuit) prove(t *testing.T, signals ...*big.Int) (proof, public []byte) {
+	x := new(big.Int).Set(c.ic[0])
+	for i, s := range signals {
+		x.Add(x, new(big.Int).Mul(s, c.ic[i+1]))
+	}
+	a, b := randScalar(t), randScalar(t)
+
+	cs := new(big.Int).Mul(a, b)
+	cs.Sub(cs, new(big.Int).Mul(c.alpha, c.beta))
+	cs.Sub(cs, new(big.Int).Mul(x, c.gamma))
+	cs.Mul(cs, new(big.Int).ModInverse(c.delta, bn256.Order))
+	cs.Mod(cs, bn256.Order)
+
+	proof, err := json.Marshal(map[string]interface{}{
+		"pi_a": g1Coords(new(bn256.G1).ScalarBaseMult(a)),
+		"pi_b": g2Coords(new(bn256.G2).ScalarBaseMult(b)),
+		"pi_c": g1Coords(new(bn256.G1).ScalarBaseMult(cs)),
+	})
+	require.NoError(t, err)
+	strs := make([]string, len(signals))
+	for i, s := range signals {
+		strs[i] = s.String()
+	}
+	public, err = json.Marshal(strs)
+	require.NoError(t, err)
+	return proof, public
+}
+
+func randScalar(t *testing.T) *big.Int {
+	k, err := rand.Int(rand.Reader, bn256.Order)
+	require.NoError(t, err)
+	if k.Sign() == 0 {
+		return big.NewInt(1)
+	}
+	return k
+}
+
+func g1Coords(p *bn256.G1) []string {
+	bz := p.Marshal()
+	return []string{new(big.Int).SetBytes(bz[:32]).String(), new(big.Int).SetBytes(bz[32:]).String(), "1"}
+}
+
+func g2Coords(p *bn256.G2) [][]string {
+	bz := p.Marshal()
+	return [][]string{
+		{new(big.Int).SetBytes(bz[32:64]).String(), new(big.Int).SetBytes(bz[:32]).String()},
+		{new(big.Int).SetBytes(bz[96:]).String(), new(big.Int).SetBytes(bz[64:96]).String()},
+		{"1", "0"},
+	}
+}
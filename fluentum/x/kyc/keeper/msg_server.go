@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fluentum-chain/fluentum/x/kyc/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) RegisterMerchant(goCtx context.Context, msg *types.MsgRegisterMerchant) (*types.MsgRegisterMerchantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkIssuer(ctx, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	root, index, err := k.Keeper.RegisterMerchant(ctx, msg.MerchantID, msg.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterMerchant,
+		sdk.NewAttribute(types.AttributeKeyMerchantID, msg.MerchantID),
+		sdk.NewAttribute(types.AttributeKeyRoot, types.FormatRoot(root)),
+	))
+
+	return &types.MsgRegisterMerchantResponse{Root: root, LeafIndex: index}, nil
+}
+
+func (k msgServer) RevokeMerchant(goCtx context.Context, msg *types.MsgRevokeMerchant) (*types.MsgRevokeMerchantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkIssuer(ctx, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	root, err := k.Keeper.RevokeMerchant(ctx, msg.MerchantID, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevokeMerchant,
+		sdk.NewAttribute(types.AttributeKeyMerchantID, msg.MerchantID),
+		sdk.NewAttribute(types.AttributeKeyRoot, types.FormatRoot(root)),
+	))
+
+	return &types.MsgRevokeMerchantResponse{Root: root}, nil
+}
+
+func (k msgServer) checkIssuer(ctx sdk.Context, signer string) error {
+	issuer := k.GetParams(ctx).Issuer
+	if issuer == "" || signer != issuer {
+		return errorsmod.Wrapf(types.ErrUnauthorizedIssuer, "expected %q, got %q", issuer, signer)
+	}
+	return nil
+}
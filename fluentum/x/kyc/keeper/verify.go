@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fluentum-chain/fluentum/x/kyc/types"
+	"github.com/fluentum-chain/fluentum/zkprover"
+)
+
+// Public signals of a KYC proof, in circuit output order.
+const (
+	signalRoot = iota
+	signalMinLevel
+	signalValidAt
+	signalCaller
+	signalNullifier
+	numSignals
+)
+
+// VerifyKYC checks a Groth16 proof, in the snarkjs format, that a merchant
+// in the registry has a KYC level of at least minLevel and a KYC record that
+// has not expired. The proof is made with circuits/kyc.circom, and its public
+// signals must be
+//
+//	[root, minLevel, validAt, caller, nullifier]
+//
+// where root is the current registry root, minLevel is the proven lower bound
+// on the KYC level, validAt is a unix time, no earlier than the block time,
+// before which the KYC record does not expire, and caller is the
+// types.CallerSignal of the account checking the proof, so that a proof can't
+// be replayed to another account. Nothing else about the merchant is revealed:
+// the returned nullifier is the same for all the proofs of a merchant to
+// caller, and lets caller tell merchants apart.
+func (k Keeper) VerifyKYC(
+	goCtx context.Context,
+	caller sdk.AccAddress,
+	minLevel uint64,
+	proof, publicSignals []byte,
+) ([]byte, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if len(params.VerifyingKey) == 0 {
+		return nil, types.ErrNoVerifyingKey
+	}
+	vk, err := zkprover.ParseVerifyingKey(params.VerifyingKey)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrNoVerifyingKey, err.Error())
+	}
+
+	signals, err := zkprover.ParsePublicSignals(publicSignals)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidProof, err.Error())
+	}
+	if len(signals) != numSignals {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProof, "expected %d public signals, got %d", numSignals, len(signals))
+	}
+
+	root, _ := k.GetRoot(ctx)
+	if !bytes.Equal(signals[signalRoot].FillBytes(make([]byte, types.CommitmentSize)), root) {
+		return nil, types.ErrRootMismatch
+	}
+	if signals[signalMinLevel].Cmp(new(big.Int).SetUint64(minLevel)) < 0 {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientLevel, "proven %s, required %d", signals[signalMinLevel], minLevel)
+	}
+	if signals[signalValidAt].Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, errorsmod.Wrapf(types.ErrProofExpired, "valid at %s, block time %d",
+			signals[signalValidAt], ctx.BlockTime().Unix())
+	}
+	if signals[signalCaller].Cmp(types.CallerSignal(caller)) != 0 {
+		return nil, errorsmod.Wrapf(types.ErrCallerMismatch, "caller %s", caller)
+	}
+
+	proofData, err := zkprover.ParseProof(proof)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidProof, err.Error())
+	}
+	if err := vk.VerifyGroth16(proofData, signals); err != nil {
+		var countErr zkprover.ErrPublicSignalsCount
+		if errors.As(err, &countErr) {
+			return nil, errorsmod.Wrapf(types.ErrNoVerifyingKey, "verifying key expects %d public signals", countErr.Expected)
+		}
+		return nil, errorsmod.Wrap(types.ErrInvalidProof, err.Error())
+	}
+	return signals[signalNullifier].FillBytes(make([]byte, types.CommitmentSize)), nil
+}
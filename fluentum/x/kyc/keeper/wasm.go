@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KYCQuery is the custom query a CosmWasm contract sends to check a KYC proof
+// or read the registry root. Exactly one field must be set.
+type KYCQuery struct {
+	VerifyKYC *VerifyKYCQuery `json:"verify_kyc,omitempty"`
+	KYCRoot   *struct{}       `json:"kyc_root,omitempty"`
+}
+
+// VerifyKYCQuery asks whether a proof is valid for the given minimum level.
+type VerifyKYCQuery struct {
+	MinLevel      uint64          `json:"min_level"`
+	Proof         json.RawMessage `json:"proof"`
+	PublicSignals json.RawMessage `json:"public_signals"`
+}
+
+// VerifyKYCResponse is the reply to a VerifyKYCQuery. Nullifier is set when
+// Valid is true, see Keeper.VerifyKYC, and Error when it is false.
+type VerifyKYCResponse struct {
+	Valid     bool   `json:"valid"`
+	Nullifier []byte `json:"nullifier,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// KYCRootResponse is the reply to a kyc_root query.
+type KYCRootResponse struct {
+	Root      []byte `json:"root"`
+	LeafCount uint64 `json:"leaf_count"`
+}
+
+// NewWasmQuerier returns a handler for the KYCQuery custom queries of the
+// contract caller. The proofs are checked against caller, so the app installs
+// it with wasmkeeper.WithQueryHandlerDecorator rather than as a
+// wasmkeeper.CustomQuerier, which isn't given the contract address.
+func NewWasmQuerier(k Keeper) func(ctx sdk.Context, caller sdk.AccAddress, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, caller sdk.AccAddress, request json.RawMessage) ([]byte, error) {
+		var q KYCQuery
+		if err := json.Unmarshal(request, &q); err != nil {
+			return nil, fmt.Errorf("invalid kyc query: %w", err)
+		}
+
+		switch {
+		case q.VerifyKYC != nil:
+			nullifier, err := k.VerifyKYC(ctx, caller, q.VerifyKYC.MinLevel, q.VerifyKYC.Proof, q.VerifyKYC.PublicSignals)
+			resp := VerifyKYCResponse{Valid: true, Nullifier: nullifier}
+			if err != nil {
+				resp = VerifyKYCResponse{Valid: false, Error: err.Error()}
+			}
+			return json.Marshal(resp)
+		case q.KYCRoot != nil:
+			root, count := k.GetRoot(ctx)
+			return json.Marshal(KYCRootResponse{Root: root, LeafCount: count})
+		default:
+			return nil, fmt.Errorf("unknown kyc query")
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/kyc module sentinel errors
+var (
+	ErrUnauthorizedIssuer = errorsmod.Register(ModuleName, 2, "signer is not the KYC issuer")
+	ErrInvalidCommitment  = errorsmod.Register(ModuleName, 3, "invalid KYC commitment")
+	ErrMerchantExists     = errorsmod.Register(ModuleName, 4, "merchant already registered")
+	ErrMerchantNotFound   = errorsmod.Register(ModuleName, 5, "merchant not found")
+	ErrMerchantRevoked    = errorsmod.Register(ModuleName, 6, "merchant revoked")
+	ErrNoVerifyingKey     = errorsmod.Register(ModuleName, 7, "no KYC verifying key configured")
+	ErrInvalidProof       = errorsmod.Register(ModuleName, 8, "invalid KYC proof")
+	ErrRootMismatch       = errorsmod.Register(ModuleName, 9, "proof is not against the current KYC root")
+	ErrInsufficientLevel  = errorsmod.Register(ModuleName, 10, "proven KYC level is too low")
+	ErrProofExpired       = errorsmod.Register(ModuleName, 11, "proof does not cover the current block time")
+	ErrCallerMismatch     = errorsmod.Register(ModuleName, 12, "proof is bound to another caller")
+)
@@ -0,0 +1,17 @@
+package types
+
+import "encoding/hex"
+
+// kyc module event types
+const (
+	EventTypeRegisterMerchant = "register_merchant"
+	EventTypeRevokeMerchant   = "revoke_merchant"
+
+	AttributeKeyMerchantID = "merchant_id"
+	AttributeKeyRoot       = "root"
+)
+
+// FormatRoot formats a registry root as an event attribute value.
+func FormatRoot(root []byte) string {
+	return hex.EncodeToString(root)
+}
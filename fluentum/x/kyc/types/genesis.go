@@ -0,0 +1,44 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:      DefaultParams(),
+		Merchants:   []Merchant{},
+		Revocations: []Revocation{},
+	}
+}
+
+// Validate performs basic genesis state validation. Merchants must be listed
+// by leaf index, starting at zero.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[string]bool, len(gs.Merchants))
+	for i, m := range gs.Merchants {
+		if m.MerchantID == "" {
+			return fmt.Errorf("merchant %d has no ID", i)
+		}
+		if ids[m.MerchantID] {
+			return fmt.Errorf("duplicate merchant %s", m.MerchantID)
+		}
+		ids[m.MerchantID] = true
+		if m.LeafIndex != uint64(i) {
+			return fmt.Errorf("merchant %s has leaf index %d, expected %d", m.MerchantID, m.LeafIndex, i)
+		}
+		if err := ValidateCommitment(m.Commitment); err != nil {
+			return fmt.Errorf("merchant %s: %w", m.MerchantID, err)
+		}
+	}
+
+	for _, r := range gs.Revocations {
+		if !ids[r.MerchantID] {
+			return fmt.Errorf("revocation of unknown merchant %s", r.MerchantID)
+		}
+	}
+	return nil
+}
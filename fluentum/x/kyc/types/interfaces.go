@@ -0,0 +1,14 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KYCKeeper is the interface other modules use to check a merchant's KYC
+// proof against the registry. The proof must be bound to caller, the account
+// checking it; the returned nullifier identifies the merchant to caller only.
+type KYCKeeper interface {
+	VerifyKYC(ctx context.Context, caller sdk.AccAddress, minLevel uint64, proof, publicSignals []byte) (nullifier []byte, err error)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "kyc"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Store key prefixes
+var (
+	ParamsKey           = []byte{0x01}
+	RootKey             = []byte{0x02}
+	LeafCountKey        = []byte{0x03}
+	LeafKeyPrefix       = []byte{0x04}
+	MerchantKeyPrefix   = []byte{0x05}
+	RevocationKeyPrefix = []byte{0x06}
+)
+
+// LeafKey returns the store key of the registry leaf at index. Indices are
+// big-endian encoded so that leaves iterate in insertion order.
+func LeafKey(index uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, index)
+	return append(append([]byte{}, LeafKeyPrefix...), bz...)
+}
+
+// MerchantKey returns the store key of a registered merchant.
+func MerchantKey(merchantID string) []byte {
+	return append(append([]byte{}, MerchantKeyPrefix...), merchantID...)
+}
+
+// RevocationKey returns the store key of a merchant's revocation.
+func RevocationKey(merchantID string) []byte {
+	return append(append([]byte{}, RevocationKeyPrefix...), merchantID...)
+}
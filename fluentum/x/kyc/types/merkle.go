@@ -0,0 +1,70 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iden3/go-iden3-crypto/constants"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+)
+
+// CommitmentSize is the size of a KYC commitment and of the registry root:
+// a big-endian element of the BN254 scalar field.
+const CommitmentSize = 32
+
+// ValidateCommitment checks that bz encodes a BN254 scalar field element.
+func ValidateCommitment(bz []byte) error {
+	if len(bz) != CommitmentSize {
+		return fmt.Errorf("expected %d bytes, got %d", CommitmentSize, len(bz))
+	}
+	if new(big.Int).SetBytes(bz).Cmp(constants.Q) >= 0 {
+		return fmt.Errorf("commitment is not a field element")
+	}
+	return nil
+}
+
+// CallerSignal returns the public signal that binds a KYC proof to the
+// account checking it: the SHA-256 hash of the address reduced into the BN254
+// scalar field.
+func CallerSignal(caller sdk.AccAddress) *big.Int {
+	hash := sha256.Sum256(caller)
+	signal := new(big.Int).SetBytes(hash[:])
+	return signal.Mod(signal, constants.Q)
+}
+
+// MerkleRoot computes the root of the binary Poseidon Merkle tree over
+// leaves. The leaves are padded with zeros to the next power of two, and a
+// revoked merchant's leaf is zero, so the tree can be opened inside a circom
+// circuit. The root of an empty tree is zero.
+func MerkleRoot(leaves [][]byte) ([]byte, error) {
+	if len(leaves) == 0 {
+		return make([]byte, CommitmentSize), nil
+	}
+
+	size := 1
+	for size < len(leaves) {
+		size *= 2
+	}
+	level := make([]*big.Int, size)
+	for i := range level {
+		level[i] = new(big.Int)
+		if i < len(leaves) {
+			level[i].SetBytes(leaves[i])
+		}
+	}
+
+	for len(level) > 1 {
+		next := make([]*big.Int, len(level)/2)
+		for i := range next {
+			h, err := poseidon.Hash([]*big.Int{level[2*i], level[2*i+1]})
+			if err != nil {
+				return nil, err
+			}
+			next[i] = h
+		}
+		level = next
+	}
+	return level[0].FillBytes(make([]byte, CommitmentSize)), nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterMerchant{}
+	_ sdk.Msg = &MsgRevokeMerchant{}
+)
+
+// NewMsgRegisterMerchant creates a new MsgRegisterMerchant instance
+func NewMsgRegisterMerchant(issuer, merchantID string, commitment []byte) *MsgRegisterMerchant {
+	return &MsgRegisterMerchant{
+		Issuer:     issuer,
+		MerchantID: merchantID,
+		Commitment: commitment,
+	}
+}
+
+// ValidateBasic validates the message
+func (msg *MsgRegisterMerchant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+	if msg.MerchantID == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty merchant ID")
+	}
+	if err := ValidateCommitment(msg.Commitment); err != nil {
+		return errorsmod.Wrap(ErrInvalidCommitment, err.Error())
+	}
+	return nil
+}
+
+// NewMsgRevokeMerchant creates a new MsgRevokeMerchant instance
+func NewMsgRevokeMerchant(issuer, merchantID, reason string) *MsgRevokeMerchant {
+	return &MsgRevokeMerchant{
+		Issuer:     issuer,
+		MerchantID: merchantID,
+		Reason:     reason,
+	}
+}
+
+// ValidateBasic validates the message
+func (msg *MsgRevokeMerchant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+	if msg.MerchantID == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty merchant ID")
+	}
+	return nil
+}
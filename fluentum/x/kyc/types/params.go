@@ -0,0 +1,27 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the default parameters: no issuer and no verifying
+// key, which disables registration and proof checks until set at genesis.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate validates the parameters.
+func (p Params) Validate() error {
+	if p.Issuer != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Issuer); err != nil {
+			return fmt.Errorf("invalid issuer address: %w", err)
+		}
+	}
+	if len(p.VerifyingKey) > 0 && !json.Valid(p.VerifyingKey) {
+		return fmt.Errorf("verifying key is not valid JSON")
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/fluentum-chain/fluentum/crypto/merkle"
+	"github.com/iden3/go-iden3-crypto/constants"
 	"github.com/iden3/go-iden3-crypto/poseidon"
 )
 
@@ -158,18 +159,47 @@ func (v *KYCVerifier) VerifyProof(proof []byte, minBalance *big.Int) (bool, erro
 
 // hashMerchantData creates a Poseidon hash of the merchant data
 func (v *KYCVerifier) hashMerchantData(data *KYCData) (*big.Int, error) {
-	// Convert data to bytes
-	bytes, err := json.Marshal(data)
+	dataHash, err := fieldHash(data)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create SHA256 hash
-	shaHash := sha256.Sum256(bytes)
+	// Create Poseidon hash
+	return poseidon.Hash([]*big.Int{dataHash})
+}
 
-	// Convert to big.Int
-	hash := new(big.Int).SetBytes(shaHash[:])
+// Commitment returns the leaf registered for the merchant in the on-chain
+// KYC registry (x/kyc): Poseidon(KYCLevel, ExpiresAt, H(data)), where H(data)
+// is the SHA-256 hash of the JSON encoded data reduced into the BN254 scalar
+// field. The KYC circuit opens the commitment to prove a minimum level and
+// an expiry without revealing the rest of the data.
+func Commitment(data *KYCData) ([]byte, error) {
+	if data.KYCLevel < 0 || data.ExpiresAt < 0 {
+		return nil, errors.New("negative KYC level or expiry")
+	}
+	dataHash, err := fieldHash(data)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := poseidon.Hash([]*big.Int{
+		big.NewInt(int64(data.KYCLevel)),
+		big.NewInt(data.ExpiresAt),
+		dataHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hash.FillBytes(make([]byte, 32)), nil
+}
 
-	// Create Poseidon hash
-	return poseidon.Hash([]*big.Int{hash})
+// fieldHash hashes the JSON encoding of data with SHA-256 and reduces the
+// result into the BN254 scalar field, so it can be used as a Poseidon input.
+func fieldHash(data *KYCData) (*big.Int, error) {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	shaHash := sha256.Sum256(bytes)
+	hash := new(big.Int).SetBytes(shaHash[:])
+	return hash.Mod(hash, constants.Q), nil
 }
@@ -1,6 +1,7 @@
 package zk_kyc
 
 import (
+	"bytes"
 	"math/big"
 	"testing"
 	"time"
@@ -119,3 +120,42 @@ func TestKYCVerifier(t *testing.T) {
 		t.Error("Proof verification succeeded with insufficient KYC level")
 	}
 } 
+
+func TestCommitment(t *testing.T) {
+	data := &KYCData{
+		MerchantID: "test_merchant_1",
+		Balance:    big.NewInt(1000000000),
+		KYCLevel:   2,
+		ExpiresAt:  time.Now().AddDate(1, 0, 0).Unix(),
+	}
+
+	c1, err := Commitment(data)
+	if err != nil {
+		t.Fatalf("Failed to compute commitment: %v", err)
+	}
+	if len(c1) != 32 {
+		t.Fatalf("Expected 32 byte commitment, got %d", len(c1))
+	}
+
+	c2, err := Commitment(data)
+	if err != nil {
+		t.Fatalf("Failed to compute commitment: %v", err)
+	}
+	if !bytes.Equal(c1, c2) {
+		t.Error("Commitment should be deterministic")
+	}
+
+	data.KYCLevel = 3
+	c3, err := Commitment(data)
+	if err != nil {
+		t.Fatalf("Failed to compute commitment: %v", err)
+	}
+	if bytes.Equal(c1, c3) {
+		t.Error("Commitment should bind the KYC level")
+	}
+
+	data.KYCLevel = -1
+	if _, err := Commitment(data); err == nil {
+		t.Error("Expected error for negative KYC level")
+	}
+}
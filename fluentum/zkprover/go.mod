@@ -2,15 +2,24 @@ module github.com/fluentum-chain/fluentum/zkprover
 
 go 1.24.4
 
-require (
-)
+require github.com/ethereum/go-ethereum v1.15.11
+
+require golang.org/x/sys v0.30.0 // indirect
 
 replace github.com/fluentum-chain/fluentum => ../../..
+
 replace github.com/fluentum-chain/fluentum/core/plugin => ../core/plugin
+
 replace github.com/fluentum-chain/fluentum/core/crypto => ../core/crypto
+
 replace github.com/fluentum-chain/fluentum/x/fluentum => ../x/fluentum
+
 replace github.com/fluentum-chain/fluentum/x/cex => ../x/cex
+
 replace github.com/fluentum-chain/fluentum/x/dex => ../x/dex
+
 replace github.com/fluentum-chain/fluentum/quantum => ../quantum
+
 replace github.com/fluentum-chain/fluentum/zkprover => .
+
 replace github.com/fluentum-chain/fluentum/liquidity => ../liquidity
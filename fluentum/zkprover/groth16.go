@@ -0,0 +1,294 @@
+package zkprover
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+)
+
+var (
+	// ErrMalformedProof is returned when a proof, verifying key or public
+	// signal cannot be decoded, or decodes to a point that is not on the curve.
+	ErrMalformedProof = errors.New("malformed proof")
+
+	// ErrPairingCheckFailed is returned when a well-formed proof does not
+	// satisfy the Groth16 verification equation for its public signals.
+	ErrPairingCheckFailed = errors.New("pairing check failed")
+)
+
+// ErrPublicSignalsCount is returned when the number of public signals does not
+// match the number of public inputs of the verifying key.
+type ErrPublicSignalsCount struct {
+	Expected int
+	Got      int
+}
+
+func (e ErrPublicSignalsCount) Error() string {
+	return fmt.Sprintf("wrong number of public signals: expected %d, got %d", e.Expected, e.Got)
+}
+
+// VerifyingKey is a Groth16 verifying key over BN254.
+type VerifyingKey struct {
+	Alpha *bn256.G1
+	Beta  *bn256.G2
+	Gamma *bn256.G2
+	Delta *bn256.G2
+	// IC holds one point per public signal, plus the constant term at IC[0].
+	IC []*bn256.G1
+}
+
+// NumPublic returns the number of public signals a proof must carry.
+func (vk *VerifyingKey) NumPublic() int {
+	return len(vk.IC) - 1
+}
+
+// Groth16Proof is a Groth16 proof over BN254.
+type Groth16Proof struct {
+	A *bn256.G1
+	B *bn256.G2
+	C *bn256.G1
+}
+
+// snarkjsVerifyingKey is the verification_key.json layout written by
+// `snarkjs zkey export verificationkey`.
+type snarkjsVerifyingKey struct {
+	Protocol string     `json:"protocol"`
+	Curve    string     `json:"curve"`
+	NPublic  int        `json:"nPublic"`
+	Alpha    []string   `json:"vk_alpha_1"`
+	Beta     [][]string `json:"vk_beta_2"`
+	Gamma    [][]string `json:"vk_gamma_2"`
+	Delta    [][]string `json:"vk_delta_2"`
+	IC       [][]string `json:"IC"`
+}
+
+// snarkjsProof is the proof.json layout written by `snarkjs groth16 prove`.
+type snarkjsProof struct {
+	A []string   `json:"pi_a"`
+	B [][]string `json:"pi_b"`
+	C []string   `json:"pi_c"`
+}
+
+// LoadVerifyingKey reads a snarkjs verification_key.json file.
+func LoadVerifyingKey(path string) (*VerifyingKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read verifying key: %w", err)
+	}
+	vk, err := ParseVerifyingKey(bz)
+	if err != nil {
+		return nil, fmt.Errorf("verifying key %s: %w", path, err)
+	}
+	return vk, nil
+}
+
+// ParseVerifyingKey decodes a verifying key in the snarkjs JSON format. Only
+// Groth16 keys over bn128 (BN254) are supported.
+func ParseVerifyingKey(bz []byte) (*VerifyingKey, error) {
+	var raw snarkjsVerifyingKey
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, err
+	}
+	if raw.Protocol != "groth16" {
+		return nil, fmt.Errorf("unsupported protocol %q", raw.Protocol)
+	}
+	if raw.Curve != "bn128" {
+		return nil, fmt.Errorf("unsupported curve %q", raw.Curve)
+	}
+	if len(raw.IC) != raw.NPublic+1 {
+		return nil, fmt.Errorf("expected %d IC points, got %d", raw.NPublic+1, len(raw.IC))
+	}
+
+	var (
+		vk  VerifyingKey
+		err error
+	)
+	if vk.Alpha, err = parseG1(raw.Alpha); err != nil {
+		return nil, fmt.Errorf("vk_alpha_1: %w", err)
+	}
+	if vk.Beta, err = parseG2(raw.Beta); err != nil {
+		return nil, fmt.Errorf("vk_beta_2: %w", err)
+	}
+	if vk.Gamma, err = parseG2(raw.Gamma); err != nil {
+		return nil, fmt.Errorf("vk_gamma_2: %w", err)
+	}
+	if vk.Delta, err = parseG2(raw.Delta); err != nil {
+		return nil, fmt.Errorf("vk_delta_2: %w", err)
+	}
+	vk.IC = make([]*bn256.G1, len(raw.IC))
+	for i, p := range raw.IC {
+		if vk.IC[i], err = parseG1(p); err != nil {
+			return nil, fmt.Errorf("IC[%d]: %w", i, err)
+		}
+	}
+	return &vk, nil
+}
+
+// ParseProof decodes a Groth16 proof in the snarkjs JSON format.
+func ParseProof(bz []byte) (*Groth16Proof, error) {
+	var raw snarkjsProof
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedProof, err)
+	}
+
+	var (
+		proof Groth16Proof
+		err   error
+	)
+	if proof.A, err = parseG1(raw.A); err != nil {
+		return nil, fmt.Errorf("%w: pi_a: %v", ErrMalformedProof, err)
+	}
+	if proof.B, err = parseG2(raw.B); err != nil {
+		return nil, fmt.Errorf("%w: pi_b: %v", ErrMalformedProof, err)
+	}
+	if proof.C, err = parseG1(raw.C); err != nil {
+		return nil, fmt.Errorf("%w: pi_c: %v", ErrMalformedProof, err)
+	}
+	return &proof, nil
+}
+
+// ParsePublicSignals decodes public signals in the snarkjs public.json format:
+// a JSON array of decimal strings, each an element of the scalar field.
+func ParsePublicSignals(bz []byte) ([]*big.Int, error) {
+	var raw []string
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("%w: public signals: %v", ErrMalformedProof, err)
+	}
+	signals := make([]*big.Int, len(raw))
+	for i, s := range raw {
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok || n.Sign() < 0 || n.Cmp(bn256.Order) >= 0 {
+			return nil, fmt.Errorf("%w: public signal %d is not a field element", ErrMalformedProof, i)
+		}
+		signals[i] = n
+	}
+	return signals, nil
+}
+
+// Verify checks a snarkjs-encoded proof against its public signals. It returns
+// nil if the proof is valid, an error wrapping ErrMalformedProof if either input
+// cannot be decoded, ErrPublicSignalsCount if the number of signals is wrong
+// and ErrPairingCheckFailed if the proof does not verify.
+func (vk *VerifyingKey) Verify(proof []byte, publicSignals []byte) error {
+	p, err := ParseProof(proof)
+	if err != nil {
+		return err
+	}
+	signals, err := ParsePublicSignals(publicSignals)
+	if err != nil {
+		return err
+	}
+	return vk.VerifyGroth16(p, signals)
+}
+
+// VerifyGroth16 checks the Groth16 equation
+//
+//	e(A, B) = e(alpha, beta) * e(vk_x, gamma) * e(C, delta)
+//
+// where vk_x = IC[0] + sum(signals[i] * IC[i+1]).
+func (vk *VerifyingKey) VerifyGroth16(proof *Groth16Proof, signals []*big.Int) error {
+	if len(signals) != vk.NumPublic() {
+		return ErrPublicSignalsCount{Expected: vk.NumPublic(), Got: len(signals)}
+	}
+
+	vkX := new(bn256.G1).Set(vk.IC[0])
+	for i, s := range signals {
+		vkX.Add(vkX, new(bn256.G1).ScalarMult(vk.IC[i+1], s))
+	}
+
+	negA := new(bn256.G1).Neg(proof.A)
+	ok := bn256.PairingCheck(
+		[]*bn256.G1{negA, vk.Alpha, vkX, proof.C},
+		[]*bn256.G2{proof.B, vk.Beta, vk.Gamma, vk.Delta},
+	)
+	if !ok {
+		return ErrPairingCheckFailed
+	}
+	return nil
+}
+
+// parseG1 decodes an affine or projective-with-z=1 G1 point given as decimal
+// coordinates [x, y, z].
+func parseG1(coords []string) (*bn256.G1, error) {
+	if len(coords) != 3 {
+		return nil, fmt.Errorf("expected 3 coordinates, got %d", len(coords))
+	}
+	x, y, z, err := parseFieldElements(coords)
+	if err != nil {
+		return nil, err
+	}
+	bz := make([]byte, 64)
+	switch {
+	case z.Sign() == 0:
+		// point at infinity, encoded as all zeroes
+	case z.Cmp(big.NewInt(1)) == 0:
+		x.FillBytes(bz[:32])
+		y.FillBytes(bz[32:])
+	default:
+		return nil, errors.New("point is not in affine form")
+	}
+	p := new(bn256.G1)
+	if _, err := p.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// parseG2 decodes a G2 point given as [[x0, x1], [y0, y1], [z0, z1]], where
+// each coordinate is c0 + c1*u. The underlying library orders the imaginary
+// part first.
+func parseG2(coords [][]string) (*bn256.G2, error) {
+	if len(coords) != 3 {
+		return nil, fmt.Errorf("expected 3 coordinates, got %d", len(coords))
+	}
+	for _, c := range coords {
+		if len(c) != 2 {
+			return nil, errors.New("expected 2 components per coordinate")
+		}
+	}
+	x0, x1, _, err := parseFieldElements(append(coords[0][:2:2], "0"))
+	if err != nil {
+		return nil, err
+	}
+	y0, y1, _, err := parseFieldElements(append(coords[1][:2:2], "0"))
+	if err != nil {
+		return nil, err
+	}
+	z0, z1, _, err := parseFieldElements(append(coords[2][:2:2], "0"))
+	if err != nil {
+		return nil, err
+	}
+	bz := make([]byte, 128)
+	switch {
+	case z0.Sign() == 0 && z1.Sign() == 0:
+		// point at infinity, encoded as all zeroes
+	case z0.Cmp(big.NewInt(1)) == 0 && z1.Sign() == 0:
+		x1.FillBytes(bz[:32])
+		x0.FillBytes(bz[32:64])
+		y1.FillBytes(bz[64:96])
+		y0.FillBytes(bz[96:])
+	default:
+		return nil, errors.New("point is not in affine form")
+	}
+	p := new(bn256.G2)
+	if _, err := p.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func parseFieldElements(coords []string) (a, b, c *big.Int, err error) {
+	out := make([]*big.Int, 3)
+	for i, s := range coords {
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok || n.Sign() < 0 || n.Cmp(bn256.P) >= 0 {
+			return nil, nil, nil, fmt.Errorf("coordinate %q is not a base field element", s)
+		}
+		out[i] = n
+	}
+	return out[0], out[1], out[2], nil
+}
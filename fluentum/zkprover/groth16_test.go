@@ -0,0 +1,186 @@
+package zkprover
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+)
+
+// testCircuit holds a verifying key built from known trapdoor scalars, so that
+// valid proofs can be simulated without a prover.
+type testCircuit struct {
+	alpha, beta, gamma, delta *big.Int
+	ic                        []*big.Int
+}
+
+func newTestCircuit(t *testing.T, nPublic int) *testCircuit {
+	c := &testCircuit{
+		alpha: randScalar(t),
+		beta:  randScalar(t),
+		gamma: randScalar(t),
+		delta: randScalar(t),
+	}
+	for i := 0; i <= nPublic; i++ {
+		c.ic = append(c.ic, randScalar(t))
+	}
+	return c
+}
+
+// verifyingKeyJSON encodes the circuit's verifying key like snarkjs does.
+func (c *testCircuit) verifyingKeyJSON(t *testing.T) []byte {
+	ic := make([][]string, len(c.ic))
+	for i, s := range c.ic {
+		ic[i] = g1Coords(new(bn256.G1).ScalarBaseMult(s))
+	}
+	bz, err := json.Marshal(map[string]interface{}{
+		"protocol":   "groth16",
+		"curve":      "bn128",
+		"nPublic":    len(c.ic) - 1,
+		"vk_alpha_1": g1Coords(new(bn256.G1).ScalarBaseMult(c.alpha)),
+		"vk_beta_2":  g2Coords(new(bn256.G2).ScalarBaseMult(c.beta)),
+		"vk_gamma_2": g2Coords(new(bn256.G2).ScalarBaseMult(c.gamma)),
+		"vk_delta_2": g2Coords(new(bn256.G2).ScalarBaseMult(c.delta)),
+		"IC":         ic,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bz
+}
+
+// prove simulates a proof for signals by choosing A and B at random and
+// solving the verification equation for C.
+func (c *testCircuit) prove(t *testing.T, signals []*big.Int) (proof, public []byte) {
+	r := bn256.Order
+	x := new(big.Int).Set(c.ic[0])
+	for i, s := range signals {
+		x.Add(x, new(big.Int).Mul(s, c.ic[i+1]))
+	}
+	a, b := randScalar(t), randScalar(t)
+
+	// c = (a*b - alpha*beta - x*gamma) / delta
+	cs := new(big.Int).Mul(a, b)
+	cs.Sub(cs, new(big.Int).Mul(c.alpha, c.beta))
+	cs.Sub(cs, new(big.Int).Mul(x, c.gamma))
+	cs.Mul(cs, new(big.Int).ModInverse(c.delta, r))
+	cs.Mod(cs, r)
+
+	proof, err := json.Marshal(map[string]interface{}{
+		"pi_a":     g1Coords(new(bn256.G1).ScalarBaseMult(a)),
+		"pi_b":     g2Coords(new(bn256.G2).ScalarBaseMult(b)),
+		"pi_c":     g1Coords(new(bn256.G1).ScalarBaseMult(cs)),
+		"protocol": "groth16",
+		"curve":    "bn128",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	strs := make([]string, len(signals))
+	for i, s := range signals {
+		strs[i] = s.String()
+	}
+	public, err = json.Marshal(strs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proof, public
+}
+
+func randScalar(t *testing.T) *big.Int {
+	k, err := rand.Int(rand.Reader, bn256.Order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.Sign() == 0 {
+		return big.NewInt(1)
+	}
+	return k
+}
+
+func g1Coords(p *bn256.G1) []string {
+	bz := p.Marshal()
+	return []string{
+		new(big.Int).SetBytes(bz[:32]).String(),
+		new(big.Int).SetBytes(bz[32:]).String(),
+		"1",
+	}
+}
+
+func g2Coords(p *bn256.G2) [][]string {
+	bz := p.Marshal()
+	return [][]string{
+		{new(big.Int).SetBytes(bz[32:64]).String(), new(big.Int).SetBytes(bz[:32]).String()},
+		{new(big.Int).SetBytes(bz[96:]).String(), new(big.Int).SetBytes(bz[64:96]).String()},
+		{"1", "0"},
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	circuit := newTestCircuit(t, 2)
+
+	path := filepath.Join(t.TempDir(), "verification_key.json")
+	if err := os.WriteFile(path, circuit.verifyingKeyJSON(t), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	vk, err := LoadVerifyingKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vk.NumPublic() != 2 {
+		t.Fatalf("expected 2 public signals, got %d", vk.NumPublic())
+	}
+
+	signals := []*big.Int{big.NewInt(42), big.NewInt(7)}
+	proof, public := circuit.prove(t, signals)
+
+	if err := VerifyProof(vk, proof, public); err != nil {
+		t.Fatalf("valid proof rejected: %v", err)
+	}
+	if err := NewZKRollup(vk).VerifyBatch(ZKBatch{Proof: proof, PublicSignals: public}); err != nil {
+		t.Fatalf("valid batch rejected: %v", err)
+	}
+
+	// A proof does not verify for other public signals.
+	if err := VerifyProof(vk, proof, []byte(`["43", "7"]`)); !errors.Is(err, ErrPairingCheckFailed) {
+		t.Fatalf("expected pairing check failure, got %v", err)
+	}
+
+	var countErr ErrPublicSignalsCount
+	if err := VerifyProof(vk, proof, []byte(`["42"]`)); !errors.As(err, &countErr) {
+		t.Fatalf("expected public signals count error, got %v", err)
+	}
+	if countErr.Expected != 2 || countErr.Got != 1 {
+		t.Fatalf("unexpected count error %+v", countErr)
+	}
+
+	for name, tc := range map[string]struct{ proof, public []byte }{
+		"not json":       {[]byte("proof"), public},
+		"missing pi_b":   {[]byte(`{"pi_a":["1","2","1"],"pi_c":["1","2","1"]}`), public},
+		"off curve":      {[]byte(`{"pi_a":["1","3","1"],"pi_b":[["0","0"],["0","0"],["0","0"]],"pi_c":["1","2","1"]}`), public},
+		"bad signal":     {proof, []byte(`["x", "7"]`)},
+		"signal too big": {proof, []byte(`["` + bn256.Order.String() + `", "7"]`)},
+	} {
+		if err := VerifyProof(vk, tc.proof, tc.public); !errors.Is(err, ErrMalformedProof) {
+			t.Errorf("%s: expected malformed proof error, got %v", name, err)
+		}
+	}
+
+	if err := VerifyProof(nil, proof, public); err == nil {
+		t.Fatal("expected error without verifying key")
+	}
+}
+
+func TestParseVerifyingKeyRejectsOtherProtocols(t *testing.T) {
+	if _, err := ParseVerifyingKey([]byte(`{"protocol":"plonk","curve":"bn128"}`)); err == nil {
+		t.Fatal("expected error for plonk key")
+	}
+	if _, err := ParseVerifyingKey([]byte(`{"protocol":"groth16","curve":"bls12381"}`)); err == nil {
+		t.Fatal("expected error for bls12-381 key")
+	}
+}
@@ -0,0 +1,159 @@
+package zkprover
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+)
+
+// BatchRequest is a batch of committed transactions to be proven.
+type BatchRequest struct {
+	Height int64    `json:"height"`
+	Index  int      `json:"index"`
+	Txs    [][]byte `json:"txs"`
+}
+
+// TxsHash returns the SHA-256 hash over the SHA-256 hashes of the batch's
+// transactions, in order.
+func (r BatchRequest) TxsHash() []byte {
+	h := sha256.New()
+	for _, tx := range r.Txs {
+		txHash := sha256.Sum256(tx)
+		h.Write(txHash[:])
+	}
+	return h.Sum(nil)
+}
+
+// Prover produces a proof for a batch of transactions. Implementations must
+// return promptly once ctx is done.
+type Prover interface {
+	ProveBatch(ctx context.Context, req BatchRequest) (*ZKBatch, error)
+}
+
+// SplitBatches splits txs into consecutive batches of at most maxSize
+// transactions. It returns nil if there are no transactions.
+func SplitBatches(txs [][]byte, maxSize int) ([][][]byte, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("batch size must be positive, got %d", maxSize)
+	}
+	var batches [][][]byte
+	for start := 0; start < len(txs); start += maxSize {
+		end := start + maxSize
+		if end > len(txs) {
+			end = len(txs)
+		}
+		batches = append(batches, txs[start:end])
+	}
+	return batches, nil
+}
+
+//-----------------------------------------------------------------------------
+
+// MockProver is an in-process Prover that produces deterministic, non-sound
+// proofs. It is meant for development networks and tests.
+type MockProver struct{}
+
+var _ Prover = MockProver{}
+
+// NewMockProver returns a new MockProver.
+func NewMockProver() MockProver {
+	return MockProver{}
+}
+
+// ProveBatch implements Prover. The public signals are, as decimal strings in
+// the snarkjs format, the batch's transaction hash reduced into the BN254
+// scalar field, the block height and the number of transactions. The proof is
+// not a Groth16 proof but the SHA-256 digest of the public signals, so it is
+// rejected by VerifyProof.
+func (MockProver) ProveBatch(ctx context.Context, req BatchRequest) (*ZKBatch, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	txsHash := req.TxsHash()
+	signal := new(big.Int).Mod(new(big.Int).SetBytes(txsHash), bn254ScalarField)
+	publicSignals, err := json.Marshal([]string{
+		signal.String(),
+		strconv.FormatInt(req.Height, 10),
+		strconv.Itoa(len(req.Txs)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proof := sha256.Sum256(append([]byte("mock-proof"), publicSignals...))
+	return &ZKBatch{
+		Height:        req.Height,
+		Index:         req.Index,
+		TxsHash:       txsHash,
+		Proof:         proof[:],
+		PublicSignals: publicSignals,
+	}, nil
+}
+
+// bn254ScalarField is the order of the BN254 scalar field that circom circuits
+// operate over.
+var bn254ScalarField, _ = new(big.Int).SetString(
+	"21888242871839275222246405745257275088548364400416034343698204186575808495617", 10)
+
+//-----------------------------------------------------------------------------
+
+// HTTPProver submits batches as JSON to a remote proving service. The service
+// must reply with a JSON-encoded ZKBatch.
+type HTTPProver struct {
+	url    string
+	client *http.Client
+}
+
+var _ Prover = (*HTTPProver)(nil)
+
+// NewHTTPProver returns a Prover that POSTs batches to url.
+func NewHTTPProver(url string, client *http.Client) *HTTPProver {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPProver{url: url, client: client}
+}
+
+// ProveBatch implements Prover.
+func (p *HTTPProver) ProveBatch(ctx context.Context, req BatchRequest) (*ZKBatch, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode batch: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("prover request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("prover returned %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+
+	var batch ZKBatch
+	if err := json.NewDecoder(resp.Body).Decode(&batch); err != nil {
+		return nil, fmt.Errorf("failed to decode prover response: %w", err)
+	}
+	if len(batch.Proof) == 0 {
+		return nil, errors.New("prover returned an empty proof")
+	}
+	batch.Height = req.Height
+	batch.Index = req.Index
+	batch.TxsHash = req.TxsHash()
+	return &batch, nil
+}
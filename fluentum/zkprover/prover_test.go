@@ -0,0 +1,116 @@
+package zkprover
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSplitBatches(t *testing.T) {
+	txs := [][]byte{{1}, {2}, {3}, {4}, {5}}
+
+	batches, err := SplitBatches(txs, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 3 {
+		t.Fatalf("expected 3 batches, got %d", len(batches))
+	}
+	if len(batches[2]) != 1 || !bytes.Equal(batches[2][0], []byte{5}) {
+		t.Fatalf("unexpected last batch %v", batches[2])
+	}
+
+	batches, err = SplitBatches(nil, 2)
+	if err != nil || batches != nil {
+		t.Fatalf("expected no batches, got %v, %v", batches, err)
+	}
+
+	if _, err := SplitBatches(txs, 0); err == nil {
+		t.Fatal("expected error for non-positive batch size")
+	}
+}
+
+func TestMockProverDeterministic(t *testing.T) {
+	req := BatchRequest{Height: 7, Index: 1, Txs: [][]byte{[]byte("a"), []byte("b")}}
+
+	b1, err := NewMockProver().ProveBatch(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2, err := NewMockProver().ProveBatch(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b1.Proof, b2.Proof) || !bytes.Equal(b1.PublicSignals, b2.PublicSignals) {
+		t.Fatal("mock prover is not deterministic")
+	}
+	if b1.Height != 7 || b1.Index != 1 || !bytes.Equal(b1.TxsHash, req.TxsHash()) {
+		t.Fatalf("unexpected batch metadata %+v", b1)
+	}
+
+	var signals []string
+	if err := json.Unmarshal(b1.PublicSignals, &signals); err != nil {
+		t.Fatal(err)
+	}
+	if len(signals) != 3 || signals[1] != "7" || signals[2] != "2" {
+		t.Fatalf("unexpected public signals %v", signals)
+	}
+
+	// the proof is a placeholder, which the rollup circuit doesn't accept
+	vk, err := ParseVerifyingKey(newTestCircuit(t, 3).verifyingKeyJSON(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewZKRollup(vk).VerifyBatch(*b1); err == nil {
+		t.Fatal("expected mock proof to be rejected")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewMockProver().ProveBatch(ctx, req); err == nil {
+		t.Fatal("expected error for cancelled context")
+	}
+}
+
+func TestHTTPProver(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req BatchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		batch, _ := NewMockProver().ProveBatch(r.Context(), req)
+		_ = json.NewEncoder(w).Encode(batch)
+	}))
+	defer srv.Close()
+
+	req := BatchRequest{Height: 3, Txs: [][]byte{[]byte("tx")}}
+	got, err := NewHTTPProver(srv.URL, nil).ProveBatch(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := NewMockProver().ProveBatch(context.Background(), req)
+	if !bytes.Equal(got.Proof, want.Proof) {
+		t.Fatal("proof mismatch")
+	}
+}
+
+func TestHTTPProverTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := NewHTTPProver(srv.URL, nil).ProveBatch(ctx, BatchRequest{}); err == nil {
+		t.Fatal("expected timeout error")
+	}
+}
@@ -1,24 +1,45 @@
 package zkprover
 
+import "errors"
+
 // ZKBatch represents a zero-knowledge proof batch
 type ZKBatch struct {
+	// Height is the height of the block whose transactions were proven.
+	Height int64 `json:"height"`
+	// Index is the position of the batch among the block's batches.
+	Index int `json:"index"`
+	// TxsHash is the SHA-256 hash over the batch's transaction hashes.
+	TxsHash []byte `json:"txs_hash"`
+
+	// Proof is a Groth16 proof and PublicSignals its public signals, both in
+	// the snarkjs JSON format. Batches of MockProver carry the same public
+	// signals, but a placeholder proof.
 	Proof         []byte `json:"proof"`
 	PublicSignals []byte `json:"public_signals"`
 }
 
-// ZKRollup represents a zero-knowledge rollup implementation
+// ZKRollup verifies rollup batches against the verifying key of the rollup
+// circuit.
 type ZKRollup struct {
-	// TODO: Implement ZK rollup functionality
+	vk *VerifyingKey
+}
+
+// NewZKRollup creates a new ZK rollup instance that verifies batches with vk.
+func NewZKRollup(vk *VerifyingKey) *ZKRollup {
+	return &ZKRollup{vk: vk}
 }
 
-// NewZKRollup creates a new ZK rollup instance
-func NewZKRollup() *ZKRollup {
-	return &ZKRollup{}
+// VerifyBatch verifies the proof carried by the batch. See VerifyProof.
+func (r *ZKRollup) VerifyBatch(batch ZKBatch) error {
+	return VerifyProof(r.vk, batch.Proof, batch.PublicSignals)
 }
 
-// VerifyProof verifies a zero-knowledge proof
-func VerifyProof(proof []byte, publicSignals []byte) bool {
-	// TODO: Implement actual ZK proof verification
-	// For now, return true as a placeholder
-	return true
+// VerifyProof verifies a snarkjs-encoded Groth16 proof and its public signals
+// against vk. It returns nil if the proof is valid; see VerifyingKey.Verify for
+// the errors returned otherwise.
+func VerifyProof(vk *VerifyingKey, proof []byte, publicSignals []byte) error {
+	if vk == nil {
+		return errors.New("no verifying key")
+	}
+	return vk.Verify(proof, publicSignals)
 }
@@ -21,6 +21,8 @@ import (
 	bcv2 "github.com/fluentum-chain/fluentum/blockchain/v2"
 	cfg "github.com/fluentum-chain/fluentum/config"
 	cs "github.com/fluentum-chain/fluentum/consensus"
+	"github.com/fluentum-chain/fluentum/core/plugin"
+	"github.com/fluentum-chain/fluentum/core/validator"
 	"github.com/fluentum-chain/fluentum/crypto"
 	"github.com/fluentum-chain/fluentum/evidence"
 
@@ -35,6 +37,7 @@ import (
 	"github.com/fluentum-chain/fluentum/p2p"
 	"github.com/fluentum-chain/fluentum/p2p/pex"
 	"github.com/fluentum-chain/fluentum/privval"
+	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
 	"github.com/fluentum-chain/fluentum/proxy"
 	rpccore "github.com/fluentum-chain/fluentum/rpc/core"
 	grpccore "github.com/fluentum-chain/fluentum/rpc/grpc"
@@ -204,6 +207,14 @@ func StateProvider(stateProvider statesync.StateProvider) Option {
 	}
 }
 
+// AIValidator sets the AI validator ordering the blocks proposed by the node,
+// so its metrics are exported along with the node ones.
+func AIValidator(v *validator.AIValidator) Option {
+	return func(n *Node) {
+		n.aiValidator = v
+	}
+}
+
 //------------------------------------------------------------------------------
 
 // Node is the highest level interface to a full Tendermint node.
@@ -245,6 +256,8 @@ type Node struct {
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
 	prometheusSrv     *http.Server
+
+	aiValidator *validator.AIValidator
 }
 
 func initDBs(config *cfg.Config, dbProvider DBProvider) (blockStore *store.BlockStore, stateDB dbm.DB, err error) {
@@ -779,12 +792,23 @@ func NewNode(config *cfg.Config,
 	// external signing process.
 	if config.PrivValidatorListenAddr != "" {
 		// FIXME: we should start services inside OnStart
-		privValidator, err = createAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, genDoc.ChainID, logger)
+		privValidator, err = createAndStartPrivValidatorSocketClient(
+			config.PrivValidatorListenAddr,
+			genDoc.ChainID,
+			validatorKeyTypes(stateStore, state.ConsensusParams.Validator),
+			logger,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("error with private validator socket client: %w", err)
 		}
 	}
 
+	// Consensus signs through the instrumented validator, whose stats are
+	// served by the quantum RPC endpoints.
+	privValidator, err = privval.NewInstrumentedPV(privValidator)
+	if err != nil {
+		return nil, err
+	}
 	pubKey, err := privValidator.GetPubKey()
 	if err != nil {
 		return nil, fmt.Errorf("can't get pubkey: %w", err)
@@ -982,6 +1006,10 @@ func NewNode(config *cfg.Config,
 		option(node)
 	}
 
+	if config.Instrumentation.Prometheus {
+		node.instrumentFluentum(genDoc.ChainID)
+	}
+
 	return node, nil
 }
 
@@ -1114,7 +1142,7 @@ func (n *Node) ConfigureRPC() error {
 	if err != nil {
 		return fmt.Errorf("can't get pubkey: %w", err)
 	}
-	rpccore.SetEnvironment(&rpccore.Environment{
+	env := &rpccore.Environment{
 		ProxyAppQuery:   n.proxyApp.Query(),
 		ProxyAppMempool: n.proxyApp.Mempool(),
 
@@ -1135,8 +1163,15 @@ func (n *Node) ConfigureRPC() error {
 
 		Logger: n.Logger.With("module", "rpc"),
 
-		Config: *n.config.RPC,
-	})
+		Config:        *n.config.RPC,
+		QuantumConfig: n.config.Quantum,
+	}
+	// assign the signer stats only if the node signs through an
+	// InstrumentedPV, so that the interface is nil otherwise
+	if pv, ok := n.privValidator.(*privval.InstrumentedPV); ok {
+		env.SignerStats = pv
+	}
+	rpccore.SetEnvironment(env)
 	if err := rpccore.InitGenesisChunks(); err != nil {
 		return err
 	}
@@ -1262,6 +1297,18 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	return listeners, nil
 }
 
+// instrumentFluentum exports the metrics of the validator signer and of the
+// optional AI validator of the node with the Prometheus metrics.
+func (n *Node) instrumentFluentum(chainID string) {
+	namespace := n.config.Instrumentation.Namespace
+	if pv, ok := n.privValidator.(*privval.InstrumentedPV); ok {
+		pv.SetMetrics(plugin.PrometheusMetrics(namespace, "chain_id", chainID))
+	}
+	if n.aiValidator != nil {
+		n.aiValidator.SetMetrics(validator.PrometheusMetrics(namespace, "chain_id", chainID))
+	}
+}
+
 // startPrometheusServer starts a Prometheus HTTP server, listening for metrics
 // collectors on addr.
 func (n *Node) startPrometheusServer(addr string) *http.Server {
@@ -1407,7 +1454,8 @@ func makeNodeInfo(
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
 		},
-		Moniker: config.Moniker,
+		Moniker:           config.Moniker,
+		HybridKeyExchange: config.P2P.HybridKeyExchange,
 		Other: p2p.DefaultNodeInfoOther{
 			TxIndex:    txIndexerStatus,
 			RPCAddress: config.RPC.ListenAddress,
@@ -1494,9 +1542,26 @@ func saveGenesisDoc(db dbm.DB, genDoc *types.GenesisDoc) error {
 	return nil
 }
 
+// validatorKeyTypes returns a function returning the key types a validator
+// key can have in the latest saved state: the PubKeyTypes of its consensus
+// params, and the NextPubKeyTypes of a scheduled key type switch, so that the
+// remote signer can move to the new key type ahead of the switch. The params
+// are those of the state the node started with until a state is saved.
+func validatorKeyTypes(stateStore sm.Store, params tmproto.ValidatorParams) func() []string {
+	return func() []string {
+		current := params
+		if state, err := stateStore.Load(); err == nil && !state.IsEmpty() {
+			current = state.ConsensusParams.Validator
+		}
+		keyTypes := append([]string{}, current.PubKeyTypes...)
+		return append(keyTypes, current.NextPubKeyTypes...)
+	}
+}
+
 func createAndStartPrivValidatorSocketClient(
 	listenAddr,
 	chainID string,
+	keyTypes func() []string,
 	logger log.Logger,
 ) (types.PrivValidator, error) {
 	pve, err := privval.NewSignerListener(listenAddr, logger)
@@ -1504,7 +1569,7 @@ func createAndStartPrivValidatorSocketClient(
 		return nil, fmt.Errorf("failed to start private validator: %w", err)
 	}
 
-	pvsc, err := privval.NewSignerClient(pve, chainID)
+	pvsc, err := privval.NewSignerClient(pve, chainID, privval.SignerClientKeyTypesFunc(keyTypes))
 	if err != nil {
 		return nil, fmt.Errorf("failed to start private validator: %w", err)
 	}
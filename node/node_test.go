@@ -27,6 +27,7 @@ import (
 	"github.com/fluentum-chain/fluentum/p2p/conn"
 	p2pmock "github.com/fluentum-chain/fluentum/p2p/mock"
 	"github.com/fluentum-chain/fluentum/privval"
+	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
 	"github.com/fluentum-chain/fluentum/proxy"
 	sm "github.com/fluentum-chain/fluentum/state"
 	"github.com/fluentum-chain/fluentum/store"
@@ -164,7 +165,8 @@ func TestNodeSetPrivValTCP(t *testing.T) {
 
 	n, err := DefaultNewNode(config, log.TestingLogger())
 	require.NoError(t, err)
-	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
+	require.IsType(t, &privval.InstrumentedPV{}, n.PrivValidator())
+	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator().(*privval.InstrumentedPV).Unwrap())
 }
 
 // address without a protocol must result in error
@@ -208,7 +210,26 @@ func TestNodeSetPrivValIPC(t *testing.T) {
 
 	n, err := DefaultNewNode(config, log.TestingLogger())
 	require.NoError(t, err)
-	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
+	require.IsType(t, &privval.InstrumentedPV{}, n.PrivValidator())
+	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator().(*privval.InstrumentedPV).Unwrap())
+}
+
+func TestValidatorKeyTypes(t *testing.T) {
+	s, stateDB, _ := state(1, 1)
+	params := s.ConsensusParams.Validator
+	keyTypes := validatorKeyTypes(sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{}), params)
+	assert.Equal(t, params.PubKeyTypes, keyTypes())
+
+	// a key type switch saved after startup is followed
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	keyTypes = validatorKeyTypes(stateStore, params)
+	s.ConsensusParams.Validator = tmproto.ValidatorParams{
+		PubKeyTypes:     []string{types.ABCIPubKeyTypeEd25519},
+		NextPubKeyTypes: []string{types.ABCIPubKeyTypeHybrid},
+		SwitchHeight:    10,
+	}
+	require.NoError(t, stateStore.Save(s))
+	assert.Equal(t, []string{types.ABCIPubKeyTypeEd25519, types.ABCIPubKeyTypeHybrid}, keyTypes())
 }
 
 // testFreeAddr claims a free port so we don't block on listener being ready.
@@ -45,10 +45,16 @@ var (
 
 	labelEphemeralLowerPublicKey = []byte("EPHEMERAL_LOWER_PUBLIC_KEY")
 	labelEphemeralUpperPublicKey = []byte("EPHEMERAL_UPPER_PUBLIC_KEY")
+	labelKEMLowerPublicKey       = []byte("KEM_LOWER_PUBLIC_KEY")
+	labelKEMUpperPublicKey       = []byte("KEM_UPPER_PUBLIC_KEY")
 	labelDHSecret                = []byte("DH_SECRET")
+	labelKEMLowerCiphertext      = []byte("KEM_LOWER_CIPHERTEXT")
+	labelKEMUpperCiphertext      = []byte("KEM_UPPER_CIPHERTEXT")
+	labelKEMSecret               = []byte("KEM_SECRET")
 	labelSecretConnectionMac     = []byte("SECRET_CONNECTION_MAC")
 
 	secretConnKeyAndChallengeGen = []byte("TENDERMINT_SECRET_CONNECTION_KEY_AND_CHALLENGE_GEN")
+	secretConnHybridSecretGen    = []byte("TENDERMINT_SECRET_CONNECTION_HYBRID_SECRET_GEN")
 )
 
 // Custom AuthSigMessage struct to match the protobuf definition
@@ -98,6 +104,22 @@ type SecretConnection struct {
 
 	sendMtx   tmsync.Mutex
 	sendNonce *[aeadNonceSize]byte
+
+	// offerHybrid is set if the handshake offers a hybrid key exchange, and
+	// hybrid if the remote peer accepted it.
+	offerHybrid bool
+	hybrid      bool
+}
+
+// SecretConnectionOption sets an optional parameter on the SecretConnection.
+type SecretConnectionOption func(*SecretConnection)
+
+// SecretConnectionHybridKeyExchange makes the handshake offer a hybrid
+// X25519+ML-KEM-768 key exchange, which keeps the session keys secret as long
+// as either X25519 or ML-KEM is unbroken. Peers which do not offer it too fall
+// back to X25519 alone.
+func SecretConnectionHybridKeyExchange() SecretConnectionOption {
+	return func(sc *SecretConnection) { sc.offerHybrid = true }
 }
 
 // MakeSecretConnection performs handshake and returns a new authenticated
@@ -105,21 +127,47 @@ type SecretConnection struct {
 // Returns nil if there is an error in handshake.
 // Caller should call conn.Close()
 // See docs/sts-final.pdf for more information.
-func MakeSecretConnection(conn io.ReadWriteCloser, locPrivKey crypto.PrivKey) (*SecretConnection, error) {
+func MakeSecretConnection(
+	conn io.ReadWriteCloser,
+	locPrivKey crypto.PrivKey,
+	options ...SecretConnectionOption,
+) (*SecretConnection, error) {
 	var (
 		locPubKey = locPrivKey.PubKey()
 	)
 
+	sc := &SecretConnection{
+		conn:       conn,
+		recvBuffer: nil,
+		recvNonce:  new([aeadNonceSize]byte),
+		sendNonce:  new([aeadNonceSize]byte),
+	}
+	for _, option := range options {
+		option(sc)
+	}
+
 	// Generate ephemeral keys for perfect forward secrecy.
 	locEphPub, locEphPriv := genEphKeys()
 
+	// To offer a hybrid key exchange, also generate an ephemeral ML-KEM key
+	// pair, whose public key is sent along with the X25519 one.
+	var locKEMPub, locKEMPriv []byte
+	if sc.offerHybrid {
+		var err error
+		locKEMPub, locKEMPriv, err = crypto.GenerateKeyPair()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Write local ephemeral pubkey and receive one too.
 	// NOTE: every 32-byte string is accepted as a Curve25519 public key (see
 	// DJB's Curve25519 paper: http://cr.yp.to/ecdh/curve25519-20060209.pdf)
-	remEphPub, err := shareEphPubKey(conn, locEphPub)
+	remEphPub, remKEMPub, err := shareEphPubKey(conn, locEphPub, locKEMPub)
 	if err != nil {
 		return nil, err
 	}
+	sc.hybrid = locKEMPub != nil && remKEMPub != nil
 
 	// Sort by lexical order.
 	loEphPub, hiEphPub := sort32(locEphPub, remEphPub)
@@ -133,6 +181,12 @@ func MakeSecretConnection(conn io.ReadWriteCloser, locPrivKey crypto.PrivKey) (*
 	// sorted.
 	locIsLeast := bytes.Equal(locEphPub[:], loEphPub[:])
 
+	if sc.hybrid {
+		loKEMPub, hiKEMPub := sortByLeast(locKEMPub, remKEMPub, locIsLeast)
+		transcript.AppendMessage(labelKEMLowerPublicKey, loKEMPub)
+		transcript.AppendMessage(labelKEMUpperPublicKey, hiKEMPub)
+	}
+
 	// Compute common diffie hellman secret using X25519.
 	dhSecret, err := computeDHSecret(remEphPub, locEphPriv)
 	if err != nil {
@@ -141,10 +195,21 @@ func MakeSecretConnection(conn io.ReadWriteCloser, locPrivKey crypto.PrivKey) (*
 
 	transcript.AppendMessage(labelDHSecret, dhSecret[:])
 
+	secret := dhSecret
+	if sc.hybrid {
+		// Each peer encapsulates a secret to the ephemeral ML-KEM key of the
+		// other, and both secrets are mixed with the DH secret.
+		kemSecret, err := shareKEMSecret(conn, transcript, locIsLeast, locKEMPriv, remKEMPub)
+		if err != nil {
+			return nil, err
+		}
+		secret = combineSecrets(dhSecret, kemSecret)
+	}
+
 	// Generate the secret used for receiving, sending, challenge via HKDF-SHA2
 	// on the transcript state (which itself also uses HKDF-SHA2 to derive a key
-	// from the dhSecret).
-	recvSecret, sendSecret := deriveSecrets(dhSecret, locIsLeast)
+	// from the dhSecret, combined with the KEM secret in a hybrid exchange).
+	recvSecret, sendSecret := deriveSecrets(secret, locIsLeast)
 
 	const challengeSize = 32
 	var challenge [challengeSize]byte
@@ -161,14 +226,8 @@ func MakeSecretConnection(conn io.ReadWriteCloser, locPrivKey crypto.PrivKey) (*
 		return nil, errors.New("invalid receive SecretConnection Key")
 	}
 
-	sc := &SecretConnection{
-		conn:       conn,
-		recvBuffer: nil,
-		recvNonce:  new([aeadNonceSize]byte),
-		sendNonce:  new([aeadNonceSize]byte),
-		recvAead:   recvAead,
-		sendAead:   sendAead,
-	}
+	sc.recvAead = recvAead
+	sc.sendAead = sendAead
 
 	// Sign the challenge bytes for authentication.
 	locSignature, err := signChallenge(&challenge, locPrivKey)
@@ -200,6 +259,12 @@ func (sc *SecretConnection) RemotePubKey() crypto.PubKey {
 	return sc.remPubKey
 }
 
+// IsHybrid returns true if the session keys were derived from a hybrid
+// X25519+ML-KEM-768 key exchange.
+func (sc *SecretConnection) IsHybrid() bool {
+	return sc.hybrid
+}
+
 // Writes encrypted frames of `totalFrameSize + aeadSizeOverhead`.
 // CONTRACT: data smaller than dataMaxSize is written atomically.
 func (sc *SecretConnection) Write(data []byte) (n int, err error) {
@@ -314,13 +379,22 @@ func genEphKeys() (ephPub, ephPriv *[32]byte) {
 	return
 }
 
-func shareEphPubKey(conn io.ReadWriter, locEphPub *[32]byte) (remEphPub *[32]byte, err error) {
+// shareEphPubKey sends the local ephemeral X25519 pubkey, followed by the
+// ephemeral ML-KEM pubkey if one is offered, and receives the remote ones.
+// remKEMPub is nil if the remote peer does not offer a hybrid key exchange.
+// Peers which predate it only read the first 32 bytes of the message.
+func shareEphPubKey(
+	conn io.ReadWriter,
+	locEphPub *[32]byte,
+	locKEMPub []byte,
+) (remEphPub *[32]byte, remKEMPub []byte, err error) {
 
 	// Send our pubkey and receive theirs in tandem.
 	var trs, _ = async.Parallel(
 		func(_ int) (val interface{}, abort bool, err error) {
 			lc := *locEphPub
-			_, err = protoio.NewDelimitedWriter(conn).WriteMsg(&gogotypes.BytesValue{Value: lc[:]})
+			_, err = protoio.NewDelimitedWriter(conn).WriteMsg(
+				&gogotypes.BytesValue{Value: append(lc[:], locKEMPub...)})
 			if err != nil {
 				return nil, true, err // abort
 			}
@@ -335,6 +409,9 @@ func shareEphPubKey(conn io.ReadWriter, locEphPub *[32]byte) (remEphPub *[32]byt
 
 			var _remEphPub [32]byte
 			copy(_remEphPub[:], bytes.Value)
+			if len(bytes.Value) == len(_remEphPub)+crypto.KEMPublicKeySize {
+				remKEMPub = bytes.Value[len(_remEphPub):]
+			}
 			return _remEphPub, false, nil
 		},
 	)
@@ -347,7 +424,67 @@ func shareEphPubKey(conn io.ReadWriter, locEphPub *[32]byte) (remEphPub *[32]byt
 
 	// Otherwise:
 	var _remEphPub = trs.FirstValue().([32]byte)
-	return &_remEphPub, nil
+	return &_remEphPub, remKEMPub, nil
+}
+
+// shareKEMSecret encapsulates a secret to the remote ephemeral ML-KEM pubkey,
+// sends the ciphertext, and decapsulates the secret the remote peer
+// encapsulated to ours. Both ciphertexts and secrets are added to the
+// transcript, and it returns the secrets concatenated, the one encapsulated to
+// the peer with the least ephemeral key first.
+func shareKEMSecret(
+	conn io.ReadWriter,
+	transcript *merlin.Transcript,
+	locIsLeast bool,
+	locKEMPriv []byte,
+	remKEMPub []byte,
+) ([]byte, error) {
+	remSecret, remCiphertext, err := crypto.Encapsulate(remKEMPub)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send our ciphertext and receive theirs in tandem.
+	var trs, _ = async.Parallel(
+		func(_ int) (val interface{}, abort bool, err error) {
+			_, err = protoio.NewDelimitedWriter(conn).WriteMsg(&gogotypes.BytesValue{Value: remCiphertext})
+			if err != nil {
+				return nil, true, err // abort
+			}
+			return nil, false, nil
+		},
+		func(_ int) (val interface{}, abort bool, err error) {
+			var bytes gogotypes.BytesValue
+			_, err = protoio.NewDelimitedReader(conn, 1024*1024).ReadMsg(&bytes)
+			if err != nil {
+				return nil, true, err // abort
+			}
+			return bytes.Value, false, nil
+		},
+	)
+	if trs.FirstError() != nil {
+		return nil, trs.FirstError()
+	}
+
+	locCiphertext := trs.FirstValue().([]byte)
+	if len(locCiphertext) != crypto.KEMCiphertextSize {
+		return nil, fmt.Errorf("expected KEM ciphertext of size %d, got %d",
+			crypto.KEMCiphertextSize, len(locCiphertext))
+	}
+	locSecret, err := crypto.Decapsulate(locKEMPriv, locCiphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	loCiphertext, hiCiphertext := sortByLeast(locCiphertext, remCiphertext, locIsLeast)
+	transcript.AppendMessage(labelKEMLowerCiphertext, loCiphertext)
+	transcript.AppendMessage(labelKEMUpperCiphertext, hiCiphertext)
+
+	loSecret, hiSecret := sortByLeast(locSecret, remSecret, locIsLeast)
+	kemSecret := append(append([]byte{}, loSecret...), hiSecret...)
+	transcript.AppendMessage(labelKEMSecret, kemSecret)
+
+	return kemSecret, nil
 }
 
 func deriveSecrets(
@@ -393,6 +530,27 @@ func computeDHSecret(remPubKey, locPrivKey *[32]byte) (*[32]byte, error) {
 	return &shrKeyArray, nil
 }
 
+// combineSecrets derives the secret of a hybrid key exchange from the X25519
+// and the ML-KEM secrets via HKDF-SHA2.
+func combineSecrets(dhSecret *[32]byte, kemSecret []byte) *[32]byte {
+	ikm := append(append([]byte{}, dhSecret[:]...), kemSecret...)
+	hkdf := hkdf.New(sha256.New, ikm, nil, secretConnHybridSecretGen)
+	secret := new([32]byte)
+	if _, err := io.ReadFull(hkdf, secret[:]); err != nil {
+		panic(err)
+	}
+	return secret
+}
+
+// sortByLeast returns the values of the peers with the least and the upper
+// ephemeral key, in that order.
+func sortByLeast(loc, rem []byte, locIsLeast bool) (lo, hi []byte) {
+	if locIsLeast {
+		return loc, rem
+	}
+	return rem, loc
+}
+
 func sort32(foo, bar *[32]byte) (lo, hi *[32]byte) {
 	if bytes.Compare(foo[:], bar[:]) < 0 {
 		lo = foo
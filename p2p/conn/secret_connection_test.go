@@ -63,6 +63,44 @@ func TestSecretConnectionHandshake(t *testing.T) {
 	}
 }
 
+func TestSecretConnectionHybridHandshake(t *testing.T) {
+	hybrid := []SecretConnectionOption{SecretConnectionHybridKeyExchange()}
+
+	testCases := []struct {
+		name       string
+		fooOptions []SecretConnectionOption
+		barOptions []SecretConnectionOption
+		wantHybrid bool
+	}{
+		{"both hybrid", hybrid, hybrid, true},
+		{"only foo hybrid", hybrid, nil, false},
+		{"only bar hybrid", nil, hybrid, false},
+		{"neither hybrid", nil, nil, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fooSecConn, barSecConn := makeSecretConnPairWithOptions(t, tc.fooOptions, tc.barOptions)
+			defer fooSecConn.Close()
+			defer barSecConn.Close()
+
+			assert.Equal(t, tc.wantHybrid, fooSecConn.IsHybrid())
+			assert.Equal(t, tc.wantHybrid, barSecConn.IsHybrid())
+
+			msg := []byte("hello")
+			go func() {
+				_, err := fooSecConn.Write(msg)
+				assert.NoError(t, err)
+			}()
+			buf := make([]byte, len(msg))
+			_, err := io.ReadFull(barSecConn, buf)
+			require.NoError(t, err)
+			assert.Equal(t, msg, buf)
+		})
+	}
+}
+
 func TestConcurrentWrite(t *testing.T) {
 	fooSecConn, barSecConn := makeSecretConnPair(t)
 	fooWriteText := tmrand.Str(dataMaxSize)
@@ -333,6 +371,13 @@ func makeKVStoreConnPair() (fooConn, barConn kvstoreConn) {
 }
 
 func makeSecretConnPair(tb testing.TB) (fooSecConn, barSecConn *SecretConnection) {
+	return makeSecretConnPairWithOptions(tb, nil, nil)
+}
+
+func makeSecretConnPairWithOptions(
+	tb testing.TB,
+	fooOptions, barOptions []SecretConnectionOption,
+) (fooSecConn, barSecConn *SecretConnection) {
 	var (
 		fooConn, barConn = makeKVStoreConnPair()
 		fooPrvKey        = ed25519.GenPrivKey()
@@ -344,7 +389,7 @@ func makeSecretConnPair(tb testing.TB) (fooSecConn, barSecConn *SecretConnection
 	// Make connections from both sides in parallel.
 	var trs, ok = async.Parallel(
 		func(_ int) (val interface{}, abort bool, err error) {
-			fooSecConn, err = MakeSecretConnection(fooConn, fooPrvKey)
+			fooSecConn, err = MakeSecretConnection(fooConn, fooPrvKey, fooOptions...)
 			if err != nil {
 				tb.Errorf("failed to establish SecretConnection for foo: %v", err)
 				return nil, true, err
@@ -359,7 +404,7 @@ func makeSecretConnPair(tb testing.TB) (fooSecConn, barSecConn *SecretConnection
 			return nil, false, nil
 		},
 		func(_ int) (val interface{}, abort bool, err error) {
-			barSecConn, err = MakeSecretConnection(barConn, barPrvKey)
+			barSecConn, err = MakeSecretConnection(barConn, barPrvKey, barOptions...)
 			if barSecConn == nil {
 				tb.Errorf("failed to establish SecretConnection for bar: %v", err)
 				return nil, true, err
@@ -90,6 +90,11 @@ type DefaultNodeInfo struct {
 	Version  string           `json:"version"`  // major.minor.revision
 	Channels tmbytes.HexBytes `json:"channels"` // channels this node knows about
 
+	// Capabilities.
+	// Set if the node offers a hybrid X25519+ML-KEM key exchange in the
+	// SecretConnection handshake.
+	HybridKeyExchange bool `json:"hybrid_key_exchange"`
+
 	// ASCIIText fields
 	Moniker string               `json:"moniker"` // arbitrary moniker
 	Other   DefaultNodeInfoOther `json:"other"`   // other application specific data
@@ -242,6 +247,7 @@ func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 	dni.Version = info.Version
 	dni.Channels = info.Channels
 	dni.Moniker = info.Moniker
+	dni.HybridKeyExchange = info.HybridKeyExchange
 	dni.Other = tmp2p.DefaultNodeInfoOther{
 		TxIndex:    info.Other.TxIndex,
 		RPCAddress: info.Other.RPCAddress,
@@ -260,12 +266,13 @@ func DefaultNodeInfoFromToProto(pb *tmp2p.DefaultNodeInfo) (DefaultNodeInfo, err
 			Block: pb.ProtocolVersion.Block,
 			App:   pb.ProtocolVersion.App,
 		},
-		DefaultNodeID: ID(pb.DefaultNodeID),
-		ListenAddr:    pb.ListenAddr,
-		Network:       pb.Network,
-		Version:       pb.Version,
-		Channels:      pb.Channels,
-		Moniker:       pb.Moniker,
+		DefaultNodeID:     ID(pb.DefaultNodeID),
+		ListenAddr:        pb.ListenAddr,
+		Network:           pb.Network,
+		Version:           pb.Version,
+		Channels:          pb.Channels,
+		Moniker:           pb.Moniker,
+		HybridKeyExchange: pb.HybridKeyExchange,
 		Other: DefaultNodeInfoOther{
 			TxIndex:    pb.Other.TxIndex,
 			RPCAddress: pb.Other.RPCAddress,
@@ -80,20 +80,22 @@ func (tr *TestReactor) ReceiveEnvelope(e Envelope) {
 }
 
 func (tr *TestReactor) Receive(chID byte, peer Peer, msgBytes []byte) {
-	msg := &p2pproto.Message{}
+	var msg proto.Message = &p2pproto.Message{}
 	err := proto.Unmarshal(msgBytes, msg)
 	if err != nil {
 		panic(err)
 	}
-	um, err := msg.Unwrap()
-	if err != nil {
-		panic(err)
+	if w, ok := msg.(Unwrapper); ok {
+		msg, err = w.Unwrap()
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	tr.ReceiveEnvelope(Envelope{
 		ChannelID: chID,
 		Src:       peer,
-		Message:   um,
+		Message:   msg,
 	})
 }
 
@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"time"
@@ -412,7 +413,12 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}()
 
-	secretConn, err = upgradeSecretConn(c, mt.handshakeTimeout, mt.nodeKey.PrivKey)
+	var scOptions []conn.SecretConnectionOption
+	if offersHybridKeyExchange(mt.nodeInfo) {
+		scOptions = append(scOptions, conn.SecretConnectionHybridKeyExchange())
+	}
+
+	secretConn, err = upgradeSecretConn(c, mt.handshakeTimeout, mt.nodeKey.PrivKey, scOptions...)
 	if err != nil {
 		return nil, nil, ErrRejected{
 			conn:          c,
@@ -469,6 +475,20 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
+	// The key exchange is negotiated in the SecretConnection handshake, before
+	// NodeInfos are exchanged, so an attacker could strip the ML-KEM key from
+	// the handshake messages to downgrade it. Reject the connection if both
+	// nodes offer a hybrid key exchange but did not use it.
+	if offersHybridKeyExchange(mt.nodeInfo) && offersHybridKeyExchange(nodeInfo) &&
+		!secretConn.IsHybrid() {
+		return nil, nil, ErrRejected{
+			conn:          c,
+			id:            connID,
+			err:           errors.New("hybrid key exchange offered by both nodes but not used"),
+			isAuthFailure: true,
+		}
+	}
+
 	// Reject self.
 	if mt.nodeInfo.ID() == nodeInfo.ID() {
 		return nil, nil, ErrRejected{
@@ -578,12 +598,13 @@ func upgradeSecretConn(
 	c net.Conn,
 	timeout time.Duration,
 	privKey crypto.PrivKey,
+	options ...conn.SecretConnectionOption,
 ) (*conn.SecretConnection, error) {
 	if err := c.SetDeadline(time.Now().Add(timeout)); err != nil {
 		return nil, err
 	}
 
-	sc, err := conn.MakeSecretConnection(c, privKey)
+	sc, err := conn.MakeSecretConnection(c, privKey, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -591,6 +612,13 @@ func upgradeSecretConn(
 	return sc, sc.SetDeadline(time.Time{})
 }
 
+// offersHybridKeyExchange returns true if the NodeInfo advertises a hybrid
+// X25519+ML-KEM key exchange.
+func offersHybridKeyExchange(nodeInfo NodeInfo) bool {
+	ni, ok := nodeInfo.(DefaultNodeInfo)
+	return ok && ni.HybridKeyExchange
+}
+
 func resolveIPs(resolver IPResolver, c net.Conn) ([]net.IP, error) {
 	host, _, err := net.SplitHostPort(c.RemoteAddr().String())
 	if err != nil {
@@ -499,6 +499,73 @@ func TestTransportMultiplexRejectIncompatible(t *testing.T) {
 	}
 }
 
+func TestTransportMultiplexHybridKeyExchange(t *testing.T) {
+	mt := testSetupHybridMultiplexTransport(t)
+
+	errc := make(chan error)
+
+	go func() {
+		var (
+			pv       = ed25519.GenPrivKey()
+			nodeInfo = testNodeInfo(PubKeyToID(pv.PubKey()), "dialer").(DefaultNodeInfo)
+		)
+		nodeInfo.HybridKeyExchange = true
+		dialer := newMultiplexTransport(nodeInfo, NodeKey{PrivKey: pv})
+		addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
+
+		_, err := dialer.Dial(*addr, peerConfig{})
+		errc <- err
+	}()
+
+	p, err := mt.Accept(peerConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("connection failed: %v", err)
+	}
+
+	sc, ok := p.(*peer).peerConn.conn.(*conn.SecretConnection)
+	if !ok || !sc.IsHybrid() {
+		t.Errorf("expected a hybrid SecretConnection")
+	}
+}
+
+func TestTransportMultiplexRejectHybridDowngrade(t *testing.T) {
+	mt := testSetupHybridMultiplexTransport(t)
+
+	go func() {
+		var (
+			pv       = ed25519.GenPrivKey()
+			nodeInfo = testNodeInfo(PubKeyToID(pv.PubKey()), "dialer").(DefaultNodeInfo)
+		)
+		nodeInfo.HybridKeyExchange = true
+
+		c, err := net.Dial(mt.listener.Addr().Network(), mt.listener.Addr().String())
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		// Advertise a hybrid key exchange, but only use X25519.
+		sc, err := upgradeSecretConn(c, 200*time.Millisecond, pv)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		_, _ = handshake(sc, 200*time.Millisecond, nodeInfo)
+	}()
+
+	_, err := mt.Accept(peerConfig{})
+	if e, ok := err.(ErrRejected); ok {
+		if !e.IsAuthFailure() {
+			t.Errorf("expected auth failure, got %v", err)
+		}
+	} else {
+		t.Errorf("expected ErrRejected, got %v", err)
+	}
+}
+
 func TestTransportMultiplexRejectSelf(t *testing.T) {
 	mt := testSetupMultiplexTransport(t)
 
@@ -666,6 +733,30 @@ func testSetupMultiplexTransport(t *testing.T) *MultiplexTransport {
 	return mt
 }
 
+func testSetupHybridMultiplexTransport(t *testing.T) *MultiplexTransport {
+	var (
+		pv       = ed25519.GenPrivKey()
+		id       = PubKeyToID(pv.PubKey())
+		nodeInfo = testNodeInfo(id, "transport").(DefaultNodeInfo)
+	)
+	nodeInfo.HybridKeyExchange = true
+	mt := newMultiplexTransport(nodeInfo, NodeKey{PrivKey: pv})
+
+	addr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mt.Listen(*addr); err != nil {
+		t.Fatal(err)
+	}
+
+	// give the listener some time to get ready
+	time.Sleep(20 * time.Millisecond)
+
+	return mt
+}
+
 type testTransportAddr struct{}
 
 func (a *testTransportAddr) Network() string { return "tcp" }
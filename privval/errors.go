@@ -22,6 +22,10 @@ var (
 	ErrWriteTimeout       = errors.New("endpoint write timed out")
 )
 
+// ErrUnsupportedKeyType is returned for keys of a type that can't be generated,
+// or that the other end of a connection doesn't accept.
+var ErrUnsupportedKeyType = errors.New("unsupported key type")
+
 // RemoteSignerError allows (remote) validators to include meaningful error
 // descriptions in their reply.
 type RemoteSignerError struct {
@@ -10,7 +10,9 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
 	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
 	tmbytes "github.com/fluentum-chain/fluentum/libs/bytes"
 	tmjson "github.com/fluentum-chain/fluentum/libs/json"
 	tmos "github.com/fluentum-chain/fluentum/libs/os"
@@ -172,6 +174,49 @@ func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
 	return NewFilePV(ed25519.GenPrivKey(), keyFilePath, stateFilePath)
 }
 
+// Key types of the private keys GenPrivKey generates. Dilithium key types name
+// the mode of the key, and hybrid keys pair an ed25519 key with a dilithium key
+// of the dilithium.DefaultMode.
+const (
+	KeyTypeEd25519    = ed25519.KeyType
+	KeyTypeDilithium2 = "dilithium2"
+	KeyTypeDilithium3 = "dilithium3"
+	KeyTypeDilithium5 = "dilithium5"
+	KeyTypeHybrid     = hybrid.KeyType
+)
+
+// KeyTypes are the key types GenPrivKey generates.
+var KeyTypes = []string{KeyTypeEd25519, KeyTypeDilithium2, KeyTypeDilithium3, KeyTypeDilithium5, KeyTypeHybrid}
+
+// GenPrivKey generates a new private key of the given key type.
+func GenPrivKey(keyType string) (crypto.PrivKey, error) {
+	switch keyType {
+	case KeyTypeEd25519:
+		return ed25519.GenPrivKey(), nil
+	case KeyTypeDilithium2:
+		return dilithium.GenPrivKeyWithMode(dilithium.Mode2), nil
+	case KeyTypeDilithium3:
+		return dilithium.GenPrivKeyWithMode(dilithium.Mode3), nil
+	case KeyTypeDilithium5:
+		return dilithium.GenPrivKeyWithMode(dilithium.Mode5), nil
+	case KeyTypeHybrid:
+		return hybrid.GenPrivKey(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedKeyType, keyType)
+	}
+}
+
+// GenFilePVWithKeyType generates a new validator with a randomly generated
+// private key of the given key type and sets the filePaths, but does not call
+// Save().
+func GenFilePVWithKeyType(keyFilePath, stateFilePath, keyType string) (*FilePV, error) {
+	privKey, err := GenPrivKey(keyType)
+	if err != nil {
+		return nil, err
+	}
+	return NewFilePV(privKey, keyFilePath, stateFilePath), nil
+}
+
 // LoadFilePV loads a FilePV from the filePaths.  The FilePV handles double
 // signing prevention by persisting data to the stateFilePath.  If either file path
 // does not exist, the program will exit.
@@ -319,10 +364,10 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 	// Otherwise, return error
 	if sameHRS {
 		if bytes.Equal(signBytes, lss.SignBytes) {
-			vote.Signature = lss.Signature
+			vote.Signature, vote.QuantumSignature = types.SplitSignature(pv.Key.PubKey, lss.Signature)
 		} else if timestamp, ok := checkVotesOnlyDifferByTimestamp(lss.SignBytes, signBytes); ok {
 			vote.Timestamp = timestamp
-			vote.Signature = lss.Signature
+			vote.Signature, vote.QuantumSignature = types.SplitSignature(pv.Key.PubKey, lss.Signature)
 		} else {
 			err = fmt.Errorf("conflicting data")
 		}
@@ -335,7 +380,7 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 		return err
 	}
 	pv.saveSigned(height, round, step, signBytes, sig)
-	vote.Signature = sig
+	vote.Signature, vote.QuantumSignature = types.SplitSignature(pv.Key.PubKey, sig)
 	return nil
 }
 
@@ -361,10 +406,10 @@ func (pv *FilePV) signProposal(chainID string, proposal *tmproto.Proposal) error
 	// Otherwise, return error
 	if sameHRS {
 		if bytes.Equal(signBytes, lss.SignBytes) {
-			proposal.Signature = lss.Signature
+			proposal.Signature, proposal.QuantumSignature = types.SplitSignature(pv.Key.PubKey, lss.Signature)
 		} else if timestamp, ok := checkProposalsOnlyDifferByTimestamp(lss.SignBytes, signBytes); ok {
 			proposal.Timestamp = timestamp
-			proposal.Signature = lss.Signature
+			proposal.Signature, proposal.QuantumSignature = types.SplitSignature(pv.Key.PubKey, lss.Signature)
 		} else {
 			err = fmt.Errorf("conflicting data")
 		}
@@ -377,7 +422,7 @@ func (pv *FilePV) signProposal(chainID string, proposal *tmproto.Proposal) error
 		return err
 	}
 	pv.saveSigned(height, round, step, signBytes, sig)
-	proposal.Signature = sig
+	proposal.Signature, proposal.QuantumSignature = types.SplitSignature(pv.Key.PubKey, sig)
 	return nil
 }
 
@@ -39,6 +39,50 @@ func TestGenLoadValidator(t *testing.T) {
 	assert.Equal(height, privVal.LastSignState.Height, "expected privval.LastHeight to have been saved")
 }
 
+func TestGenLoadValidatorKeyTypes(t *testing.T) {
+	for _, keyType := range KeyTypes {
+		t.Run(keyType, func(t *testing.T) {
+			tempKeyFile, err := os.CreateTemp("", "priv_validator_key_")
+			require.Nil(t, err)
+			tempStateFile, err := os.CreateTemp("", "priv_validator_state_")
+			require.Nil(t, err)
+
+			privVal, err := GenFilePVWithKeyType(tempKeyFile.Name(), tempStateFile.Name(), keyType)
+			require.NoError(t, err)
+			privVal.Save()
+
+			loaded := LoadFilePV(tempKeyFile.Name(), tempStateFile.Name())
+			assert.True(t, privVal.Key.PrivKey.Equals(loaded.Key.PrivKey))
+			assert.True(t, privVal.Key.PubKey.Equals(loaded.Key.PubKey))
+			assert.Equal(t, privVal.GetAddress(), loaded.GetAddress())
+
+			// signatures made by the loaded key are valid for the saved key,
+			// with the quantum signature set for post-quantum keys
+			blockID := types.BlockID{
+				Hash:          tmrand.Bytes(tmhash.Size),
+				PartSetHeader: types.PartSetHeader{Total: 5, Hash: tmrand.Bytes(tmhash.Size)},
+			}
+			vote := newVote(loaded.Key.Address, 0, 10, 1, tmproto.PrecommitType, blockID)
+			v := vote.ToProto()
+			require.NoError(t, loaded.SignVote("mychainid", v))
+			vote.Signature, vote.QuantumSignature = v.Signature, v.QuantumSignature
+			require.NoError(t, vote.ValidateBasic())
+			assert.NoError(t, vote.Verify("mychainid", privVal.Key.PubKey))
+			assert.Equal(t, keyType != KeyTypeEd25519, len(vote.QuantumSignature) > 0)
+
+			// signing the same vote again returns the same signatures
+			again := vote.ToProto()
+			again.Signature, again.QuantumSignature = nil, nil
+			require.NoError(t, loaded.SignVote("mychainid", again))
+			assert.Equal(t, v.Signature, again.Signature)
+			assert.Equal(t, v.QuantumSignature, again.QuantumSignature)
+		})
+	}
+
+	_, err := GenFilePVWithKeyType("", "", "rsa")
+	assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+}
+
 func TestResetValidator(t *testing.T) {
 	tempKeyFile, err := os.CreateTemp("", "priv_validator_key_")
 	require.Nil(t, err)
@@ -0,0 +1,137 @@
+package privval
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fluentum-chain/fluentum/core/plugin"
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
+	tmsync "github.com/fluentum-chain/fluentum/libs/sync"
+	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
+	"github.com/fluentum-chain/fluentum/types"
+)
+
+// SignerStats are the counters of the votes and proposals signed by an
+// InstrumentedPV.
+type SignerStats struct {
+	// Algorithm of the validator key, see SigningAlgorithm.
+	Algorithm     string
+	SignCount     int64
+	ErrorCount    int64
+	TotalSignTime time.Duration
+	LastSignTime  time.Time
+}
+
+// AvgSignTime returns the average time to sign a vote or proposal.
+func (s SignerStats) AvgSignTime() time.Duration {
+	if s.SignCount == 0 {
+		return 0
+	}
+	return s.TotalSignTime / time.Duration(s.SignCount)
+}
+
+// InstrumentedPV wraps the PrivValidator consensus signs with, counting and
+// timing the votes and proposals it signs. The sign latencies and errors are
+// also exported with the signer metrics, labeled by algorithm.
+type InstrumentedPV struct {
+	next      types.PrivValidator
+	algorithm string
+
+	mtx     tmsync.Mutex
+	stats   SignerStats
+	metrics *plugin.Metrics
+}
+
+// NewInstrumentedPV returns an InstrumentedPV signing with pv. The algorithm
+// of the stats is that of the current key of pv.
+func NewInstrumentedPV(pv types.PrivValidator) (*InstrumentedPV, error) {
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+	algorithm := SigningAlgorithm(pubKey)
+	return &InstrumentedPV{
+		next:      pv,
+		algorithm: algorithm,
+		stats:     SignerStats{Algorithm: algorithm},
+		metrics:   plugin.NopMetrics(),
+	}, nil
+}
+
+var _ types.PrivValidator = (*InstrumentedPV)(nil)
+
+// SigningAlgorithm returns the algorithm signatures of pubKey are made with:
+// one of KeyTypes for the keys GenPrivKey generates, or the key type of other
+// keys.
+func SigningAlgorithm(pubKey crypto.PubKey) string {
+	if pk, ok := pubKey.(dilithium.PubKey); ok {
+		if mode, ok := pk.Mode(); ok {
+			return fmt.Sprintf("%s%d", dilithium.KeyType, int(mode))
+		}
+	}
+	return pubKey.Type()
+}
+
+// IsQuantumResistant returns true if signatures of pubKey can't be forged by
+// a quantum computer, i.e. for dilithium and hybrid keys.
+func IsQuantumResistant(pubKey crypto.PubKey) bool {
+	switch pubKey.(type) {
+	case dilithium.PubKey, hybrid.PubKey:
+		return true
+	}
+	return false
+}
+
+// SetMetrics sets the metrics the signatures are exported with.
+func (pv *InstrumentedPV) SetMetrics(metrics *plugin.Metrics) {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+	pv.metrics = metrics
+}
+
+// Unwrap returns the PrivValidator pv signs with.
+func (pv *InstrumentedPV) Unwrap() types.PrivValidator {
+	return pv.next
+}
+
+// Stats returns the counters of the signatures made so far.
+func (pv *InstrumentedPV) Stats() SignerStats {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+	return pv.stats
+}
+
+func (pv *InstrumentedPV) GetPubKey() (crypto.PubKey, error) {
+	return pv.next.GetPubKey()
+}
+
+func (pv *InstrumentedPV) SignVote(chainID string, vote *tmproto.Vote) error {
+	start := time.Now()
+	err := pv.next.SignVote(chainID, vote)
+	pv.record(start, err)
+	return err
+}
+
+func (pv *InstrumentedPV) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	start := time.Now()
+	err := pv.next.SignProposal(chainID, proposal)
+	pv.record(start, err)
+	return err
+}
+
+func (pv *InstrumentedPV) record(start time.Time, err error) {
+	elapsed := time.Since(start)
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+	if err != nil {
+		pv.metrics.Errors.With("algorithm", pv.algorithm, "operation", "sign").Add(1)
+		pv.stats.ErrorCount++
+		return
+	}
+	pv.metrics.SignDuration.With("algorithm", pv.algorithm).Observe(elapsed.Seconds())
+	pv.stats.SignCount++
+	pv.stats.TotalSignTime += elapsed
+	pv.stats.LastSignTime = start
+}
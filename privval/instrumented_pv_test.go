@@ -0,0 +1,75 @@
+package privval
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fluentum-chain/fluentum/core/plugin"
+	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
+	"github.com/fluentum-chain/fluentum/types"
+)
+
+func TestSigningAlgorithm(t *testing.T) {
+	for _, keyType := range KeyTypes {
+		privKey, err := GenPrivKey(keyType)
+		require.NoError(t, err)
+		assert.Equal(t, keyType, SigningAlgorithm(privKey.PubKey()))
+		assert.Equal(t, keyType != KeyTypeEd25519, IsQuantumResistant(privKey.PubKey()), keyType)
+	}
+}
+
+func TestInstrumentedPV(t *testing.T) {
+	privKey, err := GenPrivKey(KeyTypeHybrid)
+	require.NoError(t, err)
+	mockPV := types.NewMockPVWithParams(privKey, false, false)
+	pv, err := NewInstrumentedPV(mockPV)
+	require.NoError(t, err)
+	assert.Equal(t, mockPV, pv.Unwrap())
+	assert.Equal(t, SignerStats{Algorithm: KeyTypeHybrid}, pv.Stats())
+	pv.SetMetrics(plugin.PrometheusMetrics("privval_test"))
+
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+
+	proposal := &tmproto.Proposal{Type: tmproto.ProposalType, Height: 1}
+	require.NoError(t, pv.SignProposal("test-chain", proposal))
+	vote := &tmproto.Vote{Type: tmproto.PrevoteType, Height: 1}
+	require.NoError(t, pv.SignVote("test-chain", vote))
+
+	stats := pv.Stats()
+	assert.EqualValues(t, 2, stats.SignCount)
+	assert.Zero(t, stats.ErrorCount)
+	assert.False(t, stats.LastSignTime.IsZero())
+	assert.Equal(t, stats.TotalSignTime/2, stats.AvgSignTime())
+	assert.EqualValues(t, 2, signDurationCount(t, "privval_test", KeyTypeHybrid))
+
+	// failed signatures are only counted as errors
+	erroring, err := NewInstrumentedPV(types.NewErroringMockPV())
+	require.NoError(t, err)
+	assert.Error(t, erroring.SignVote("test-chain", vote))
+	assert.Equal(t, SignerStats{Algorithm: KeyTypeEd25519, ErrorCount: 1}, erroring.Stats())
+}
+
+// signDurationCount returns the number of signatures observed by the sign
+// duration histogram of namespace for algorithm.
+func signDurationCount(t *testing.T, namespace, algorithm string) uint64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != namespace+"_signer_sign_duration_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "algorithm" && label.GetValue() == algorithm {
+					return metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	return 0
+}
@@ -18,20 +18,46 @@ import (
 type SignerClient struct {
 	endpoint *SignerListenerEndpoint
 	chainID  string
+	keyTypes func() []string
 }
 
 var _ types.PrivValidator = (*SignerClient)(nil)
 
+// SignerClientOption sets an optional parameter on the SignerClient.
+type SignerClientOption func(*SignerClient)
+
+// SignerClientKeyTypes sets the key types, as returned by crypto.PubKey.Type,
+// the client accepts from the remote signer. By default every key type is
+// accepted.
+func SignerClientKeyTypes(keyTypes ...string) SignerClientOption {
+	return SignerClientKeyTypesFunc(func() []string { return keyTypes })
+}
+
+// SignerClientKeyTypesFunc sets a function returning the key types the client
+// accepts from the remote signer. It is called on every GetPubKey, so that the
+// accepted key types can follow the consensus params while the node runs.
+func SignerClientKeyTypesFunc(keyTypes func() []string) SignerClientOption {
+	return func(sc *SignerClient) { sc.keyTypes = keyTypes }
+}
+
 // NewSignerClient returns an instance of SignerClient.
 // it will start the endpoint (if not already started)
-func NewSignerClient(endpoint *SignerListenerEndpoint, chainID string) (*SignerClient, error) {
+func NewSignerClient(
+	endpoint *SignerListenerEndpoint,
+	chainID string,
+	options ...SignerClientOption,
+) (*SignerClient, error) {
 	if !endpoint.IsRunning() {
 		if err := endpoint.Start(); err != nil {
 			return nil, fmt.Errorf("failed to start listener endpoint: %w", err)
 		}
 	}
 
-	return &SignerClient{endpoint: endpoint, chainID: chainID}, nil
+	sc := &SignerClient{endpoint: endpoint, chainID: chainID}
+	for _, option := range options {
+		option(sc)
+	}
+	return sc, nil
 }
 
 // Close closes the underlying connection
@@ -71,7 +97,13 @@ func (sc *SignerClient) Ping() error {
 // GetPubKey retrieves a public key from a remote signer
 // returns an error if client is not able to provide the key
 func (sc *SignerClient) GetPubKey() (crypto.PubKey, error) {
-	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.PubKeyRequest{ChainId: sc.chainID}))
+	var keyTypes []string
+	if sc.keyTypes != nil {
+		keyTypes = sc.keyTypes()
+	}
+	response, err := sc.endpoint.SendRequest(mustWrapMsg(
+		&privvalproto.PubKeyRequest{ChainId: sc.chainID, KeyTypes: keyTypes},
+	))
 	if err != nil {
 		return nil, fmt.Errorf("send: %w", err)
 	}
@@ -94,6 +126,11 @@ func (sc *SignerClient) GetPubKey() (crypto.PubKey, error) {
 	if err != nil {
 		return nil, err
 	}
+	// signers that predate key type negotiation ignore the accepted key types
+	if !isAcceptedKeyType(keyTypes, pk.Type()) {
+		return nil, fmt.Errorf("%w: remote signer key is %s, accepted key types are %v",
+			ErrUnsupportedKeyType, pk.Type(), keyTypes)
+	}
 
 	return pk, nil
 }
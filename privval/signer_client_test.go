@@ -11,7 +11,6 @@ import (
 	"github.com/fluentum-chain/fluentum/crypto"
 	"github.com/fluentum-chain/fluentum/crypto/tmhash"
 	tmrand "github.com/fluentum-chain/fluentum/libs/rand"
-	protocrypto "github.com/fluentum-chain/fluentum/proto/tendermint/crypto"
 	privvalproto "github.com/fluentum-chain/fluentum/proto/tendermint/privval"
 	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
 	"github.com/fluentum-chain/fluentum/types"
@@ -114,6 +113,35 @@ func TestSignerGetPubKey(t *testing.T) {
 	}
 }
 
+func TestSignerGetPubKeyKeyTypes(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		tc := tc
+		t.Cleanup(func() {
+			if err := tc.signerServer.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+		t.Cleanup(func() {
+			if err := tc.signerClient.Close(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		// the accepted key types are read again on every call
+		keyTypes := []string{types.ABCIPubKeyTypeHybrid}
+		SignerClientKeyTypesFunc(func() []string { return keyTypes })(tc.signerClient)
+		_, err := tc.signerClient.GetPubKey()
+		require.Error(t, err)
+
+		keyTypes = append(keyTypes, types.ABCIPubKeyTypeEd25519)
+		pubKey, err := tc.signerClient.GetPubKey()
+		require.NoError(t, err)
+		expectedPubKey, err := tc.mockPV.GetPubKey()
+		require.NoError(t, err)
+		assert.Equal(t, expectedPubKey, pubKey)
+	}
+}
+
 func TestSignerProposal(t *testing.T) {
 	for _, tc := range getSignerTestCases(t) {
 		ts := time.Now()
@@ -397,11 +425,11 @@ func brokenHandler(privVal types.PrivValidator, request privvalproto.Message,
 	switch r := request.Sum.(type) {
 	// This is broken and will answer most requests with a pubkey response
 	case *privvalproto.Message_PubKeyRequest:
-		res = mustWrapMsg(&privvalproto.PubKeyResponse{PubKey: &protocrypto.PublicKey{}, Error: nil})
+		res = mustWrapMsg(&privvalproto.PubKeyResponse{PubKey: nil, Error: nil})
 	case *privvalproto.Message_SignVoteRequest:
-		res = mustWrapMsg(&privvalproto.PubKeyResponse{PubKey: &protocrypto.PublicKey{}, Error: nil})
+		res = mustWrapMsg(&privvalproto.PubKeyResponse{PubKey: nil, Error: nil})
 	case *privvalproto.Message_SignProposalRequest:
-		res = mustWrapMsg(&privvalproto.PubKeyResponse{PubKey: &protocrypto.PublicKey{}, Error: nil})
+		res = mustWrapMsg(&privvalproto.PubKeyResponse{PubKey: nil, Error: nil})
 	case *privvalproto.Message_PingRequest:
 		err, res = nil, mustWrapMsg(&privvalproto.PingResponse{})
 	default:
@@ -35,6 +35,13 @@ func DefaultValidationRequestHandler(
 		if err != nil {
 			return res, err
 		}
+		if !isAcceptedKeyType(r.PubKeyRequest.KeyTypes, pubKey.Type()) {
+			res = mustWrapMsg(&privvalproto.PubKeyResponse{
+				PubKey: []byte{}, Error: &privvalproto.RemoteSignerError{
+					Code: 0, Description: fmt.Sprintf("key type %s is not accepted", pubKey.Type())}})
+			return res, fmt.Errorf("%w: %s is not one of %v",
+				ErrUnsupportedKeyType, pubKey.Type(), r.PubKeyRequest.KeyTypes)
+		}
 		pk, err := cryptoenc.PubKeyToProto(pubKey)
 		if err != nil {
 			return res, err
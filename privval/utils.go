@@ -60,3 +60,17 @@ func GetFreeLocalhostAddrPort() string {
 	}
 	return fmt.Sprintf("127.0.0.1:%d", port)
 }
+
+// isAcceptedKeyType reports whether keyType is one of keyTypes. Every key type
+// is accepted when keyTypes is empty.
+func isAcceptedKeyType(keyTypes []string, keyType string) bool {
+	if len(keyTypes) == 0 {
+		return true
+	}
+	for _, kt := range keyTypes {
+		if kt == keyType {
+			return true
+		}
+	}
+	return false
+}
@@ -152,6 +152,9 @@ type DefaultNodeInfo struct {
 	Channels        []byte               `protobuf:"bytes,6,opt,name=channels,proto3" json:"channels,omitempty"`
 	Moniker         string               `protobuf:"bytes,7,opt,name=moniker,proto3" json:"moniker,omitempty"`
 	Other           DefaultNodeInfoOther `protobuf:"bytes,8,opt,name=other,proto3" json:"other"`
+	// hybrid_key_exchange is set by nodes offering a hybrid X25519+ML-KEM key
+	// exchange in the SecretConnection handshake.
+	HybridKeyExchange bool `protobuf:"varint,9,opt,name=hybrid_key_exchange,json=hybridKeyExchange,proto3" json:"hybrid_key_exchange,omitempty"`
 }
 
 func (m *DefaultNodeInfo) Reset()         { *m = DefaultNodeInfo{} }
@@ -243,6 +246,13 @@ func (m *DefaultNodeInfo) GetOther() DefaultNodeInfoOther {
 	return DefaultNodeInfoOther{}
 }
 
+func (m *DefaultNodeInfo) GetHybridKeyExchange() bool {
+	if m != nil {
+		return m.HybridKeyExchange
+	}
+	return false
+}
+
 type DefaultNodeInfoOther struct {
 	TxIndex    string `protobuf:"bytes,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
 	RPCAddress string `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
@@ -305,38 +315,40 @@ func init() {
 func init() { proto.RegisterFile("fluentum/p2p/types.proto", fileDescriptor_a54a898f6693eb4e) }
 
 var fileDescriptor_a54a898f6693eb4e = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x8f, 0xd2, 0x40,
-	0x14, 0xa6, 0xa5, 0x0b, 0xec, 0x63, 0x91, 0x75, 0x42, 0x4c, 0x77, 0x13, 0x5b, 0xc2, 0x89, 0x8b,
-	0x34, 0xc1, 0x78, 0xf0, 0x62, 0x22, 0x72, 0xe1, 0xc2, 0x36, 0x13, 0xe3, 0xc1, 0x0b, 0x81, 0xce,
-	0x00, 0x13, 0xca, 0xcc, 0x64, 0x3a, 0x28, 0xfe, 0x17, 0xfe, 0x59, 0x7b, 0xdc, 0xa3, 0xa7, 0xc6,
-	0x94, 0x83, 0xff, 0x86, 0xe9, 0xb4, 0x68, 0x25, 0xde, 0xde, 0xf7, 0x7e, 0x7e, 0xef, 0xcb, 0x07,
-	0xee, 0x3a, 0x3e, 0x50, 0xae, 0x0f, 0xfb, 0x40, 0x8e, 0x65, 0xa0, 0xbf, 0x49, 0x9a, 0x8c, 0xa4,
-	0x12, 0x5a, 0xa0, 0x9b, 0x73, 0x65, 0x24, 0xc7, 0xf2, 0xbe, 0xb7, 0x11, 0x1b, 0x61, 0x0a, 0x41,
-	0x1e, 0x15, 0x3d, 0x83, 0x10, 0x60, 0x4e, 0xf5, 0x7b, 0x42, 0x14, 0x4d, 0x12, 0xf4, 0x02, 0x6c,
-	0x46, 0x5c, 0xab, 0x6f, 0x0d, 0xaf, 0x27, 0x8d, 0x2c, 0xf5, 0xed, 0xd9, 0x14, 0xdb, 0x8c, 0x98,
-	0xbc, 0x74, 0xed, 0x4a, 0x3e, 0xc4, 0x36, 0x93, 0x08, 0x81, 0x23, 0x85, 0xd2, 0x6e, 0xbd, 0x6f,
-	0x0d, 0x3b, 0xd8, 0xc4, 0x83, 0x8f, 0xd0, 0x0d, 0xf3, 0xd5, 0x91, 0x88, 0x3f, 0x51, 0x95, 0x30,
-	0xc1, 0xd1, 0x1d, 0xd4, 0xe5, 0x58, 0x9a, 0xbd, 0xce, 0xa4, 0x99, 0xa5, 0x7e, 0x3d, 0x1c, 0x87,
-	0x38, 0xcf, 0xa1, 0x1e, 0x5c, 0xad, 0x62, 0x11, 0xed, 0xcc, 0x72, 0x07, 0x17, 0x00, 0xdd, 0x42,
-	0x7d, 0x29, 0xa5, 0x59, 0xeb, 0xe0, 0x3c, 0x1c, 0xfc, 0xb2, 0xa1, 0x3b, 0xa5, 0xeb, 0xe5, 0x21,
-	0xd6, 0x73, 0x41, 0xe8, 0x8c, 0xaf, 0x05, 0x9a, 0xc3, 0xad, 0x2c, 0x2f, 0x2d, 0xbe, 0x14, 0xa7,
-	0xcc, 0x8d, 0xf6, 0xf8, 0xe5, 0xa8, 0xfa, 0xfa, 0xe8, 0x82, 0xcf, 0xc4, 0x79, 0x4c, 0xfd, 0x1a,
-	0xee, 0xca, 0x0b, 0x9a, 0x6f, 0xa1, 0x4b, 0x8a, 0x13, 0x0b, 0x2e, 0x08, 0x5d, 0x30, 0x52, 0xbe,
-	0xfc, 0x3c, 0x4b, 0xfd, 0x4e, 0xf5, 0xfa, 0x14, 0x77, 0x48, 0x05, 0x12, 0xe4, 0x43, 0x3b, 0x66,
-	0x89, 0xa6, 0x7c, 0xb1, 0x24, 0x44, 0x19, 0xe2, 0xd7, 0x18, 0x8a, 0x54, 0x2e, 0x2e, 0x72, 0xa1,
-	0xc9, 0xa9, 0xfe, 0x2a, 0xd4, 0xce, 0x75, 0x4c, 0xf1, 0x0c, 0xf3, 0xca, 0x99, 0xfc, 0x55, 0x51,
-	0x29, 0x21, 0xba, 0x87, 0x56, 0xb4, 0x5d, 0x72, 0x4e, 0xe3, 0xc4, 0x6d, 0xf4, 0xad, 0xe1, 0x0d,
-	0xfe, 0x83, 0xf3, 0xa9, 0xbd, 0xe0, 0x6c, 0x47, 0x95, 0xdb, 0x2c, 0xa6, 0x4a, 0x88, 0xde, 0xc1,
-	0x95, 0xd0, 0x5b, 0xaa, 0xdc, 0x96, 0x91, 0x62, 0xf0, 0xaf, 0x14, 0x17, 0x1a, 0x3e, 0xe4, 0x9d,
-	0xa5, 0x1e, 0xc5, 0xd8, 0x60, 0x05, 0xbd, 0xff, 0x35, 0xa1, 0x3b, 0x68, 0xe9, 0xe3, 0x82, 0x71,
-	0x42, 0x8f, 0x85, 0x43, 0x70, 0x53, 0x1f, 0x67, 0x39, 0x44, 0x01, 0xb4, 0x95, 0x8c, 0xcc, 0xeb,
-	0x34, 0x49, 0x4a, 0xd1, 0x9e, 0x65, 0xa9, 0x0f, 0x38, 0xfc, 0x50, 0x7a, 0x0b, 0x83, 0x92, 0x51,
-	0x19, 0x4f, 0x1e, 0x1e, 0x33, 0xcf, 0x7a, 0xca, 0x3c, 0xeb, 0x67, 0xe6, 0x59, 0xdf, 0x4f, 0x5e,
-	0xed, 0xe9, 0xe4, 0xd5, 0x7e, 0x9c, 0xbc, 0xda, 0xe7, 0x37, 0x1b, 0xa6, 0xb7, 0x87, 0xd5, 0x28,
-	0x12, 0xfb, 0xe0, 0x4c, 0xfc, 0x55, 0xb4, 0x5d, 0x32, 0x1e, 0xfc, 0xf5, 0xb9, 0x31, 0x71, 0xd5,
-	0xf6, 0xab, 0x86, 0xc9, 0xbd, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x7b, 0xf6, 0xd2, 0x0d,
-	0x03, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3d, 0x8f, 0xda, 0x40,
+	0x10, 0xc5, 0x60, 0xbe, 0x86, 0x23, 0xdc, 0x6d, 0x50, 0xe4, 0x3b, 0x29, 0x36, 0xa2, 0xa2, 0x89,
+	0x2d, 0x11, 0xa5, 0x48, 0x13, 0x29, 0x84, 0x14, 0x28, 0x12, 0x67, 0xad, 0xa2, 0x14, 0x69, 0x2c,
+	0xf0, 0x2e, 0x60, 0x61, 0x76, 0x57, 0xeb, 0x25, 0x81, 0x7f, 0x91, 0x2e, 0x7f, 0xe9, 0xca, 0x2b,
+	0x53, 0xa1, 0xc8, 0xfc, 0x91, 0xc8, 0x6b, 0x93, 0x10, 0x74, 0xdd, 0xbc, 0x79, 0x3b, 0xf3, 0x66,
+	0x9e, 0x66, 0xc1, 0x5a, 0xc4, 0x5b, 0xca, 0xd4, 0x76, 0xe3, 0x89, 0xa1, 0xf0, 0xd4, 0x5e, 0xd0,
+	0xc4, 0x15, 0x92, 0x2b, 0x8e, 0xae, 0x4e, 0x8c, 0x2b, 0x86, 0xe2, 0xae, 0xbb, 0xe4, 0x4b, 0xae,
+	0x09, 0x2f, 0x8b, 0xf2, 0x37, 0x7d, 0x1f, 0x60, 0x4a, 0xd5, 0x7b, 0x42, 0x24, 0x4d, 0x12, 0xf4,
+	0x02, 0xca, 0x11, 0xb1, 0x8c, 0x9e, 0x31, 0x68, 0x8e, 0x6a, 0xe9, 0xc1, 0x29, 0x4f, 0xc6, 0xb8,
+	0x1c, 0x11, 0x9d, 0x17, 0x56, 0xf9, 0x2c, 0xef, 0xe3, 0x72, 0x24, 0x10, 0x02, 0x53, 0x70, 0xa9,
+	0xac, 0x4a, 0xcf, 0x18, 0xb4, 0xb1, 0x8e, 0xfb, 0x9f, 0xa1, 0xe3, 0x67, 0xad, 0x43, 0x1e, 0x7f,
+	0xa1, 0x32, 0x89, 0x38, 0x43, 0xb7, 0x50, 0x11, 0x43, 0xa1, 0xfb, 0x9a, 0xa3, 0x7a, 0x7a, 0x70,
+	0x2a, 0xfe, 0xd0, 0xc7, 0x59, 0x0e, 0x75, 0xa1, 0x3a, 0x8f, 0x79, 0xb8, 0xd6, 0xcd, 0x4d, 0x9c,
+	0x03, 0x74, 0x0d, 0x95, 0x99, 0x10, 0xba, 0xad, 0x89, 0xb3, 0xb0, 0xff, 0xb3, 0x02, 0x9d, 0x31,
+	0x5d, 0xcc, 0xb6, 0xb1, 0x9a, 0x72, 0x42, 0x27, 0x6c, 0xc1, 0xd1, 0x14, 0xae, 0x45, 0xa1, 0x14,
+	0x7c, 0xcb, 0xa5, 0xb4, 0x46, 0x6b, 0xf8, 0xd2, 0x3d, 0x5f, 0xdd, 0xbd, 0x98, 0x67, 0x64, 0x3e,
+	0x1c, 0x9c, 0x12, 0xee, 0x88, 0x8b, 0x31, 0xdf, 0x42, 0x87, 0xe4, 0x12, 0x01, 0xe3, 0x84, 0x06,
+	0x11, 0x29, 0x56, 0xbe, 0x49, 0x0f, 0x4e, 0xfb, 0x5c, 0x7d, 0x8c, 0xdb, 0xe4, 0x0c, 0x12, 0xe4,
+	0x40, 0x2b, 0x8e, 0x12, 0x45, 0x59, 0x30, 0x23, 0x44, 0xea, 0xc1, 0x9b, 0x18, 0xf2, 0x54, 0x66,
+	0x2e, 0xb2, 0xa0, 0xce, 0xa8, 0xfa, 0xce, 0xe5, 0xda, 0x32, 0x35, 0x79, 0x82, 0x19, 0x73, 0x1a,
+	0xbe, 0x9a, 0x33, 0x05, 0x44, 0x77, 0xd0, 0x08, 0x57, 0x33, 0xc6, 0x68, 0x9c, 0x58, 0xb5, 0x9e,
+	0x31, 0xb8, 0xc2, 0x7f, 0x71, 0x56, 0xb5, 0xe1, 0x2c, 0x5a, 0x53, 0x69, 0xd5, 0xf3, 0xaa, 0x02,
+	0xa2, 0x77, 0x50, 0xe5, 0x6a, 0x45, 0xa5, 0xd5, 0xd0, 0x56, 0xf4, 0xff, 0xb7, 0xe2, 0xc2, 0xc3,
+	0xfb, 0xec, 0x65, 0xe1, 0x47, 0x5e, 0x86, 0x5c, 0x78, 0xbe, 0xda, 0xcf, 0x65, 0x44, 0x82, 0x35,
+	0xdd, 0x07, 0x74, 0x97, 0x49, 0x2e, 0xa9, 0xd5, 0xec, 0x19, 0x83, 0x06, 0xbe, 0xc9, 0xa9, 0x4f,
+	0x74, 0xff, 0xb1, 0x20, 0xfa, 0x73, 0xe8, 0x3e, 0xd5, 0x14, 0xdd, 0x42, 0x43, 0xed, 0x82, 0x88,
+	0x11, 0xba, 0xcb, 0x2f, 0x0a, 0xd7, 0xd5, 0x6e, 0x92, 0x41, 0xe4, 0x41, 0x4b, 0x8a, 0x50, 0x5b,
+	0x45, 0x93, 0xa4, 0x30, 0xf9, 0x59, 0x7a, 0x70, 0x00, 0xfb, 0x1f, 0x8a, 0x5b, 0xc4, 0x20, 0x45,
+	0x58, 0xc4, 0xa3, 0xfb, 0x87, 0xd4, 0x36, 0x1e, 0x53, 0xdb, 0xf8, 0x9d, 0xda, 0xc6, 0x8f, 0xa3,
+	0x5d, 0x7a, 0x3c, 0xda, 0xa5, 0x5f, 0x47, 0xbb, 0xf4, 0xf5, 0xcd, 0x32, 0x52, 0xab, 0xed, 0xdc,
+	0x0d, 0xf9, 0xc6, 0x3b, 0x2d, 0xfa, 0x2a, 0x5c, 0xcd, 0x22, 0xe6, 0xfd, 0xfb, 0x17, 0xfa, 0xe8,
+	0xcf, 0xbf, 0xc9, 0xbc, 0xa6, 0x73, 0xaf, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x0d, 0xd6,
+	0x3c, 0x3d, 0x03, 0x00, 0x00,
 }
 
 func (m *NetAddress) Marshal() (dAtA []byte, err error) {
@@ -439,6 +451,16 @@ func (m *DefaultNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HybridKeyExchange {
+		i--
+		if m.HybridKeyExchange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size, err := m.Other.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -624,6 +646,9 @@ func (m *DefaultNodeInfo) Size() (n int) {
 	}
 	l = m.Other.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if m.HybridKeyExchange {
+		n += 2
+	}
 	return n
 }
 
@@ -1179,6 +1204,26 @@ func (m *DefaultNodeInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HybridKeyExchange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HybridKeyExchange = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -116,6 +116,9 @@ func (m *RemoteSignerError) GetDescription() string {
 // PubKeyRequest requests the consensus public key from the remote signer.
 type PubKeyRequest struct {
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// key_types are the key types the client accepts. Signers with a key of
+	// another type refuse the request. Empty accepts any key type.
+	KeyTypes []string `protobuf:"bytes,2,rep,name=key_types,json=keyTypes,proto3" json:"key_types,omitempty"`
 }
 
 func (m *PubKeyRequest) Reset()         { *m = PubKeyRequest{} }
@@ -158,6 +161,13 @@ func (m *PubKeyRequest) GetChainId() string {
 	return ""
 }
 
+func (m *PubKeyRequest) GetKeyTypes() []string {
+	if m != nil {
+		return m.KeyTypes
+	}
+	return nil
+}
+
 // PubKeyResponse is a response message containing the public key.
 type PubKeyResponse struct {
 	PubKey []byte             `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
@@ -677,53 +687,54 @@ func init() {
 func init() { proto.RegisterFile("tendermint/privval/types.proto", fileDescriptor_cb4e437a5328cf9c) }
 
 var fileDescriptor_cb4e437a5328cf9c = []byte{
-	// 726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdb, 0x4e, 0x13, 0x4d,
-	0x1c, 0xdf, 0x85, 0x1e, 0xe0, 0xdf, 0x03, 0x65, 0xe0, 0x83, 0xd2, 0x7c, 0x2e, 0xb5, 0x46, 0x25,
-	0x4d, 0x6c, 0x0d, 0x26, 0xde, 0xe0, 0x8d, 0xc0, 0xc6, 0x36, 0x84, 0x6d, 0x9d, 0x16, 0x21, 0x24,
-	0x66, 0xd3, 0xc3, 0xb0, 0x6c, 0xa4, 0x3b, 0xe3, 0xce, 0x96, 0x84, 0x6b, 0x5f, 0xc0, 0xc4, 0x97,
-	0xf0, 0xda, 0xa7, 0xe0, 0x92, 0x4b, 0xaf, 0x8c, 0x81, 0x17, 0x31, 0x9d, 0x9d, 0x6e, 0xcf, 0x44,
-	0xc3, 0xdd, 0xfc, 0x0f, 0xf3, 0x3b, 0x74, 0x7e, 0xe9, 0x82, 0xe6, 0x11, 0xa7, 0x4d, 0xdc, 0x8e,
-	0xed, 0x78, 0x45, 0xe6, 0xda, 0x97, 0x97, 0x8d, 0x8b, 0xa2, 0x77, 0xc5, 0x08, 0x2f, 0x30, 0x97,
-	0x7a, 0x14, 0xa1, 0xc1, 0xbc, 0x20, 0xe7, 0x99, 0xff, 0x87, 0xee, 0x88, 0xdd, 0xe1, 0x1b, 0x99,
-	0x55, 0x8b, 0x5a, 0x54, 0x1c, 0x8b, 0xbd, 0x93, 0xdf, 0xcd, 0x95, 0x61, 0x19, 0x93, 0x0e, 0xf5,
-	0x48, 0xcd, 0xb6, 0x1c, 0xe2, 0xea, 0xae, 0x4b, 0x5d, 0x84, 0x20, 0xd4, 0xa2, 0x6d, 0x92, 0x56,
-	0xb3, 0xea, 0x56, 0x18, 0x8b, 0x33, 0xca, 0x42, 0xac, 0x4d, 0x78, 0xcb, 0xb5, 0x99, 0x67, 0x53,
-	0x27, 0x3d, 0x97, 0x55, 0xb7, 0x16, 0xf1, 0x70, 0x2b, 0x97, 0x87, 0x44, 0xb5, 0xdb, 0x3c, 0x20,
-	0x57, 0x98, 0x7c, 0xee, 0x12, 0xee, 0xa1, 0x0d, 0x58, 0x68, 0x9d, 0x37, 0x6c, 0xc7, 0xb4, 0xdb,
-	0x02, 0x6a, 0x11, 0x47, 0x45, 0x5d, 0x6e, 0xe7, 0xce, 0x20, 0xd9, 0xdf, 0xe5, 0x8c, 0x3a, 0x9c,
-	0xa0, 0x75, 0x88, 0xb2, 0x6e, 0xd3, 0xfc, 0x44, 0xae, 0xc4, 0x6e, 0x1c, 0x47, 0x98, 0x58, 0x40,
-	0x3b, 0x10, 0x26, 0x3d, 0x55, 0x82, 0x32, 0xb6, 0xfd, 0xb4, 0x30, 0xe9, 0xbc, 0x30, 0x61, 0x01,
-	0xfb, 0x77, 0x72, 0x27, 0xb0, 0xd4, 0xeb, 0x7e, 0xa0, 0x1e, 0xe9, 0xab, 0xca, 0x43, 0xe8, 0x92,
-	0x7a, 0xbe, 0xb9, 0xd8, 0xf6, 0xda, 0x30, 0x9c, 0xff, 0x73, 0x89, 0x65, 0xb1, 0x33, 0xe2, 0x60,
-	0x6e, 0xd4, 0xc1, 0x17, 0x15, 0x90, 0x20, 0x6c, 0xfb, 0xe0, 0xd2, 0xc6, 0xcb, 0xbf, 0x41, 0xdf,
-	0x0d, 0x5d, 0xff, 0xda, 0x54, 0x24, 0xc7, 0x83, 0xfc, 0x9d, 0xc3, 0x4a, 0xaf, 0x5b, 0x75, 0x29,
-	0xa3, 0xbc, 0x71, 0xd1, 0xf7, 0xf8, 0x1a, 0x16, 0x98, 0x6c, 0x49, 0x25, 0x99, 0x49, 0x25, 0xc1,
-	0xa5, 0x60, 0xf7, 0x3e, 0xbf, 0xdf, 0x54, 0x58, 0xf3, 0xfd, 0x0e, 0xc8, 0xa4, 0xe7, 0x37, 0xff,
-	0xc2, 0x26, 0xbd, 0x0f, 0x38, 0x1f, 0xe4, 0x3f, 0x01, 0xb1, 0xaa, 0xed, 0x58, 0xd2, 0x77, 0x2e,
-	0x09, 0x71, 0xbf, 0xf4, 0x95, 0xe5, 0x7e, 0x84, 0x21, 0x7a, 0x48, 0x38, 0x6f, 0x58, 0x04, 0x1d,
-	0xc0, 0x92, 0x0c, 0x98, 0xe9, 0xfa, 0xeb, 0x52, 0xec, 0xe3, 0x69, 0x8c, 0x23, 0x49, 0x2e, 0x29,
-	0x38, 0xc1, 0x46, 0xa2, 0x6d, 0x40, 0x6a, 0x00, 0xe6, 0x93, 0x49, 0xfd, 0xb9, 0xfb, 0xd0, 0xfc,
-	0xcd, 0x92, 0x82, 0x93, 0x6c, 0x34, 0xfd, 0xef, 0x61, 0x99, 0xdb, 0x96, 0x63, 0xf6, 0x12, 0x11,
-	0xc8, 0x9b, 0x17, 0x80, 0x4f, 0xa6, 0x01, 0x8e, 0x85, 0xba, 0xa4, 0xe0, 0x25, 0x3e, 0x96, 0xf3,
-	0x53, 0x58, 0xe5, 0xe2, 0xbd, 0xfa, 0xa0, 0x52, 0x66, 0x48, 0xa0, 0x3e, 0x9b, 0x85, 0x3a, 0x9a,
-	0xe7, 0x92, 0x82, 0x11, 0x9f, 0x4c, 0xf9, 0x47, 0xf8, 0x4f, 0xc8, 0xed, 0x3f, 0x62, 0x20, 0x39,
-	0x2c, 0xc0, 0x9f, 0xcf, 0x02, 0x1f, 0xcb, 0x69, 0x49, 0xc1, 0x2b, 0x7c, 0x4a, 0x7c, 0xcf, 0x20,
-	0x2d, 0xa5, 0x0f, 0x11, 0x48, 0xf9, 0x11, 0xc1, 0x90, 0x9f, 0x2d, 0x7f, 0x3c, 0x9e, 0x25, 0x05,
-	0xaf, 0xf1, 0xe9, 0xc1, 0xdd, 0x87, 0x38, 0xb3, 0x1d, 0x2b, 0x50, 0x1f, 0x15, 0xd8, 0x9b, 0x53,
-	0x5f, 0x70, 0x90, 0xb2, 0x92, 0x82, 0x63, 0x6c, 0x50, 0xa2, 0x77, 0x90, 0x90, 0x28, 0x52, 0xe2,
-	0x82, 0x80, 0xc9, 0xce, 0x86, 0x09, 0x84, 0xc5, 0xd9, 0x50, 0xbd, 0x1b, 0x86, 0x79, 0xde, 0xed,
-	0xe4, 0xbf, 0xab, 0x10, 0x11, 0x21, 0xe7, 0x08, 0x41, 0x52, 0xc7, 0xb8, 0x82, 0x6b, 0xe6, 0x91,
-	0x71, 0x60, 0x54, 0x8e, 0x8d, 0x94, 0x82, 0x34, 0xc8, 0x04, 0x3d, 0xfd, 0xa4, 0xaa, 0xef, 0xd5,
-	0xf5, 0x7d, 0x13, 0xeb, 0xb5, 0x6a, 0xc5, 0xa8, 0xe9, 0x29, 0x15, 0xa5, 0x61, 0x55, 0xce, 0x8d,
-	0x8a, 0xb9, 0x57, 0x31, 0x0c, 0x7d, 0xaf, 0x5e, 0xae, 0x18, 0xa9, 0x39, 0xf4, 0x08, 0x36, 0xe4,
-	0x64, 0xd0, 0x36, 0xeb, 0xe5, 0x43, 0xbd, 0x72, 0x54, 0x4f, 0xcd, 0xa3, 0x75, 0x58, 0x91, 0x63,
-	0xac, 0xbf, 0xdd, 0x0f, 0x06, 0xa1, 0x21, 0xc4, 0x63, 0x5c, 0xae, 0xeb, 0xc1, 0x24, 0xbc, 0x7b,
-	0x74, 0x7d, 0xab, 0xa9, 0x37, 0xb7, 0x9a, 0xfa, 0xfb, 0x56, 0x53, 0xbf, 0xde, 0x69, 0xca, 0xcd,
-	0x9d, 0xa6, 0xfc, 0xbc, 0xd3, 0x94, 0xd3, 0x1d, 0xcb, 0xf6, 0xce, 0xbb, 0xcd, 0x42, 0x8b, 0x76,
-	0x8a, 0x67, 0x17, 0x5d, 0xe2, 0x78, 0xdd, 0xce, 0x0b, 0xf1, 0x5f, 0x12, 0x94, 0x45, 0xff, 0x63,
-	0x34, 0xf9, 0xa1, 0x6b, 0x46, 0xc4, 0xe4, 0xd5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xab,
-	0x4a, 0x1d, 0x05, 0x07, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc9, 0x4e, 0xdb, 0x40,
+	0x18, 0xb6, 0xb3, 0xe7, 0xcf, 0x42, 0x18, 0x28, 0x84, 0xb4, 0x35, 0xa9, 0xab, 0xb6, 0x08, 0xa9,
+	0x49, 0x45, 0xa5, 0x5e, 0xe8, 0xa5, 0x80, 0x45, 0x22, 0x84, 0x93, 0x4e, 0x42, 0x41, 0x48, 0x95,
+	0x95, 0x65, 0x30, 0x16, 0xc4, 0x76, 0x3d, 0x0e, 0x52, 0xce, 0x7d, 0x81, 0x4a, 0x7d, 0x89, 0x9e,
+	0xfb, 0x14, 0x1c, 0x39, 0xf6, 0x54, 0x55, 0xf0, 0x22, 0x55, 0xc6, 0x13, 0x67, 0x47, 0xad, 0xb8,
+	0xcd, 0xbf, 0xcc, 0xb7, 0x64, 0x3e, 0xc5, 0x20, 0xb9, 0xc4, 0x6c, 0x13, 0xa7, 0x63, 0x98, 0x6e,
+	0xd1, 0x76, 0x8c, 0xab, 0xab, 0xc6, 0x65, 0xd1, 0xed, 0xd9, 0x84, 0x16, 0x6c, 0xc7, 0x72, 0x2d,
+	0x84, 0x86, 0xf3, 0x02, 0x9f, 0xe7, 0x9e, 0x8c, 0xdc, 0x61, 0xbb, 0xa3, 0x37, 0x72, 0xcb, 0xba,
+	0xa5, 0x5b, 0xec, 0x58, 0xec, 0x9f, 0xbc, 0xae, 0x5c, 0x86, 0x45, 0x4c, 0x3a, 0x96, 0x4b, 0x6a,
+	0x86, 0x6e, 0x12, 0x47, 0x71, 0x1c, 0xcb, 0x41, 0x08, 0x42, 0x2d, 0xab, 0x4d, 0xb2, 0x62, 0x5e,
+	0xdc, 0x08, 0x63, 0x76, 0x46, 0x79, 0x48, 0xb4, 0x09, 0x6d, 0x39, 0x86, 0xed, 0x1a, 0x96, 0x99,
+	0x0d, 0xe4, 0xc5, 0x8d, 0x38, 0x1e, 0x6d, 0xc9, 0xfb, 0x90, 0xaa, 0x76, 0x9b, 0x07, 0xa4, 0x87,
+	0xc9, 0x97, 0x2e, 0xa1, 0x2e, 0x5a, 0x83, 0x58, 0xeb, 0xbc, 0x61, 0x98, 0x9a, 0xd1, 0x66, 0x50,
+	0x71, 0x1c, 0x65, 0x75, 0xb9, 0x8d, 0x1e, 0x43, 0xfc, 0x82, 0xf4, 0x34, 0xa6, 0x2f, 0x1b, 0xc8,
+	0x07, 0x37, 0xe2, 0x38, 0x76, 0x41, 0x7a, 0xf5, 0x7e, 0x2d, 0x9f, 0x41, 0x7a, 0x00, 0x44, 0x6d,
+	0xcb, 0xa4, 0x04, 0xad, 0x42, 0xd4, 0xee, 0x36, 0xb5, 0x0b, 0xd2, 0x63, 0x40, 0x49, 0x1c, 0xb1,
+	0xd9, 0x02, 0xda, 0x86, 0x30, 0xe9, 0x4b, 0x66, 0x7a, 0x12, 0x5b, 0x2f, 0x0a, 0xd3, 0x3f, 0x4b,
+	0x61, 0xca, 0x1f, 0xf6, 0xee, 0xc8, 0x27, 0xb0, 0xd0, 0xef, 0x7e, 0xb2, 0x5c, 0x32, 0x90, 0xbc,
+	0x09, 0xa1, 0x2b, 0xcb, 0xf5, 0x9c, 0x27, 0xb6, 0x56, 0x46, 0xe1, 0xbc, 0xdf, 0x92, 0x2d, 0xb3,
+	0x9d, 0x31, 0x7b, 0x81, 0x31, 0x7b, 0xf2, 0x57, 0x11, 0x10, 0x23, 0x6c, 0x7b, 0xe0, 0xdc, 0xc6,
+	0x9b, 0x7f, 0x41, 0xdf, 0x09, 0x5d, 0xff, 0x5e, 0x17, 0x38, 0xc7, 0x83, 0xfc, 0x9d, 0xc3, 0x52,
+	0xbf, 0x5b, 0x75, 0x2c, 0xdb, 0xa2, 0x8d, 0xcb, 0x81, 0xc7, 0x77, 0x10, 0xb3, 0x79, 0x8b, 0x2b,
+	0xc9, 0x4d, 0x2b, 0xf1, 0x2f, 0xf9, 0xbb, 0xf7, 0xf9, 0xfd, 0x2e, 0xc2, 0x8a, 0xe7, 0x77, 0x48,
+	0xc6, 0x3d, 0xbf, 0xff, 0x1f, 0x36, 0xee, 0x7d, 0xc8, 0xf9, 0x20, 0xff, 0x29, 0x48, 0x54, 0x0d,
+	0x53, 0xe7, 0xbe, 0xe5, 0x34, 0x24, 0xbd, 0xd2, 0x53, 0x26, 0xff, 0x0c, 0x43, 0xf4, 0x90, 0x50,
+	0xda, 0xd0, 0x09, 0x3a, 0x80, 0x05, 0x1e, 0x30, 0xcd, 0xf1, 0xd6, 0xb9, 0xd8, 0x67, 0xb3, 0x18,
+	0xc7, 0x62, 0x5e, 0x12, 0x70, 0xca, 0x1e, 0xcb, 0xbd, 0x0a, 0x99, 0x21, 0x98, 0x47, 0xc6, 0xf5,
+	0xcb, 0xf7, 0xa1, 0x79, 0x9b, 0x25, 0x01, 0xa7, 0xed, 0xf1, 0xf4, 0x7f, 0x84, 0x45, 0x6a, 0xe8,
+	0xa6, 0xd6, 0x4f, 0x84, 0x2f, 0x2f, 0xc8, 0x00, 0x9f, 0xcf, 0x02, 0x9c, 0x08, 0x75, 0x49, 0xc0,
+	0x0b, 0x74, 0x22, 0xe7, 0xa7, 0xb0, 0x4c, 0xd9, 0x7b, 0x0d, 0x40, 0xb9, 0xcc, 0x10, 0x43, 0x7d,
+	0x39, 0x0f, 0x75, 0x3c, 0xcf, 0x25, 0x01, 0x23, 0x3a, 0x9d, 0xf2, 0xcf, 0xf0, 0x88, 0xc9, 0x1d,
+	0x3c, 0xa2, 0x2f, 0x39, 0xcc, 0xc0, 0x5f, 0xcd, 0x03, 0x9f, 0xc8, 0x69, 0x49, 0xc0, 0x4b, 0x74,
+	0x46, 0x7c, 0xcf, 0x20, 0xcb, 0xa5, 0x8f, 0x10, 0x70, 0xf9, 0x11, 0xc6, 0xb0, 0x39, 0x5f, 0xfe,
+	0x64, 0x3c, 0x4b, 0x02, 0x5e, 0xa1, 0xb3, 0x83, 0xbb, 0x07, 0x49, 0xdb, 0x30, 0x75, 0x5f, 0x7d,
+	0x94, 0x61, 0xaf, 0xcf, 0x7c, 0xc1, 0x61, 0xca, 0x4a, 0x02, 0x4e, 0xd8, 0xc3, 0x12, 0xed, 0x43,
+	0x8a, 0xa3, 0x70, 0x89, 0x31, 0x06, 0x93, 0x9f, 0x0f, 0xe3, 0x0b, 0x4b, 0xda, 0x23, 0xf5, 0x4e,
+	0x18, 0x82, 0xb4, 0xdb, 0xd9, 0xfc, 0x21, 0x42, 0x84, 0x85, 0x9c, 0x22, 0x04, 0x69, 0x05, 0xe3,
+	0x0a, 0xae, 0x69, 0x47, 0xea, 0x81, 0x5a, 0x39, 0x56, 0x33, 0x02, 0x92, 0x20, 0xe7, 0xf7, 0x94,
+	0x93, 0xaa, 0xb2, 0x5b, 0x57, 0xf6, 0x34, 0xac, 0xd4, 0xaa, 0x15, 0xb5, 0xa6, 0x64, 0x44, 0x94,
+	0x85, 0x65, 0x3e, 0x57, 0x2b, 0xda, 0x6e, 0x45, 0x55, 0x95, 0xdd, 0x7a, 0xb9, 0xa2, 0x66, 0x02,
+	0xe8, 0x29, 0xac, 0xf1, 0xc9, 0xb0, 0xad, 0xd5, 0xcb, 0x87, 0x4a, 0xe5, 0xa8, 0x9e, 0x09, 0xa2,
+	0x55, 0x58, 0xe2, 0x63, 0xac, 0x7c, 0xd8, 0xf3, 0x07, 0xa1, 0x11, 0xc4, 0x63, 0x5c, 0xae, 0x2b,
+	0xfe, 0x24, 0xbc, 0x73, 0x74, 0x7d, 0x2b, 0x89, 0x37, 0xb7, 0x92, 0xf8, 0xe7, 0x56, 0x12, 0xbf,
+	0xdd, 0x49, 0xc2, 0xcd, 0x9d, 0x24, 0xfc, 0xba, 0x93, 0x84, 0xd3, 0x6d, 0xdd, 0x70, 0xcf, 0xbb,
+	0xcd, 0x42, 0xcb, 0xea, 0x14, 0xcf, 0x2e, 0xbb, 0xc4, 0x74, 0xbb, 0x9d, 0xd7, 0xec, 0xbf, 0xc4,
+	0x2f, 0x8b, 0xde, 0x97, 0x6a, 0xfa, 0x2b, 0xd8, 0x8c, 0xb0, 0xc9, 0xdb, 0xbf, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0xfa, 0xf0, 0xd4, 0x04, 0x22, 0x07, 0x00, 0x00,
 }
 
 func (m *RemoteSignerError) Marshal() (dAtA []byte, err error) {
@@ -781,6 +792,15 @@ func (m *PubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.KeyTypes) > 0 {
+		for iNdEx := len(m.KeyTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyTypes[iNdEx])
+			copy(dAtA[i:], m.KeyTypes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
@@ -1290,6 +1310,12 @@ func (m *PubKeyRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if len(m.KeyTypes) > 0 {
+		for _, s := range m.KeyTypes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1669,6 +1695,38 @@ func (m *PubKeyRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyTypes = append(m.KeyTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -110,6 +110,9 @@ type BlockParams struct {
 	//
 	// Not exposed to the application.
 	TimeIotaMs int64 `protobuf:"varint,3,opt,name=time_iota_ms,json=timeIotaMs,proto3" json:"time_iota_ms,omitempty"`
+	// Height from which the proposer may put an ordering record first in a
+	// block, see types.NewOrderingRecord. 0 disables ordering records.
+	OrderingRecordHeight int64 `protobuf:"varint,4,opt,name=ordering_record_height,json=orderingRecordHeight,proto3" json:"ordering_record_height,omitempty"`
 }
 
 func (m *BlockParams) Reset()         { *m = BlockParams{} }
@@ -166,6 +169,13 @@ func (m *BlockParams) GetTimeIotaMs() int64 {
 	return 0
 }
 
+func (m *BlockParams) GetOrderingRecordHeight() int64 {
+	if m != nil {
+		return m.OrderingRecordHeight
+	}
+	return 0
+}
+
 // EvidenceParams determine how we handle evidence of malfeasance.
 type EvidenceParams struct {
 	// Max age of evidence, in blocks.
@@ -243,6 +253,11 @@ func (m *EvidenceParams) GetMaxBytes() int64 {
 // NOTE: uses ABCI pubkey naming, not Amino names.
 type ValidatorParams struct {
 	PubKeyTypes []string `protobuf:"bytes,1,rep,name=pub_key_types,json=pubKeyTypes,proto3" json:"pub_key_types,omitempty"`
+	// next_pub_key_types replace pub_key_types at switch_height, so that every
+	// node switches to the new validator key types at the same height. Both are
+	// unset if no switch is scheduled.
+	NextPubKeyTypes []string `protobuf:"bytes,2,rep,name=next_pub_key_types,json=nextPubKeyTypes,proto3" json:"next_pub_key_types,omitempty"`
+	SwitchHeight    int64    `protobuf:"varint,3,opt,name=switch_height,json=switchHeight,proto3" json:"switch_height,omitempty"`
 }
 
 func (m *ValidatorParams) Reset()         { *m = ValidatorParams{} }
@@ -285,6 +300,20 @@ func (m *ValidatorParams) GetPubKeyTypes() []string {
 	return nil
 }
 
+func (m *ValidatorParams) GetNextPubKeyTypes() []string {
+	if m != nil {
+		return m.NextPubKeyTypes
+	}
+	return nil
+}
+
+func (m *ValidatorParams) GetSwitchHeight() int64 {
+	if m != nil {
+		return m.SwitchHeight
+	}
+	return 0
+}
+
 // VersionParams contains the ABCI application version.
 type VersionParams struct {
 	AppVersion uint64 `protobuf:"varint,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
@@ -397,42 +426,46 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x18, 0xcc, 0xd6, 0xf9, 0xdb, 0xe4, 0x4b, 0xd3, 0x54, 0xab, 0x5f, 0x22, 0x14, 0xd5, 0x0e, 0x3e,
-	0xa0, 0x4a, 0x08, 0x5b, 0x82, 0x53, 0x7b, 0xa9, 0x30, 0x54, 0x05, 0xa1, 0x20, 0x64, 0x21, 0x90,
-	0x7a, 0xb1, 0xd6, 0xc9, 0xd6, 0xb1, 0x9a, 0xf5, 0x5a, 0xde, 0x75, 0x94, 0xbc, 0x05, 0xc7, 0x1e,
-	0x7b, 0x84, 0x37, 0xe0, 0x11, 0x7a, 0xec, 0x91, 0x13, 0xa0, 0xe4, 0xc2, 0x63, 0x20, 0xaf, 0xb3,
-	0x4d, 0x9d, 0x72, 0xf3, 0x7e, 0xdf, 0xcc, 0xac, 0x67, 0x46, 0x0b, 0xfb, 0x92, 0x26, 0x43, 0x9a,
-	0xb1, 0x38, 0x91, 0xae, 0x9c, 0xa5, 0x54, 0xb8, 0x29, 0xc9, 0x08, 0x13, 0x4e, 0x9a, 0x71, 0xc9,
-	0xf1, 0xee, 0x6a, 0xed, 0xa8, 0xf5, 0xde, 0xff, 0x11, 0x8f, 0xb8, 0x5a, 0xba, 0xc5, 0x57, 0x89,
-	0xdb, 0x33, 0x23, 0xce, 0xa3, 0x31, 0x75, 0xd5, 0x29, 0xcc, 0xcf, 0xdd, 0x61, 0x9e, 0x11, 0x19,
-	0xf3, 0xa4, 0xdc, 0xdb, 0x97, 0x1b, 0xd0, 0x79, 0xc5, 0x13, 0x41, 0x13, 0x91, 0x8b, 0x0f, 0xea,
-	0x06, 0x7c, 0x08, 0xff, 0x85, 0x63, 0x3e, 0xb8, 0xe8, 0xa2, 0x1e, 0x3a, 0x68, 0x3d, 0xdf, 0x77,
-	0xd6, 0xef, 0x72, 0xbc, 0x62, 0x5d, 0xa2, 0xbd, 0xfa, 0xf5, 0x4f, 0xab, 0xe6, 0x97, 0x0c, 0xec,
-	0x41, 0x83, 0x4e, 0xe2, 0x21, 0x4d, 0x06, 0xb4, 0xbb, 0xa1, 0xd8, 0xbd, 0xfb, 0xec, 0x93, 0x25,
-	0xa2, 0x22, 0x70, 0xcb, 0xc3, 0x27, 0xd0, 0x9c, 0x90, 0x71, 0x3c, 0x24, 0x92, 0x67, 0x5d, 0x43,
-	0x89, 0x3c, 0xbe, 0x2f, 0xf2, 0x49, 0x43, 0x2a, 0x2a, 0x2b, 0x26, 0x3e, 0x86, 0xad, 0x09, 0xcd,
-	0x44, 0xcc, 0x93, 0x6e, 0x5d, 0x89, 0x58, 0xff, 0x10, 0x29, 0x01, 0x15, 0x09, 0xcd, 0xb2, 0x29,
-	0xb4, 0xee, 0xf8, 0xc4, 0x8f, 0xa0, 0xc9, 0xc8, 0x34, 0x08, 0x67, 0x92, 0x0a, 0x95, 0x8c, 0xe1,
-	0x37, 0x18, 0x99, 0x7a, 0xc5, 0x19, 0x3f, 0x80, 0xad, 0x62, 0x19, 0x11, 0xa1, 0x6c, 0x1b, 0xfe,
-	0x26, 0x23, 0xd3, 0x53, 0x22, 0x70, 0x0f, 0xb6, 0x65, 0xcc, 0x68, 0x10, 0x73, 0x49, 0x02, 0x26,
-	0x94, 0x1f, 0xc3, 0x87, 0x62, 0xf6, 0x96, 0x4b, 0xd2, 0x17, 0xf6, 0x37, 0x04, 0x3b, 0xd5, 0x44,
-	0xf0, 0x53, 0xc0, 0x85, 0x1a, 0x89, 0x68, 0x90, 0xe4, 0x2c, 0x50, 0xd1, 0xea, 0x3b, 0x3b, 0x8c,
-	0x4c, 0x5f, 0x46, 0xf4, 0x7d, 0xce, 0xd4, 0xcf, 0x09, 0xdc, 0x87, 0x5d, 0x0d, 0xd6, 0xdd, 0x2e,
-	0xa3, 0x7f, 0xe8, 0x94, 0xe5, 0x3b, 0xba, 0x7c, 0xe7, 0xf5, 0x12, 0xe0, 0x35, 0x0a, 0xab, 0x97,
-	0xbf, 0x2c, 0xe4, 0xef, 0x94, 0x7a, 0x7a, 0x53, 0xb5, 0x69, 0x54, 0x6d, 0xda, 0xc7, 0xd0, 0x59,
-	0xcb, 0x1d, 0xdb, 0xd0, 0x4e, 0xf3, 0x30, 0xb8, 0xa0, 0xb3, 0x40, 0x65, 0xda, 0x45, 0x3d, 0xe3,
-	0xa0, 0xe9, 0xb7, 0xd2, 0x3c, 0x7c, 0x47, 0x67, 0x1f, 0x8b, 0xd1, 0x51, 0xe3, 0xfb, 0x95, 0x85,
-	0xfe, 0x5c, 0x59, 0xc8, 0x3e, 0x82, 0x76, 0x25, 0x73, 0x6c, 0x41, 0x8b, 0xa4, 0x69, 0xa0, 0x9b,
-	0x2a, 0x3c, 0xd6, 0x7d, 0x20, 0x69, 0xba, 0x84, 0xdd, 0xe1, 0x9e, 0xc1, 0xf6, 0x1b, 0x22, 0x46,
-	0x74, 0xb8, 0xa4, 0x3e, 0x81, 0x8e, 0x4a, 0x26, 0x58, 0xaf, 0xa5, 0xad, 0xc6, 0x7d, 0xdd, 0x8d,
-	0x0d, 0xed, 0x15, 0x6e, 0xd5, 0x50, 0x4b, 0xa3, 0x4e, 0x89, 0xf0, 0x3e, 0x7f, 0x9d, 0x9b, 0xe8,
-	0x7a, 0x6e, 0xa2, 0x9b, 0xb9, 0x89, 0x7e, 0xcf, 0x4d, 0xf4, 0x65, 0x61, 0xd6, 0x6e, 0x16, 0x66,
-	0xed, 0xc7, 0xc2, 0xac, 0x9d, 0x1d, 0x46, 0xb1, 0x1c, 0xe5, 0xa1, 0x33, 0xe0, 0xcc, 0x3d, 0x1f,
-	0xe7, 0x34, 0x91, 0x39, 0x7b, 0x36, 0x18, 0x91, 0x38, 0xb9, 0x3d, 0x96, 0x0f, 0xcc, 0x5d, 0x7f,
-	0xb4, 0xe1, 0xa6, 0x9a, 0xbf, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x99, 0xef, 0x6a, 0x8b, 0xcf,
-	0x03, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x10, 0xcf, 0xd6, 0xfd, 0xda, 0x74, 0xd2, 0x34, 0xd5, 0xaa, 0xfa, 0x08, 0x45, 0x75, 0x82, 0x91,
+	0x50, 0x25, 0x84, 0x2d, 0x01, 0x97, 0xf6, 0x82, 0x08, 0x54, 0x2d, 0x42, 0x45, 0x95, 0x85, 0x40,
+	0xea, 0xc5, 0x5a, 0xc7, 0x5b, 0xdb, 0x6a, 0xbc, 0x6b, 0x79, 0xd7, 0x25, 0x79, 0x0b, 0xb8, 0x55,
+	0xe2, 0xd2, 0x23, 0xbc, 0x01, 0x8f, 0xd0, 0x63, 0x8f, 0x9c, 0x00, 0xa5, 0x17, 0x1e, 0x03, 0x79,
+	0x9d, 0x6d, 0xe2, 0x94, 0x5b, 0x76, 0x7f, 0x7f, 0x76, 0xe6, 0x37, 0x13, 0xc3, 0x96, 0xa4, 0x2c,
+	0xa0, 0x59, 0x12, 0x33, 0xe9, 0xc8, 0x51, 0x4a, 0x85, 0x93, 0x92, 0x8c, 0x24, 0xc2, 0x4e, 0x33,
+	0x2e, 0x39, 0x5e, 0x9f, 0xc2, 0xb6, 0x82, 0x37, 0x37, 0x42, 0x1e, 0x72, 0x05, 0x3a, 0xc5, 0xaf,
+	0x92, 0xb7, 0x69, 0x86, 0x9c, 0x87, 0x03, 0xea, 0xa8, 0x93, 0x9f, 0x9f, 0x38, 0x41, 0x9e, 0x11,
+	0x19, 0x73, 0x56, 0xe2, 0xd6, 0xf9, 0x02, 0xb4, 0x5e, 0x72, 0x26, 0x28, 0x13, 0xb9, 0x38, 0x52,
+	0x2f, 0xe0, 0x1d, 0xf8, 0xcf, 0x1f, 0xf0, 0xfe, 0x69, 0x1b, 0x75, 0xd1, 0x76, 0xe3, 0xc9, 0x96,
+	0x3d, 0xff, 0x96, 0xdd, 0x2b, 0xe0, 0x92, 0xdd, 0x5b, 0xbc, 0xfc, 0xd9, 0xa9, 0xb9, 0xa5, 0x02,
+	0xf7, 0xa0, 0x4e, 0xcf, 0xe2, 0x80, 0xb2, 0x3e, 0x6d, 0x2f, 0x28, 0x75, 0xf7, 0xb6, 0x7a, 0x6f,
+	0xc2, 0xa8, 0x18, 0xdc, 0xe8, 0xf0, 0x1e, 0xac, 0x9c, 0x91, 0x41, 0x1c, 0x10, 0xc9, 0xb3, 0xb6,
+	0xa1, 0x4c, 0xee, 0xdf, 0x36, 0x79, 0xaf, 0x29, 0x15, 0x97, 0xa9, 0x12, 0x3f, 0x87, 0xe5, 0x33,
+	0x9a, 0x89, 0x98, 0xb3, 0xf6, 0xa2, 0x32, 0xe9, 0xfc, 0xc3, 0xa4, 0x24, 0x54, 0x2c, 0xb4, 0xca,
+	0xfa, 0x82, 0xa0, 0x31, 0xd3, 0x28, 0xbe, 0x07, 0x2b, 0x09, 0x19, 0x7a, 0xfe, 0x48, 0x52, 0xa1,
+	0xa2, 0x31, 0xdc, 0x7a, 0x42, 0x86, 0xbd, 0xe2, 0x8c, 0xef, 0xc0, 0x72, 0x01, 0x86, 0x44, 0xa8,
+	0xbe, 0x0d, 0x77, 0x29, 0x21, 0xc3, 0x7d, 0x22, 0x70, 0x17, 0x56, 0x65, 0x9c, 0x50, 0x2f, 0xe6,
+	0x92, 0x78, 0x89, 0x50, 0x0d, 0x19, 0x2e, 0x14, 0x77, 0xaf, 0xb9, 0x24, 0x87, 0x02, 0x3f, 0x83,
+	0xff, 0x79, 0x16, 0xd0, 0x2c, 0x66, 0xa1, 0x97, 0xd1, 0x3e, 0xcf, 0x02, 0x2f, 0xa2, 0x71, 0x18,
+	0x49, 0x55, 0xb7, 0xe1, 0x6e, 0x68, 0xd4, 0x55, 0xe0, 0x81, 0xc2, 0xac, 0x6f, 0x08, 0xd6, 0xaa,
+	0x41, 0xe2, 0x47, 0x80, 0x8b, 0x1a, 0x48, 0x48, 0x3d, 0x96, 0x27, 0x9e, 0x9a, 0x88, 0xae, 0xb4,
+	0x95, 0x90, 0xe1, 0x8b, 0x90, 0xbe, 0xcd, 0x13, 0xd5, 0x92, 0xc0, 0x87, 0xb0, 0xae, 0xc9, 0x7a,
+	0x25, 0x26, 0x13, 0xbb, 0x6b, 0x97, 0x3b, 0x63, 0xeb, 0x9d, 0xb1, 0x5f, 0x4d, 0x08, 0xbd, 0x7a,
+	0x91, 0xd0, 0xf9, 0xaf, 0x0e, 0x72, 0xd7, 0x4a, 0x3f, 0x8d, 0x54, 0xc3, 0x31, 0xaa, 0xe1, 0x58,
+	0x9f, 0x11, 0xb4, 0xe6, 0xe6, 0x85, 0x2d, 0x68, 0xa6, 0xb9, 0xef, 0x9d, 0xd2, 0x91, 0xa7, 0x66,
+	0xd1, 0x46, 0x5d, 0x63, 0x7b, 0xc5, 0x6d, 0xa4, 0xb9, 0xff, 0x86, 0x8e, 0xde, 0x15, 0x57, 0x45,
+	0x43, 0x8c, 0x0e, 0xa5, 0x57, 0x25, 0x2e, 0x28, 0x62, 0xab, 0x40, 0x8e, 0x66, 0xc8, 0x0f, 0xa0,
+	0x29, 0x3e, 0xc6, 0xb2, 0x1f, 0xe9, 0xf4, 0xca, 0x2a, 0x56, 0xcb, 0xcb, 0x32, 0xb5, 0xdd, 0xfa,
+	0xf7, 0x8b, 0x0e, 0xfa, 0x73, 0xd1, 0x41, 0xd6, 0x2e, 0x34, 0x2b, 0xd3, 0xc7, 0x1d, 0x68, 0x90,
+	0x34, 0xf5, 0xf4, 0xce, 0x14, 0xb1, 0x2d, 0xba, 0x40, 0xd2, 0x74, 0x42, 0x9b, 0xd1, 0x1e, 0xc3,
+	0xea, 0x01, 0x11, 0x11, 0x0d, 0x26, 0xd2, 0x87, 0xd0, 0x52, 0x61, 0x7b, 0xf3, 0xfb, 0xd1, 0x54,
+	0xd7, 0x87, 0x7a, 0x49, 0x2c, 0x68, 0x4e, 0x79, 0xd3, 0x55, 0x69, 0x68, 0xd6, 0x3e, 0x11, 0xbd,
+	0x0f, 0xc7, 0x3b, 0x61, 0x2c, 0xa3, 0xdc, 0xb7, 0xfb, 0x3c, 0x71, 0x4e, 0x06, 0x39, 0x65, 0x32,
+	0x4f, 0x1e, 0xf7, 0x23, 0x12, 0xb3, 0x9b, 0x63, 0xf9, 0x77, 0x76, 0xe6, 0x3f, 0x11, 0x5f, 0xc7,
+	0x26, 0xba, 0x1c, 0x9b, 0xe8, 0x6a, 0x6c, 0xa2, 0xdf, 0x63, 0x13, 0x7d, 0xba, 0x36, 0x6b, 0x57,
+	0xd7, 0x66, 0xed, 0xc7, 0xb5, 0x59, 0xf3, 0x97, 0x94, 0xe6, 0xe9, 0xdf, 0x01, 0x00, 0xae, 0x54,
+	0xb2, 0x2b, 0x59, 0x04, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -496,6 +529,9 @@ func (this *BlockParams) Equal(that interface{}) bool {
 	if this.TimeIotaMs != that1.TimeIotaMs {
 		return false
 	}
+	if this.OrderingRecordHeight != that1.OrderingRecordHeight {
+		return false
+	}
 	return true
 }
 func (this *EvidenceParams) Equal(that interface{}) bool {
@@ -555,6 +591,17 @@ func (this *ValidatorParams) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.NextPubKeyTypes) != len(that1.NextPubKeyTypes) {
+		return false
+	}
+	for i := range this.NextPubKeyTypes {
+		if this.NextPubKeyTypes[i] != that1.NextPubKeyTypes[i] {
+			return false
+		}
+	}
+	if this.SwitchHeight != that1.SwitchHeight {
+		return false
+	}
 	return true
 }
 func (this *VersionParams) Equal(that interface{}) bool {
@@ -691,6 +738,11 @@ func (m *BlockParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OrderingRecordHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderingRecordHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeIotaMs != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.TimeIotaMs))
 		i--
@@ -770,6 +822,20 @@ func (m *ValidatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SwitchHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SwitchHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPubKeyTypes) > 0 {
+		for iNdEx := len(m.NextPubKeyTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NextPubKeyTypes[iNdEx])
+			copy(dAtA[i:], m.NextPubKeyTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.NextPubKeyTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.PubKeyTypes) > 0 {
 		for iNdEx := len(m.PubKeyTypes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PubKeyTypes[iNdEx])
@@ -861,6 +927,15 @@ func NewPopulatedValidatorParams(r randyParams, easy bool) *ValidatorParams {
 	for i := 0; i < v1; i++ {
 		this.PubKeyTypes[i] = string(randStringParams(r))
 	}
+	v2 := r.Intn(10)
+	this.NextPubKeyTypes = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.NextPubKeyTypes[i] = string(randStringParams(r))
+	}
+	this.SwitchHeight = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.SwitchHeight *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -893,9 +968,9 @@ func randUTF8RuneParams(r randyParams) rune {
 	return rune(ru + 61)
 }
 func randStringParams(r randyParams) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
 		tmps[i] = randUTF8RuneParams(r)
 	}
 	return string(tmps)
@@ -917,11 +992,11 @@ func randFieldParams(dAtA []byte, r randyParams, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateParams(dAtA, uint64(key))
-		v3 := r.Int63()
+		v4 := r.Int63()
 		if r.Intn(2) == 0 {
-			v3 *= -1
+			v4 *= -1
 		}
-		dAtA = encodeVarintPopulateParams(dAtA, uint64(v3))
+		dAtA = encodeVarintPopulateParams(dAtA, uint64(v4))
 	case 1:
 		dAtA = encodeVarintPopulateParams(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -978,6 +1053,9 @@ func (m *BlockParams) Size() (n int) {
 	if m.TimeIotaMs != 0 {
 		n += 1 + sovParams(uint64(m.TimeIotaMs))
 	}
+	if m.OrderingRecordHeight != 0 {
+		n += 1 + sovParams(uint64(m.OrderingRecordHeight))
+	}
 	return n
 }
 
@@ -1010,6 +1088,15 @@ func (m *ValidatorParams) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.NextPubKeyTypes) > 0 {
+		for _, s := range m.NextPubKeyTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SwitchHeight != 0 {
+		n += 1 + sovParams(uint64(m.SwitchHeight))
+	}
 	return n
 }
 
@@ -1314,6 +1401,25 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderingRecordHeight", wireType)
+			}
+			m.OrderingRecordHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderingRecordHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1517,6 +1623,57 @@ func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
 			}
 			m.PubKeyTypes = append(m.PubKeyTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPubKeyTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPubKeyTypes = append(m.NextPubKeyTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchHeight", wireType)
+			}
+			m.SwitchHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwitchHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -473,6 +473,9 @@ type Vote struct {
 	ValidatorAddress []byte        `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	ValidatorIndex   int32         `protobuf:"varint,7,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
 	Signature        []byte        `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// quantum_signature is the post-quantum signature of validators with
+	// dilithium or hybrid keys, over the same sign bytes as signature.
+	QuantumSignature []byte `protobuf:"bytes,9,opt,name=quantum_signature,json=quantumSignature,proto3" json:"quantum_signature,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -564,6 +567,13 @@ func (m *Vote) GetSignature() []byte {
 	return nil
 }
 
+func (m *Vote) GetQuantumSignature() []byte {
+	if m != nil {
+		return m.QuantumSignature
+	}
+	return nil
+}
+
 // Commit contains the evidence that a block was committed by a set of validators.
 type Commit struct {
 	Height     int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -639,6 +649,7 @@ type CommitSig struct {
 	ValidatorAddress []byte      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Timestamp        time.Time   `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 	Signature        []byte      `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	QuantumSignature []byte      `protobuf:"bytes,5,opt,name=quantum_signature,json=quantumSignature,proto3" json:"quantum_signature,omitempty"`
 }
 
 func (m *CommitSig) Reset()         { *m = CommitSig{} }
@@ -702,14 +713,22 @@ func (m *CommitSig) GetSignature() []byte {
 	return nil
 }
 
+func (m *CommitSig) GetQuantumSignature() []byte {
+	if m != nil {
+		return m.QuantumSignature
+	}
+	return nil
+}
+
 type Proposal struct {
-	Type      SignedMsgType `protobuf:"varint,1,opt,name=type,proto3,enum=tendermint.types.SignedMsgType" json:"type,omitempty"`
-	Height    int64         `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Round     int32         `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
-	PolRound  int32         `protobuf:"varint,4,opt,name=pol_round,json=polRound,proto3" json:"pol_round,omitempty"`
-	BlockID   BlockID       `protobuf:"bytes,5,opt,name=block_id,json=blockId,proto3" json:"block_id"`
-	Timestamp time.Time     `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
-	Signature []byte        `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	Type             SignedMsgType `protobuf:"varint,1,opt,name=type,proto3,enum=tendermint.types.SignedMsgType" json:"type,omitempty"`
+	Height           int64         `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round            int32         `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	PolRound         int32         `protobuf:"varint,4,opt,name=pol_round,json=polRound,proto3" json:"pol_round,omitempty"`
+	BlockID          BlockID       `protobuf:"bytes,5,opt,name=block_id,json=blockId,proto3" json:"block_id"`
+	Timestamp        time.Time     `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Signature        []byte        `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	QuantumSignature []byte        `protobuf:"bytes,8,opt,name=quantum_signature,json=quantumSignature,proto3" json:"quantum_signature,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -794,6 +813,13 @@ func (m *Proposal) GetSignature() []byte {
 	return nil
 }
 
+func (m *Proposal) GetQuantumSignature() []byte {
+	if m != nil {
+		return m.QuantumSignature
+	}
+	return nil
+}
+
 type SignedHeader struct {
 	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Commit *Commit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -1048,88 +1074,90 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/types.proto", fileDescriptor_d3a6e55e2345de56) }
 
 var fileDescriptor_d3a6e55e2345de56 = []byte{
-	// 1292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1a, 0xc7,
-	0x17, 0xf7, 0x9a, 0xb5, 0x81, 0x07, 0xd8, 0x78, 0xe5, 0x24, 0x84, 0x24, 0x18, 0xf1, 0xd5, 0xb7,
-	0x75, 0xd2, 0x16, 0xd2, 0x44, 0xaa, 0x9a, 0x43, 0x0f, 0x80, 0x9d, 0x04, 0xc5, 0xc6, 0x68, 0x21,
-	0xa9, 0xda, 0xcb, 0x6a, 0xf1, 0x8e, 0x61, 0x95, 0x65, 0x67, 0xb5, 0x33, 0xb8, 0x4e, 0xfe, 0x82,
-	0xca, 0xa7, 0x9c, 0x7a, 0xf3, 0xa9, 0x3d, 0xf4, 0xde, 0x7f, 0xa0, 0xea, 0x29, 0xc7, 0xdc, 0xda,
-	0x4b, 0xd3, 0xca, 0x91, 0xaa, 0xfe, 0x19, 0xd5, 0xbc, 0x19, 0x96, 0xc5, 0xd8, 0x6d, 0x15, 0x45,
-	0xbd, 0xa0, 0x9d, 0xf7, 0x3e, 0x6f, 0xe6, 0xbd, 0xcf, 0xfb, 0xcc, 0x0f, 0xe0, 0x3a, 0x27, 0xbe,
-	0x43, 0xc2, 0x91, 0xeb, 0xf3, 0x1a, 0x7f, 0x16, 0x10, 0x26, 0x7f, 0xab, 0x41, 0x48, 0x39, 0x35,
-	0xf2, 0x53, 0x6f, 0x15, 0xed, 0xc5, 0xf5, 0x01, 0x1d, 0x50, 0x74, 0xd6, 0xc4, 0x97, 0xc4, 0x15,
-	0x37, 0x06, 0x94, 0x0e, 0x3c, 0x52, 0xc3, 0x51, 0x7f, 0x7c, 0x50, 0xe3, 0xee, 0x88, 0x30, 0x6e,
-	0x8f, 0x02, 0x05, 0x28, 0xc5, 0x96, 0x39, 0x24, 0x21, 0x73, 0xa9, 0x1f, 0x5f, 0xa8, 0x58, 0x9e,
-	0x4b, 0xe3, 0xd0, 0xf6, 0x5c, 0xc7, 0xe6, 0x34, 0x94, 0x88, 0xca, 0x3d, 0xc8, 0x75, 0xec, 0x90,
-	0x77, 0x09, 0x7f, 0x48, 0x6c, 0x87, 0x84, 0xc6, 0x3a, 0x2c, 0x71, 0xca, 0x6d, 0xaf, 0xa0, 0x95,
-	0xb5, 0xcd, 0x9c, 0x29, 0x07, 0x86, 0x01, 0xfa, 0xd0, 0x66, 0xc3, 0xc2, 0x62, 0x59, 0xdb, 0xcc,
-	0x9a, 0xf8, 0x5d, 0x79, 0x08, 0xba, 0x08, 0x15, 0x11, 0xae, 0xef, 0x90, 0xa3, 0x49, 0x04, 0x0e,
-	0x84, 0xb5, 0xff, 0x8c, 0x13, 0xa6, 0x42, 0xe4, 0x40, 0x58, 0x83, 0x90, 0xd2, 0x83, 0x42, 0x42,
-	0x5a, 0x71, 0x50, 0xf1, 0x20, 0xd9, 0xf0, 0xe8, 0xfe, 0xd3, 0xd6, 0x56, 0xb4, 0x90, 0x36, 0x5d,
-	0xc8, 0xd8, 0x85, 0xd5, 0xc0, 0x0e, 0xb9, 0xc5, 0x08, 0xb7, 0x86, 0x98, 0x25, 0x4e, 0x9a, 0xb9,
-	0xb3, 0x51, 0x3d, 0x4b, 0x64, 0x75, 0xa6, 0x98, 0x86, 0xfe, 0xf2, 0xf5, 0xc6, 0x82, 0x99, 0x0b,
-	0xe2, 0xc6, 0xca, 0x1f, 0x3a, 0x2c, 0xab, 0x62, 0x3f, 0x83, 0xa4, 0xa2, 0x0d, 0x17, 0xcc, 0xdc,
-	0xb9, 0x11, 0x9f, 0x51, 0xb9, 0xaa, 0x4d, 0xea, 0x33, 0xe2, 0xb3, 0x31, 0x53, 0xf3, 0x4d, 0x62,
-	0x8c, 0xf7, 0x20, 0xb5, 0x3f, 0xb4, 0x5d, 0xdf, 0x72, 0x1d, 0xcc, 0x28, 0xdd, 0xc8, 0x9c, 0xbe,
-	0xde, 0x48, 0x36, 0x85, 0xad, 0xb5, 0x65, 0x26, 0xd1, 0xd9, 0x72, 0x8c, 0xcb, 0xb0, 0x3c, 0x24,
-	0xee, 0x60, 0xc8, 0xb1, 0xec, 0x84, 0xa9, 0x46, 0xc6, 0xa7, 0xa0, 0x8b, 0x8e, 0x16, 0x74, 0x5c,
-	0xbb, 0x58, 0x95, 0xed, 0xae, 0x4e, 0xda, 0x5d, 0xed, 0x4d, 0xda, 0xdd, 0x48, 0x89, 0x85, 0x5f,
-	0xfc, 0xb6, 0xa1, 0x99, 0x18, 0x61, 0x34, 0x21, 0xe7, 0xd9, 0x8c, 0x5b, 0x7d, 0x41, 0x9b, 0x58,
-	0x7e, 0x09, 0xa7, 0xb8, 0x3a, 0x4f, 0x88, 0x22, 0x56, 0xa5, 0x9e, 0x11, 0x51, 0xd2, 0xe4, 0x18,
-	0x9b, 0x90, 0xc7, 0x49, 0xf6, 0xe9, 0x68, 0xe4, 0x72, 0x0b, 0x79, 0x5f, 0x46, 0xde, 0x57, 0x84,
-	0xbd, 0x89, 0xe6, 0x87, 0xa2, 0x03, 0xd7, 0x20, 0xed, 0xd8, 0xdc, 0x96, 0x90, 0x24, 0x42, 0x52,
-	0xc2, 0x80, 0xce, 0xf7, 0x61, 0x35, 0x52, 0x15, 0x93, 0x90, 0x94, 0x9c, 0x65, 0x6a, 0x46, 0xe0,
-	0x6d, 0x58, 0xf7, 0xc9, 0x11, 0xb7, 0xce, 0xa2, 0xd3, 0x88, 0x36, 0x84, 0xef, 0xc9, 0x6c, 0xc4,
-	0xff, 0x61, 0x65, 0x7f, 0x42, 0xbe, 0xc4, 0x02, 0x62, 0x73, 0x91, 0x15, 0x61, 0x57, 0x21, 0x65,
-	0x07, 0x81, 0x04, 0x64, 0x10, 0x90, 0xb4, 0x83, 0x00, 0x5d, 0xb7, 0x60, 0x0d, 0x6b, 0x0c, 0x09,
-	0x1b, 0x7b, 0x5c, 0x4d, 0x92, 0x45, 0xcc, 0xaa, 0x70, 0x98, 0xd2, 0x8e, 0xd8, 0xff, 0x41, 0x8e,
-	0x1c, 0xba, 0x0e, 0xf1, 0xf7, 0x89, 0xc4, 0xe5, 0x10, 0x97, 0x9d, 0x18, 0x11, 0x74, 0x13, 0xf2,
-	0x41, 0x48, 0x03, 0xca, 0x48, 0x68, 0xd9, 0x8e, 0x13, 0x12, 0xc6, 0x0a, 0x2b, 0x72, 0xbe, 0x89,
-	0xbd, 0x2e, 0xcd, 0x95, 0x02, 0xe8, 0x5b, 0x36, 0xb7, 0x8d, 0x3c, 0x24, 0xf8, 0x11, 0x2b, 0x68,
-	0xe5, 0xc4, 0x66, 0xd6, 0x14, 0x9f, 0x95, 0x3f, 0x17, 0x41, 0x7f, 0x42, 0x39, 0x31, 0xee, 0x82,
-	0x2e, 0xda, 0x84, 0xea, 0x5b, 0x39, 0x4f, 0xcf, 0x5d, 0x77, 0xe0, 0x13, 0x67, 0x97, 0x0d, 0x7a,
-	0xcf, 0x02, 0x62, 0x22, 0x38, 0x26, 0xa7, 0xc5, 0x19, 0x39, 0xad, 0xc3, 0x52, 0x48, 0xc7, 0xbe,
-	0x83, 0x2a, 0x5b, 0x32, 0xe5, 0xc0, 0xd8, 0x86, 0x54, 0xa4, 0x12, 0xfd, 0x9f, 0x54, 0xb2, 0x2a,
-	0x54, 0x22, 0x34, 0xac, 0x0c, 0x66, 0xb2, 0xaf, 0xc4, 0xd2, 0x80, 0x74, 0x74, 0xfa, 0x28, 0xb5,
-	0xfd, 0x3b, 0xc1, 0x4e, 0xc3, 0x8c, 0x0f, 0x60, 0x2d, 0xea, 0x7d, 0x44, 0x9e, 0x54, 0x5c, 0x3e,
-	0x72, 0x28, 0xf6, 0x66, 0x64, 0x65, 0xc9, 0x03, 0x26, 0x89, 0x75, 0x4d, 0x65, 0xd5, 0xc2, 0x93,
-	0xe6, 0x3a, 0xa4, 0x99, 0x3b, 0xf0, 0x6d, 0x3e, 0x0e, 0x89, 0x52, 0xde, 0xd4, 0x50, 0xf9, 0x51,
-	0x83, 0x65, 0xa9, 0xe4, 0x18, 0x6f, 0xda, 0xf9, 0xbc, 0x2d, 0x5e, 0xc4, 0x5b, 0xe2, 0xed, 0x79,
-	0xab, 0x03, 0x44, 0xc9, 0xb0, 0x82, 0x5e, 0x4e, 0x6c, 0x66, 0xee, 0x5c, 0x9b, 0x9f, 0x48, 0xa6,
-	0xd8, 0x75, 0x07, 0x6a, 0xa3, 0xc6, 0x82, 0x2a, 0xbf, 0x6a, 0x90, 0x8e, 0xfc, 0x46, 0x1d, 0x72,
-	0x93, 0xbc, 0xac, 0x03, 0xcf, 0x1e, 0x28, 0xed, 0xdc, 0xb8, 0x30, 0xb9, 0xfb, 0x9e, 0x3d, 0x30,
-	0x33, 0x2a, 0x1f, 0x31, 0x38, 0xbf, 0x0f, 0x8b, 0x17, 0xf4, 0x61, 0xa6, 0xf1, 0x89, 0xb7, 0x6b,
-	0xfc, 0x4c, 0x8b, 0xf4, 0xb3, 0x2d, 0xfa, 0x61, 0x11, 0x52, 0x1d, 0xdc, 0x3b, 0xb6, 0xf7, 0x5f,
-	0xec, 0x88, 0x6b, 0x90, 0x0e, 0xa8, 0x67, 0x49, 0x8f, 0x8e, 0x9e, 0x54, 0x40, 0x3d, 0x73, 0xae,
-	0xed, 0x4b, 0xef, 0x68, 0xbb, 0x2c, 0xbf, 0x03, 0xd6, 0x92, 0x67, 0x59, 0x0b, 0x21, 0x2b, 0xa9,
-	0x50, 0x77, 0xd9, 0x6d, 0xc1, 0x01, 0x5e, 0x8e, 0xf2, 0x2a, 0x2b, 0xcc, 0xa7, 0x2d, 0x91, 0xa6,
-	0xc2, 0x89, 0x08, 0x79, 0xf4, 0xab, 0xeb, 0xb4, 0x70, 0x91, 0x2c, 0x4d, 0x85, 0xab, 0x7c, 0xa3,
-	0x01, 0xec, 0x08, 0x66, 0xb1, 0x5e, 0x71, 0x0b, 0x31, 0x4c, 0xc1, 0x9a, 0x59, 0xb9, 0x74, 0x51,
-	0xd3, 0xd4, 0xfa, 0x59, 0x16, 0xcf, 0xbb, 0x09, 0xb9, 0xa9, 0x18, 0x19, 0x99, 0x24, 0x73, 0xce,
-	0x24, 0xd1, 0xe5, 0xd0, 0x25, 0xdc, 0xcc, 0x1e, 0xc6, 0x46, 0x95, 0x9f, 0x34, 0x48, 0x63, 0x4e,
-	0xbb, 0x84, 0xdb, 0x33, 0x3d, 0xd4, 0xde, 0xbe, 0x87, 0x37, 0x00, 0xe4, 0x34, 0xcc, 0x7d, 0x4e,
-	0x94, 0xb2, 0xd2, 0x68, 0xe9, 0xba, 0xcf, 0x89, 0xf1, 0x49, 0x44, 0x78, 0xe2, 0xef, 0x09, 0x57,
-	0x5b, 0x7a, 0x42, 0xfb, 0x15, 0x48, 0xfa, 0xe3, 0x91, 0x25, 0xae, 0x04, 0x5d, 0xaa, 0xd5, 0x1f,
-	0x8f, 0x7a, 0x47, 0xac, 0xd2, 0x81, 0x64, 0xef, 0xa8, 0x23, 0x5e, 0x44, 0x42, 0xa2, 0x21, 0xa5,
-	0xea, 0x4e, 0x96, 0x6f, 0xa1, 0x94, 0x30, 0xe0, 0x15, 0x64, 0x80, 0x2e, 0x2e, 0xdf, 0xc9, 0x63,
-	0x4c, 0x7c, 0x9f, 0xff, 0xb0, 0xba, 0xf5, 0xb3, 0x06, 0x99, 0xd8, 0x29, 0x60, 0x7c, 0x0c, 0x97,
-	0x1a, 0x3b, 0x7b, 0xcd, 0x47, 0x56, 0x6b, 0xcb, 0xba, 0xbf, 0x53, 0x7f, 0x60, 0x3d, 0x6e, 0x3f,
-	0x6a, 0xef, 0x7d, 0xde, 0xce, 0x2f, 0x14, 0x2f, 0x1f, 0x9f, 0x94, 0x8d, 0x18, 0xf6, 0xb1, 0xff,
-	0xd4, 0xa7, 0x5f, 0xf9, 0x46, 0x0d, 0xd6, 0x67, 0x43, 0xea, 0x8d, 0xee, 0x76, 0xbb, 0x97, 0xd7,
-	0x8a, 0x97, 0x8e, 0x4f, 0xca, 0x6b, 0xb1, 0x88, 0x7a, 0x9f, 0x11, 0x9f, 0xcf, 0x07, 0x34, 0xf7,
-	0x76, 0x77, 0x5b, 0xbd, 0xfc, 0xe2, 0x5c, 0x80, 0x3a, 0x96, 0x6f, 0xc2, 0xda, 0x6c, 0x40, 0xbb,
-	0xb5, 0x93, 0x4f, 0x14, 0x8d, 0xe3, 0x93, 0xf2, 0x4a, 0x0c, 0xdd, 0x76, 0xbd, 0x62, 0xea, 0xeb,
-	0x6f, 0x4b, 0x0b, 0xdf, 0x7f, 0x57, 0xd2, 0x44, 0x65, 0xb9, 0x99, 0x93, 0xc0, 0xf8, 0x10, 0xae,
-	0x74, 0x5b, 0x0f, 0xda, 0xdb, 0x5b, 0xd6, 0x6e, 0xf7, 0x81, 0xd5, 0xfb, 0xa2, 0xb3, 0x1d, 0xab,
-	0x6e, 0xf5, 0xf8, 0xa4, 0x9c, 0x51, 0x25, 0x5d, 0x84, 0xee, 0x98, 0xdb, 0x4f, 0xf6, 0x7a, 0xdb,
-	0x79, 0x4d, 0xa2, 0x3b, 0x21, 0x39, 0xa4, 0x9c, 0x20, 0xfa, 0x36, 0x5c, 0x3d, 0x07, 0x1d, 0x15,
-	0xb6, 0x76, 0x7c, 0x52, 0xce, 0x75, 0x42, 0x22, 0x77, 0x09, 0x46, 0x54, 0xa1, 0x30, 0x1f, 0xb1,
-	0xd7, 0xd9, 0xeb, 0xd6, 0x77, 0xf2, 0xe5, 0x62, 0xfe, 0xf8, 0xa4, 0x9c, 0x9d, 0x1c, 0x79, 0x02,
-	0x3f, 0xad, 0xac, 0xd1, 0x7d, 0x79, 0x5a, 0xd2, 0x5e, 0x9d, 0x96, 0xb4, 0xdf, 0x4f, 0x4b, 0xda,
-	0x8b, 0x37, 0xa5, 0x85, 0x57, 0x6f, 0x4a, 0x0b, 0xbf, 0xbc, 0x29, 0x2d, 0x7c, 0x79, 0x6f, 0xe0,
-	0xf2, 0xe1, 0xb8, 0x5f, 0xdd, 0xa7, 0xa3, 0xda, 0x81, 0x37, 0x26, 0x3e, 0x1f, 0x8f, 0x3e, 0xc2,
-	0x37, 0x66, 0x34, 0x94, 0x7f, 0x15, 0x6a, 0x67, 0x9f, 0xfd, 0xfd, 0x65, 0xb4, 0xdf, 0xfd, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0xd5, 0xbb, 0x76, 0x0a, 0x98, 0x0c, 0x00, 0x00,
+	// 1313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1a, 0x47,
+	0x14, 0xf7, 0xc2, 0xda, 0xc0, 0x03, 0xec, 0xf5, 0xca, 0x49, 0x08, 0x49, 0x30, 0xa2, 0x6a, 0xeb,
+	0xa4, 0x2d, 0xa4, 0x89, 0x54, 0x35, 0x87, 0x1e, 0x00, 0x3b, 0x09, 0x8a, 0x8d, 0xd1, 0x42, 0x52,
+	0xb5, 0x97, 0xd5, 0xe2, 0x1d, 0xc3, 0x2a, 0xcb, 0xce, 0x76, 0x67, 0x70, 0x9d, 0x7c, 0x82, 0x8a,
+	0x53, 0x2e, 0xed, 0xcd, 0xa7, 0xf6, 0xd0, 0x8f, 0x51, 0xf5, 0x94, 0x63, 0x6e, 0xed, 0x29, 0x8d,
+	0x1c, 0xa9, 0x9f, 0xa3, 0x9a, 0x3f, 0xbb, 0x2c, 0x01, 0xda, 0x28, 0x8a, 0x7a, 0x41, 0x3b, 0xef,
+	0xfd, 0xde, 0xcc, 0x7b, 0xbf, 0xf7, 0x9b, 0x3f, 0xc0, 0x55, 0x8a, 0x3c, 0x1b, 0x05, 0x23, 0xc7,
+	0xa3, 0x35, 0xfa, 0xc4, 0x47, 0x44, 0xfc, 0x56, 0xfd, 0x00, 0x53, 0xac, 0x6b, 0x53, 0x6f, 0x95,
+	0xdb, 0x8b, 0x5b, 0x03, 0x3c, 0xc0, 0xdc, 0x59, 0x63, 0x5f, 0x02, 0x57, 0xdc, 0x1e, 0x60, 0x3c,
+	0x70, 0x51, 0x8d, 0x8f, 0xfa, 0xe3, 0xe3, 0x1a, 0x75, 0x46, 0x88, 0x50, 0x6b, 0xe4, 0x4b, 0x40,
+	0x29, 0xb6, 0xcc, 0x09, 0x0a, 0x88, 0x83, 0xbd, 0xf8, 0x42, 0xc5, 0xf2, 0x5c, 0x1a, 0x27, 0x96,
+	0xeb, 0xd8, 0x16, 0xc5, 0x81, 0x40, 0x54, 0xee, 0x40, 0xbe, 0x63, 0x05, 0xb4, 0x8b, 0xe8, 0x7d,
+	0x64, 0xd9, 0x28, 0xd0, 0xb7, 0x60, 0x95, 0x62, 0x6a, 0xb9, 0x05, 0xa5, 0xac, 0xec, 0xe4, 0x0d,
+	0x31, 0xd0, 0x75, 0x50, 0x87, 0x16, 0x19, 0x16, 0x12, 0x65, 0x65, 0x27, 0x67, 0xf0, 0xef, 0xca,
+	0x7d, 0x50, 0x59, 0x28, 0x8b, 0x70, 0x3c, 0x1b, 0x9d, 0x86, 0x11, 0x7c, 0xc0, 0xac, 0xfd, 0x27,
+	0x14, 0x11, 0x19, 0x22, 0x06, 0xcc, 0xea, 0x07, 0x18, 0x1f, 0x17, 0x92, 0xc2, 0xca, 0x07, 0x15,
+	0x17, 0x52, 0x0d, 0x17, 0x1f, 0x3d, 0x6e, 0xed, 0x46, 0x0b, 0x29, 0xd3, 0x85, 0xf4, 0x03, 0xd8,
+	0xf0, 0xad, 0x80, 0x9a, 0x04, 0x51, 0x73, 0xc8, 0xb3, 0xe4, 0x93, 0x66, 0x6f, 0x6d, 0x57, 0xdf,
+	0x24, 0xb2, 0x3a, 0x53, 0x4c, 0x43, 0x7d, 0xfe, 0x72, 0x7b, 0xc5, 0xc8, 0xfb, 0x71, 0x63, 0xe5,
+	0x6f, 0x15, 0xd6, 0x64, 0xb1, 0x5f, 0x41, 0x4a, 0xd2, 0xc6, 0x17, 0xcc, 0xde, 0xba, 0x16, 0x9f,
+	0x51, 0xba, 0xaa, 0x4d, 0xec, 0x11, 0xe4, 0x91, 0x31, 0x91, 0xf3, 0x85, 0x31, 0xfa, 0x47, 0x90,
+	0x3e, 0x1a, 0x5a, 0x8e, 0x67, 0x3a, 0x36, 0xcf, 0x28, 0xd3, 0xc8, 0x9e, 0xbf, 0xdc, 0x4e, 0x35,
+	0x99, 0xad, 0xb5, 0x6b, 0xa4, 0xb8, 0xb3, 0x65, 0xeb, 0x17, 0x61, 0x6d, 0x88, 0x9c, 0xc1, 0x90,
+	0xf2, 0xb2, 0x93, 0x86, 0x1c, 0xe9, 0x5f, 0x82, 0xca, 0x3a, 0x5a, 0x50, 0xf9, 0xda, 0xc5, 0xaa,
+	0x68, 0x77, 0x35, 0x6c, 0x77, 0xb5, 0x17, 0xb6, 0xbb, 0x91, 0x66, 0x0b, 0x3f, 0xfb, 0x6b, 0x5b,
+	0x31, 0x78, 0x84, 0xde, 0x84, 0xbc, 0x6b, 0x11, 0x6a, 0xf6, 0x19, 0x6d, 0x6c, 0xf9, 0x55, 0x3e,
+	0xc5, 0xe5, 0x79, 0x42, 0x24, 0xb1, 0x32, 0xf5, 0x2c, 0x8b, 0x12, 0x26, 0x5b, 0xdf, 0x01, 0x8d,
+	0x4f, 0x72, 0x84, 0x47, 0x23, 0x87, 0x9a, 0x9c, 0xf7, 0x35, 0xce, 0xfb, 0x3a, 0xb3, 0x37, 0xb9,
+	0xf9, 0x3e, 0xeb, 0xc0, 0x15, 0xc8, 0xd8, 0x16, 0xb5, 0x04, 0x24, 0xc5, 0x21, 0x69, 0x66, 0xe0,
+	0xce, 0x8f, 0x61, 0x23, 0x52, 0x15, 0x11, 0x90, 0xb4, 0x98, 0x65, 0x6a, 0xe6, 0xc0, 0x9b, 0xb0,
+	0xe5, 0xa1, 0x53, 0x6a, 0xbe, 0x89, 0xce, 0x70, 0xb4, 0xce, 0x7c, 0x8f, 0x66, 0x23, 0x3e, 0x84,
+	0xf5, 0xa3, 0x90, 0x7c, 0x81, 0x05, 0x8e, 0xcd, 0x47, 0x56, 0x0e, 0xbb, 0x0c, 0x69, 0xcb, 0xf7,
+	0x05, 0x20, 0xcb, 0x01, 0x29, 0xcb, 0xf7, 0xb9, 0xeb, 0x06, 0x6c, 0xf2, 0x1a, 0x03, 0x44, 0xc6,
+	0x2e, 0x95, 0x93, 0xe4, 0x38, 0x66, 0x83, 0x39, 0x0c, 0x61, 0xe7, 0xd8, 0x0f, 0x20, 0x8f, 0x4e,
+	0x1c, 0x1b, 0x79, 0x47, 0x48, 0xe0, 0xf2, 0x1c, 0x97, 0x0b, 0x8d, 0x1c, 0x74, 0x1d, 0x34, 0x3f,
+	0xc0, 0x3e, 0x26, 0x28, 0x30, 0x2d, 0xdb, 0x0e, 0x10, 0x21, 0x85, 0x75, 0x31, 0x5f, 0x68, 0xaf,
+	0x0b, 0x73, 0xa5, 0x00, 0xea, 0xae, 0x45, 0x2d, 0x5d, 0x83, 0x24, 0x3d, 0x25, 0x05, 0xa5, 0x9c,
+	0xdc, 0xc9, 0x19, 0xec, 0xb3, 0xf2, 0x63, 0x12, 0xd4, 0x47, 0x98, 0x22, 0xfd, 0x36, 0xa8, 0xac,
+	0x4d, 0x5c, 0x7d, 0xeb, 0x8b, 0xf4, 0xdc, 0x75, 0x06, 0x1e, 0xb2, 0x0f, 0xc8, 0xa0, 0xf7, 0xc4,
+	0x47, 0x06, 0x07, 0xc7, 0xe4, 0x94, 0x98, 0x91, 0xd3, 0x16, 0xac, 0x06, 0x78, 0xec, 0xd9, 0x5c,
+	0x65, 0xab, 0x86, 0x18, 0xe8, 0x7b, 0x90, 0x8e, 0x54, 0xa2, 0xfe, 0x97, 0x4a, 0x36, 0x98, 0x4a,
+	0x98, 0x86, 0xa5, 0xc1, 0x48, 0xf5, 0xa5, 0x58, 0x1a, 0x90, 0x89, 0x4e, 0x1f, 0xa9, 0xb6, 0xb7,
+	0x13, 0xec, 0x34, 0x4c, 0xff, 0x04, 0x36, 0xa3, 0xde, 0x47, 0xe4, 0x09, 0xc5, 0x69, 0x91, 0x43,
+	0xb2, 0x37, 0x23, 0x2b, 0x53, 0x1c, 0x30, 0x29, 0x5e, 0xd7, 0x54, 0x56, 0x2d, 0x7e, 0xd2, 0x5c,
+	0x85, 0x0c, 0x71, 0x06, 0x9e, 0x45, 0xc7, 0x01, 0x92, 0xca, 0x9b, 0x1a, 0xd8, 0x9a, 0xdf, 0x8d,
+	0x2d, 0x8f, 0x8e, 0x47, 0xe6, 0x14, 0x25, 0x14, 0xa7, 0x49, 0x47, 0x37, 0xb4, 0x57, 0x7e, 0x53,
+	0x60, 0x4d, 0xc8, 0x3e, 0x46, 0xb2, 0xb2, 0x98, 0xe4, 0xc4, 0x32, 0x92, 0x93, 0xef, 0x4e, 0x72,
+	0x1d, 0x20, 0x4a, 0x92, 0x14, 0xd4, 0x72, 0x72, 0x27, 0x7b, 0xeb, 0xca, 0xfc, 0x44, 0x22, 0xc5,
+	0xae, 0x33, 0x90, 0xbb, 0x3a, 0x16, 0x54, 0x99, 0x24, 0x20, 0x13, 0xf9, 0xf5, 0x3a, 0xe4, 0xc3,
+	0xbc, 0xcc, 0x63, 0xd7, 0x1a, 0x48, 0xa1, 0x5d, 0x5b, 0x9a, 0xdc, 0x5d, 0xd7, 0x1a, 0x18, 0x59,
+	0x99, 0x0f, 0x1b, 0x2c, 0x6e, 0x5a, 0x62, 0x49, 0xd3, 0x66, 0x54, 0x92, 0x7c, 0x37, 0x95, 0xcc,
+	0xf4, 0x53, 0x7d, 0xab, 0x7e, 0xae, 0x2e, 0xe9, 0xe7, 0xab, 0x04, 0xa4, 0x3b, 0x7c, 0x57, 0x5a,
+	0xee, 0xff, 0xb1, 0xd7, 0xae, 0x40, 0xc6, 0xc7, 0xae, 0x29, 0x3c, 0x2a, 0xf7, 0xa4, 0x7d, 0xec,
+	0x1a, 0x73, 0x1a, 0x59, 0x7d, 0x4f, 0x1b, 0x71, 0xed, 0x3d, 0x50, 0x9c, 0x7a, 0x2b, 0x8a, 0xd3,
+	0x4b, 0x28, 0x0e, 0x20, 0x27, 0x78, 0x93, 0x57, 0xea, 0x4d, 0x46, 0x18, 0xbf, 0xa3, 0xc5, 0x8d,
+	0x5a, 0x98, 0xaf, 0x51, 0x20, 0x0d, 0x89, 0x63, 0x11, 0xe2, 0x06, 0x92, 0xb7, 0x7a, 0x61, 0x99,
+	0xe0, 0x0d, 0x89, 0xab, 0xfc, 0xa4, 0x00, 0xec, 0xb3, 0x36, 0x70, 0x72, 0xd8, 0x65, 0x48, 0x78,
+	0x0a, 0xe6, 0xcc, 0xca, 0xa5, 0x65, 0x1d, 0x96, 0xeb, 0xe7, 0x48, 0x3c, 0xef, 0x26, 0xe4, 0xa7,
+	0x32, 0x27, 0x28, 0x4c, 0x66, 0xc1, 0x24, 0xd1, 0x1d, 0xd5, 0x45, 0xd4, 0xc8, 0x9d, 0xc4, 0x46,
+	0x95, 0xdf, 0x15, 0xc8, 0xf0, 0x9c, 0x0e, 0x10, 0xb5, 0x66, 0x1a, 0xae, 0xbc, 0x7b, 0xc3, 0xaf,
+	0x01, 0x88, 0x69, 0x88, 0xf3, 0x14, 0x49, 0x19, 0x66, 0xb8, 0xa5, 0xeb, 0x3c, 0x45, 0xfa, 0x17,
+	0x11, 0xe1, 0xc9, 0x7f, 0x27, 0x5c, 0x1e, 0x16, 0x21, 0xed, 0x97, 0x20, 0xe5, 0x8d, 0x47, 0x26,
+	0xbb, 0x99, 0x54, 0x21, 0x6d, 0x6f, 0x3c, 0xea, 0x9d, 0x92, 0x4a, 0x07, 0x52, 0xbd, 0xd3, 0x0e,
+	0x7b, 0x98, 0x31, 0x3d, 0x07, 0x18, 0xcb, 0xa7, 0x81, 0x78, 0x92, 0xa5, 0x99, 0x81, 0xdf, 0x84,
+	0x3a, 0xa8, 0xec, 0x0d, 0x10, 0xbe, 0x09, 0xd9, 0xf7, 0xe2, 0xf7, 0xdd, 0x8d, 0x3f, 0x14, 0xc8,
+	0xc6, 0xce, 0x17, 0xfd, 0x73, 0xb8, 0xd0, 0xd8, 0x3f, 0x6c, 0x3e, 0x30, 0x5b, 0xbb, 0xe6, 0xdd,
+	0xfd, 0xfa, 0x3d, 0xf3, 0x61, 0xfb, 0x41, 0xfb, 0xf0, 0xeb, 0xb6, 0xb6, 0x52, 0xbc, 0x38, 0x39,
+	0x2b, 0xeb, 0x31, 0xec, 0x43, 0xef, 0xb1, 0x87, 0xbf, 0xf7, 0xf4, 0x1a, 0x6c, 0xcd, 0x86, 0xd4,
+	0x1b, 0xdd, 0xbd, 0x76, 0x4f, 0x53, 0x8a, 0x17, 0x26, 0x67, 0xe5, 0xcd, 0x58, 0x44, 0xbd, 0x4f,
+	0x90, 0x47, 0xe7, 0x03, 0x9a, 0x87, 0x07, 0x07, 0xad, 0x9e, 0x96, 0x98, 0x0b, 0x90, 0x07, 0xfe,
+	0x75, 0xd8, 0x9c, 0x0d, 0x68, 0xb7, 0xf6, 0xb5, 0x64, 0x51, 0x9f, 0x9c, 0x95, 0xd7, 0x63, 0xe8,
+	0xb6, 0xe3, 0x16, 0xd3, 0x3f, 0xfc, 0x5c, 0x5a, 0xf9, 0xf5, 0x97, 0x92, 0xc2, 0x2a, 0xcb, 0xcf,
+	0x1c, 0x1b, 0xfa, 0xa7, 0x70, 0xa9, 0xdb, 0xba, 0xd7, 0xde, 0xdb, 0x35, 0x0f, 0xba, 0xf7, 0xcc,
+	0xde, 0x37, 0x9d, 0xbd, 0x58, 0x75, 0x1b, 0x93, 0xb3, 0x72, 0x56, 0x96, 0xb4, 0x0c, 0xdd, 0x31,
+	0xf6, 0x1e, 0x1d, 0xf6, 0xf6, 0x34, 0x45, 0xa0, 0x3b, 0x01, 0x3a, 0xc1, 0x14, 0x71, 0xf4, 0x4d,
+	0xb8, 0xbc, 0x00, 0x1d, 0x15, 0xb6, 0x39, 0x39, 0x2b, 0xe7, 0x3b, 0x01, 0x12, 0xbb, 0x84, 0x47,
+	0x54, 0xa1, 0x30, 0x1f, 0x71, 0xd8, 0x39, 0xec, 0xd6, 0xf7, 0xb5, 0x72, 0x51, 0x9b, 0x9c, 0x95,
+	0x73, 0xe1, 0xf9, 0xc8, 0xf0, 0xd3, 0xca, 0x1a, 0xdd, 0xe7, 0xe7, 0x25, 0xe5, 0xc5, 0x79, 0x49,
+	0x79, 0x75, 0x5e, 0x52, 0x9e, 0xbd, 0x2e, 0xad, 0xbc, 0x78, 0x5d, 0x5a, 0xf9, 0xf3, 0x75, 0x69,
+	0xe5, 0xdb, 0x3b, 0x03, 0x87, 0x0e, 0xc7, 0xfd, 0xea, 0x11, 0x1e, 0xd5, 0x8e, 0xdd, 0x31, 0x62,
+	0xe7, 0xc1, 0x67, 0xfc, 0xa9, 0x1b, 0x0d, 0xc5, 0x3f, 0x96, 0xda, 0x9b, 0xff, 0x3e, 0xfa, 0x6b,
+	0xdc, 0x7e, 0xfb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0xd5, 0x95, 0xde, 0x1f, 0x0d, 0x00,
+	0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1427,6 +1455,13 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QuantumSignature) > 0 {
+		i -= len(m.QuantumSignature)
+		copy(dAtA[i:], m.QuantumSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuantumSignature)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1559,6 +1594,13 @@ func (m *CommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QuantumSignature) > 0 {
+		i -= len(m.QuantumSignature)
+		copy(dAtA[i:], m.QuantumSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuantumSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1609,6 +1651,13 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QuantumSignature) > 0 {
+		i -= len(m.QuantumSignature)
+		copy(dAtA[i:], m.QuantumSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuantumSignature)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -2013,6 +2062,10 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.QuantumSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2058,6 +2111,10 @@ func (m *CommitSig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.QuantumSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2087,6 +2144,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.QuantumSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3354,6 +3415,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuantumSignature = append(m.QuantumSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuantumSignature == nil {
+				m.QuantumSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3679,6 +3774,40 @@ func (m *CommitSig) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuantumSignature = append(m.QuantumSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuantumSignature == nil {
+				m.QuantumSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3905,6 +4034,40 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuantumSignature = append(m.QuantumSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuantumSignature == nil {
+				m.QuantumSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -234,8 +234,8 @@ func (a *ABCIAdapter) FinalizeBlock(ctx context.Context, req *cmtabci.RequestFin
 		return nil, err
 	}
 	return &cmtabci.ResponseFinalizeBlock{
-		Events:                []cmtabci.Event{},         // Convert events
-		TxResults:             []*cmtabci.ExecTxResult{}, // Convert tx results
+		Events:                resp.Events,
+		TxResults:             resp.TxResults,
 		ValidatorUpdates:      resp.ValidatorUpdates,
 		ConsensusParamUpdates: resp.ConsensusParamUpdates,
 		AppHash:               resp.AppHash,
@@ -301,29 +301,11 @@ func (a *ABCIAdapter) ApplySnapshotChunk(ctx context.Context, req *cmtabci.Reque
 }
 
 func (a *ABCIAdapter) PrepareProposal(ctx context.Context, req *cmtabci.RequestPrepareProposal) (*cmtabci.ResponsePrepareProposal, error) {
-	localReq := &abci.PrepareProposalRequest{
-		Txs: req.Txs,
-	}
-	resp, err := a.app.PrepareProposal(ctx, localReq)
-	if err != nil {
-		return nil, err
-	}
-	return &cmtabci.ResponsePrepareProposal{
-		Txs: resp.Txs,
-	}, nil
+	return a.app.PrepareProposal(ctx, req)
 }
 
 func (a *ABCIAdapter) ProcessProposal(ctx context.Context, req *cmtabci.RequestProcessProposal) (*cmtabci.ResponseProcessProposal, error) {
-	localReq := &abci.ProcessProposalRequest{
-		Txs: req.Txs,
-	}
-	resp, err := a.app.ProcessProposal(ctx, localReq)
-	if err != nil {
-		return nil, err
-	}
-	return &cmtabci.ResponseProcessProposal{
-		Status: resp.Status,
-	}, nil
+	return a.app.ProcessProposal(ctx, req)
 }
 
 func (a *ABCIAdapter) ExtendVote(ctx context.Context, req *cmtabci.RequestExtendVote) (*cmtabci.ResponseExtendVote, error) {
@@ -82,7 +82,7 @@ func (a *consensusConn) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlo
 		return nil, err
 	}
 	return &abci.FinalizeBlockResponse{
-		Events:                []cmtabci.Event{},
+		Events:                res.Events,
 		TxResults:             res.TxResults,
 		ValidatorUpdates:      res.ValidatorUpdates,
 		ConsensusParamUpdates: res.ConsensusParamUpdates,
@@ -63,14 +63,14 @@ func (m *MockAppConnConsensus) FinalizeBlock(ctx context.Context, req *abci.Fina
 
 func (m *MockAppConnConsensus) PrepareProposal(ctx context.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
 	if m.PrepareProposalFn == nil {
-		return &abci.PrepareProposalResponse{}, nil
+		return &abci.PrepareProposalResponse{Txs: req.Txs}, nil
 	}
 	return m.PrepareProposalFn(ctx, req)
 }
 
 func (m *MockAppConnConsensus) ProcessProposal(ctx context.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
 	if m.ProcessProposalFn == nil {
-		return &abci.ProcessProposalResponse{}, nil
+		return &abci.ProcessProposalResponse{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 	}
 	return m.ProcessProposalFn(ctx, req)
 }
@@ -151,11 +151,16 @@ func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockR
 		return nil, err
 	}
 
+	paramUpdates, err := compat.ConsensusParamUpdatesFromFinalizeBlock(results.FinalizeBlock)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ctypes.ResultBlockResults{
 		Height:                height,
 		TxsResults:            compat.ExecTxResultsFromComet(results.FinalizeBlock.TxResults),
 		ValidatorUpdates:      results.FinalizeBlock.ValidatorUpdates,
-		ConsensusParamUpdates: compat.ConsensusParamsToProtoTypes(compat.ConsensusParamsFromComet(results.FinalizeBlock.ConsensusParamUpdates)),
+		ConsensusParamUpdates: compat.ConsensusParamsToProtoTypes(paramUpdates),
 	}, nil
 }
 
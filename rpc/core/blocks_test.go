@@ -10,7 +10,6 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	abci "github.com/cometbft/cometbft/abci/types"
-	tmstate "github.com/fluentum-chain/fluentum/proto/tendermint/state"
 	ctypes "github.com/fluentum-chain/fluentum/rpc/core/types"
 	rpctypes "github.com/fluentum-chain/fluentum/rpc/jsonrpc/types"
 	sm "github.com/fluentum-chain/fluentum/state"
@@ -70,14 +69,14 @@ func TestBlockchainInfo(t *testing.T) {
 }
 
 func TestBlockResults(t *testing.T) {
-	results := &tmstate.ABCIResponses{
-		DeliverTxs: []*abci.ResponseDeliverTx{
-			{Code: 0, Data: []byte{0x01}, Log: "ok"},
-			{Code: 0, Data: []byte{0x02}, Log: "ok"},
-			{Code: 1, Log: "not ok"},
+	results := &sm.ABCIResponses{
+		FinalizeBlock: &abci.ResponseFinalizeBlock{
+			TxResults: []*abci.ExecTxResult{
+				{Code: 0, Data: []byte{0x01}, Log: "ok"},
+				{Code: 0, Data: []byte{0x02}, Log: "ok"},
+				{Code: 1, Log: "not ok"},
+			},
 		},
-		EndBlock:   &abci.ResponseEndBlock{},
-		BeginBlock: &abci.ResponseBeginBlock{},
 	}
 
 	env = &Environment{}
@@ -97,12 +96,9 @@ func TestBlockResults(t *testing.T) {
 		{0, true, nil},
 		{101, true, nil},
 		{100, false, &ctypes.ResultBlockResults{
-			Height:                100,
-			TxsResults:            results.DeliverTxs,
-			BeginBlockEvents:      results.BeginBlock.Events,
-			EndBlockEvents:        results.EndBlock.Events,
-			ValidatorUpdates:      results.EndBlock.ValidatorUpdates,
-			ConsensusParamUpdates: results.EndBlock.ConsensusParamUpdates,
+			Height:           100,
+			TxsResults:       results.FinalizeBlock.TxResults,
+			ValidatorUpdates: results.FinalizeBlock.ValidatorUpdates,
 		}},
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/fluentum-chain/fluentum/libs/log"
 	mempl "github.com/fluentum-chain/fluentum/mempool"
 	"github.com/fluentum-chain/fluentum/p2p"
+	"github.com/fluentum-chain/fluentum/privval"
 	"github.com/fluentum-chain/fluentum/proxy"
 	sm "github.com/fluentum-chain/fluentum/state"
 	"github.com/fluentum-chain/fluentum/state/indexer"
@@ -61,6 +62,10 @@ type transport interface {
 	NodeInfo() p2p.NodeInfo
 }
 
+type signerStats interface {
+	Stats() privval.SignerStats
+}
+
 type peers interface {
 	AddPersistentPeers([]string) error
 	AddUnconditionalPeerIDs([]string) error
@@ -94,9 +99,13 @@ type Environment struct {
 	EventBus         *types.EventBus // thread safe
 	Mempool          mempl.Mempool
 
+	// counters of the signatures of the validator, if the node keeps them
+	SignerStats signerStats
+
 	Logger log.Logger
 
-	Config cfg.RPCConfig
+	Config        cfg.RPCConfig
+	QuantumConfig *cfg.QuantumConfig
 
 	// cache of chunked genesis data.
 	genChunks []string
@@ -2,10 +2,13 @@ package core
 
 import (
 	"fmt"
+	"sort"
 
-	cfg "github.com/fluentum-chain/fluentum/config"
 	"github.com/fluentum-chain/fluentum/core/crypto"
 	"github.com/fluentum-chain/fluentum/core/plugin"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
+	"github.com/fluentum-chain/fluentum/privval"
 	ctypes "github.com/fluentum-chain/fluentum/rpc/core/types"
 	rpcserver "github.com/fluentum-chain/fluentum/rpc/jsonrpc/server"
 	rpctypes "github.com/fluentum-chain/fluentum/rpc/jsonrpc/types"
@@ -17,22 +20,14 @@ type QuantumAPI struct{}
 // Reload reloads the quantum signer plugin
 // More: https://docs.tendermint.com/v0.34/rpc/#/Info/quantum_reload
 func (api *QuantumAPI) Reload(ctx *rpctypes.Context) (*ctypes.ResultQuantumReload, error) {
-	// Get current quantum config from environment
-	if env == nil || env.Config.ListenAddress == "" {
+	if env == nil || env.QuantumConfig == nil {
 		return nil, fmt.Errorf("RPC environment not properly configured")
 	}
 
-	// For now, we'll use a default config since the full config isn't in env
-	// In a real implementation, you'd want to pass the full config to the environment
-	quantumConfig := cfg.DefaultQuantumConfig()
-
-	// Unload current signer by setting to default ECDSA
-	crypto.SetActiveSigner("ecdsa")
-
-	// Reload new quantum signer
-	if err := plugin.LoadQuantumSigner(quantumConfig.LibPath); err != nil {
-		// Revert to default ECDSA signer on failure
-		crypto.SetActiveSigner("ecdsa")
+	// Reload the quantum signer. The registry is safe for concurrent use, so
+	// the current signer stays active until the new one is registered, and
+	// is left active if the reload fails.
+	if err := plugin.LoadQuantumSigner(env.QuantumConfig.LibPath); err != nil {
 		return &ctypes.ResultQuantumReload{
 			Success: false,
 			Error:   err.Error(),
@@ -45,29 +40,102 @@ func (api *QuantumAPI) Reload(ctx *rpctypes.Context) (*ctypes.ResultQuantumReloa
 	}, nil
 }
 
-// Status returns the current quantum signing status
+// Status returns the current quantum signing status, i.e. the algorithm of
+// the key the validator signs votes and proposals with
 // More: https://docs.tendermint.com/v0.34/rpc/#/Info/quantum_status
 func (api *QuantumAPI) Status(ctx *rpctypes.Context) (*ctypes.ResultQuantumStatus, error) {
-	activeSigner := crypto.GetSigner()
-	if activeSigner == nil {
-		return &ctypes.ResultQuantumStatus{
-			Enabled:    false,
-			SignerName: "none",
-			Mode:       "",
-		}, nil
+	result := &ctypes.ResultQuantumStatus{
+		SignerName: "none",
+	}
+	if env == nil {
+		return result, nil
+	}
+	if env.QuantumConfig != nil && env.QuantumConfig.Enabled {
+		result.Mode = env.QuantumConfig.Mode
 	}
+	if env.PubKey != nil {
+		result.Enabled = privval.IsQuantumResistant(env.PubKey)
+		result.SignerName = privval.SigningAlgorithm(env.PubKey)
+		result.ValidatorKeyType = env.PubKey.Type()
+	}
+	return result, nil
+}
 
-	return &ctypes.ResultQuantumStatus{
-		Enabled:    activeSigner.Name() != "ecdsa",
-		SignerName: activeSigner.Name(),
-		Mode:       "", // Could be enhanced to return the specific quantum mode
-	}, nil
+// PubKey returns the post-quantum public key of the validator
+// More: https://docs.tendermint.com/v0.34/rpc/#/Info/quantum_pubkey
+func (api *QuantumAPI) PubKey(ctx *rpctypes.Context) (*ctypes.ResultQuantumPubKey, error) {
+	if env == nil || env.PubKey == nil {
+		return nil, fmt.Errorf("RPC environment not properly configured")
+	}
+
+	result := &ctypes.ResultQuantumPubKey{
+		Address: env.PubKey.Address(),
+		KeyType: env.PubKey.Type(),
+	}
+	var quantumKey dilithium.PubKey
+	switch pk := env.PubKey.(type) {
+	case dilithium.PubKey:
+		quantumKey = pk
+	case hybrid.PubKey:
+		quantumKey = pk.QuantumKey()
+	default:
+		return result, nil
+	}
+	if mode, ok := quantumKey.Mode(); ok {
+		result.Mode = mode.String()
+	}
+	result.QuantumPubKey = quantumKey.Bytes()
+	return result, nil
 }
 
-// Provide a function map for registration
+// Algorithms returns the signing algorithms available on the node
+// More: https://docs.tendermint.com/v0.34/rpc/#/Info/quantum_algorithms
+func (api *QuantumAPI) Algorithms(ctx *rpctypes.Context) (*ctypes.ResultQuantumAlgorithms, error) {
+	result := &ctypes.ResultQuantumAlgorithms{
+		Signers: crypto.ListSigners(),
+	}
+	if activeSigner := crypto.GetSigner(); activeSigner != nil {
+		result.ActiveSigner = activeSigner.Name()
+	}
+	if env != nil && env.StateStore != nil {
+		state, err := env.StateStore.Load()
+		if err != nil {
+			return nil, err
+		}
+		result.ValidatorKeyTypes = append([]string(nil), state.ConsensusParams.Validator.PubKeyTypes...)
+		sort.Strings(result.ValidatorKeyTypes)
+	}
+	return result, nil
+}
+
+// Metrics returns the counters of the votes and proposals signed by the
+// validator
+// More: https://docs.tendermint.com/v0.34/rpc/#/Info/quantum_metrics
+func (api *QuantumAPI) Metrics(ctx *rpctypes.Context) (*ctypes.ResultQuantumMetrics, error) {
+	result := &ctypes.ResultQuantumMetrics{}
+	if env == nil || env.SignerStats == nil {
+		return result, nil
+	}
+
+	stats := env.SignerStats.Stats()
+	result.Signer = &ctypes.SignerMetrics{
+		Algorithm:     stats.Algorithm,
+		SignCount:     stats.SignCount,
+		ErrorCount:    stats.ErrorCount,
+		AvgSignTime:   stats.AvgSignTime(),
+		TotalSignTime: stats.TotalSignTime,
+		LastSignTime:  stats.LastSignTime,
+	}
+	return result, nil
+}
+
+// QuantumRoutes are the safe quantum routes. quantum_reload swaps the active
+// signer, and is only served with the unsafe routes, see AddUnsafeRoutes.
 var QuantumRoutes = map[string]*rpcserver.RPCFunc{
-	"quantum_reload": rpcserver.NewRPCFunc(QuantumReload, ""),
-	"quantum_status": rpcserver.NewRPCFunc(QuantumStatus, ""),
+	"quantum_status":     rpcserver.NewRPCFunc(QuantumStatus, ""),
+	"quantum_pubkey":     rpcserver.NewRPCFunc(QuantumPubKey, ""),
+	"quantum_algorithms": rpcserver.NewRPCFunc(QuantumAlgorithms, ""),
+	"quantum_metrics":    rpcserver.NewRPCFunc(QuantumMetrics, ""),
 }
 
 // Route functions for the quantum API
@@ -83,3 +151,24 @@ func QuantumStatus(ctx *rpctypes.Context) (*ctypes.ResultQuantumStatus, error) {
 	api := &QuantumAPI{}
 	return api.Status(ctx)
 }
+
+// QuantumPubKey is the RPC route function for getting the validator's
+// post-quantum public key
+func QuantumPubKey(ctx *rpctypes.Context) (*ctypes.ResultQuantumPubKey, error) {
+	api := &QuantumAPI{}
+	return api.PubKey(ctx)
+}
+
+// QuantumAlgorithms is the RPC route function for listing the signing
+// algorithms
+func QuantumAlgorithms(ctx *rpctypes.Context) (*ctypes.ResultQuantumAlgorithms, error) {
+	api := &QuantumAPI{}
+	return api.Algorithms(ctx)
+}
+
+// QuantumMetrics is the RPC route function for getting the validator signer
+// counters
+func QuantumMetrics(ctx *rpctypes.Context) (*ctypes.ResultQuantumMetrics, error) {
+	api := &QuantumAPI{}
+	return api.Metrics(ctx)
+}
@@ -1,27 +1,65 @@
 package core
 
 import (
+	"bytes"
 	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
 
 	cfg "github.com/fluentum-chain/fluentum/config"
+	"github.com/fluentum-chain/fluentum/core/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
+	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
+	"github.com/fluentum-chain/fluentum/privval"
+	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
 	rpctypes "github.com/fluentum-chain/fluentum/rpc/jsonrpc/types"
+	sm "github.com/fluentum-chain/fluentum/state"
+	"github.com/fluentum-chain/fluentum/types"
 )
 
 func TestQuantumAPI_Status(t *testing.T) {
 	api := &QuantumAPI{}
 	ctx := &rpctypes.Context{}
 
-	// Test with no active signer
+	origEnv := env
+	defer func() { env = origEnv }()
+
+	// Without a validator key, nothing signs
+	env = &Environment{}
 	result, err := api.Status(ctx)
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
+	if result.Enabled || result.SignerName != "none" {
+		t.Errorf("Expected no signer, got %+v", result)
+	}
 
+	// The status is that of the validator key, whatever signer is active in
+	// the registry
+	env = &Environment{PubKey: ed25519.GenPrivKey().PubKey()}
+	result, err = api.Status(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
 	if result.Enabled {
-		t.Error("Expected disabled when no signer is active")
+		t.Error("Expected disabled for an ed25519 validator")
+	}
+	if result.SignerName != "ed25519" {
+		t.Errorf("Expected signer name 'ed25519', got '%s'", result.SignerName)
 	}
-	if result.SignerName != "none" {
-		t.Errorf("Expected signer name 'none', got '%s'", result.SignerName)
+
+	env = &Environment{PubKey: dilithium.GenPrivKeyWithMode(dilithium.Mode3).PubKey()}
+	result, err = api.Status(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.Enabled {
+		t.Error("Expected enabled for a dilithium validator")
+	}
+	if result.SignerName != "dilithium3" {
+		t.Errorf("Expected signer name 'dilithium3', got '%s'", result.SignerName)
 	}
 }
 
@@ -33,12 +71,24 @@ func TestQuantumAPI_Reload(t *testing.T) {
 	origEnv := env
 	defer func() { env = origEnv }()
 
+	// Reload needs the quantum config of the node
+	env = &Environment{}
+	if _, err := api.Reload(ctx); err == nil {
+		t.Error("Expected error without quantum config")
+	}
+
 	// Create a minimal environment for testing
 	env = &Environment{
-		Config: cfg.RPCConfig{}, // Non-empty config
+		Config:        cfg.RPCConfig{},
+		QuantumConfig: cfg.TestQuantumConfig(),
 	}
 
-	// Test reload with default config (should fail since lib path doesn't exist)
+	// Test reload with the test config (should fail since lib path doesn't exist)
+	if !crypto.SetActiveSigner(crypto.Ed25519SignerName) {
+		t.Fatal("Failed to activate the ed25519 signer")
+	}
+	defer crypto.SetActiveSigner(crypto.ECDSASignerName)
+	activeName := crypto.GetSigner().Name()
 	result, err := api.Reload(ctx)
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -51,6 +101,10 @@ func TestQuantumAPI_Reload(t *testing.T) {
 	if result.Error == "" {
 		t.Error("Expected error message for failed reload")
 	}
+	// The active signer is left unchanged by a failed reload
+	if active := crypto.GetSigner(); active == nil || active.Name() != activeName {
+		t.Errorf("Expected the active signer to stay %q, got %v", activeName, active)
+	}
 }
 
 func TestQuantumReload_RouteFunction(t *testing.T) {
@@ -61,7 +115,8 @@ func TestQuantumReload_RouteFunction(t *testing.T) {
 	defer func() { env = origEnv }()
 
 	env = &Environment{
-		Config: cfg.RPCConfig{},
+		Config:        cfg.RPCConfig{},
+		QuantumConfig: cfg.TestQuantumConfig(),
 	}
 
 	// Test the route function
@@ -85,7 +140,7 @@ func TestQuantumStatus_RouteFunction(t *testing.T) {
 	}
 
 	if result.Enabled {
-		t.Error("Expected disabled when no signer is active")
+		t.Error("Expected disabled without a quantum validator key")
 	}
 }
 
@@ -114,3 +169,134 @@ func TestQuantumAPI_Integration(t *testing.T) {
 	_ = status.SignerName
 	_ = status.Mode
 }
+
+func TestQuantumAPI_StatusMode(t *testing.T) {
+	api := &QuantumAPI{}
+	ctx := &rpctypes.Context{}
+
+	origEnv := env
+	defer func() { env = origEnv }()
+
+	env = &Environment{
+		PubKey:        hybrid.GenPrivKeyWithMode(dilithium.Mode2).PubKey(),
+		QuantumConfig: cfg.TestQuantumConfig(),
+	}
+
+	result, err := api.Status(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Mode != "dilithium3" {
+		t.Errorf("Expected mode 'dilithium3', got '%s'", result.Mode)
+	}
+	if result.ValidatorKeyType != hybrid.KeyType {
+		t.Errorf("Expected validator key type '%s', got '%s'", hybrid.KeyType, result.ValidatorKeyType)
+	}
+}
+
+func TestQuantumAPI_PubKey(t *testing.T) {
+	api := &QuantumAPI{}
+	ctx := &rpctypes.Context{}
+
+	origEnv := env
+	defer func() { env = origEnv }()
+
+	// Hybrid keys return their quantum half
+	privKey := hybrid.GenPrivKeyWithMode(dilithium.Mode2)
+	pubKey := privKey.PubKey().(hybrid.PubKey)
+	env = &Environment{PubKey: pubKey}
+
+	result, err := api.PubKey(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.KeyType != hybrid.KeyType {
+		t.Errorf("Expected key type '%s', got '%s'", hybrid.KeyType, result.KeyType)
+	}
+	if result.Mode != dilithium.Mode2.String() {
+		t.Errorf("Expected mode '%s', got '%s'", dilithium.Mode2, result.Mode)
+	}
+	if !bytes.Equal(result.QuantumPubKey, pubKey.QuantumKey().Bytes()) {
+		t.Error("Expected the quantum half of the hybrid key")
+	}
+
+	// Classical keys have no quantum key
+	env = &Environment{PubKey: ed25519.GenPrivKey().PubKey()}
+	result, err = api.PubKey(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Mode != "" || len(result.QuantumPubKey) != 0 {
+		t.Error("Expected no quantum key for an ed25519 validator")
+	}
+}
+
+func TestQuantumAPI_AlgorithmsAndMetrics(t *testing.T) {
+	api := &QuantumAPI{}
+	ctx := &rpctypes.Context{}
+
+	origEnv := env
+	defer func() { env = origEnv }()
+
+	// Without a state store and signer stats, only the registry is reported
+	env = &Environment{}
+	algorithms, err := api.Algorithms(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(algorithms.Signers) == 0 || len(algorithms.ValidatorKeyTypes) != 0 {
+		t.Errorf("Expected only registered signers, got %+v", algorithms)
+	}
+	metrics, err := api.Metrics(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if metrics.Signer != nil {
+		t.Errorf("Expected no metrics, got %+v", metrics)
+	}
+
+	privKey := dilithium.GenPrivKeyWithMode(dilithium.Mode3)
+	consensusParams := types.DefaultConsensusParams()
+	consensusParams.Evidence.MaxAgeDuration = 48 * time.Hour
+	consensusParams.Validator.PubKeyTypes = []string{hybrid.KeyType, ed25519.KeyType}
+	state, err := sm.MakeGenesisState(&types.GenesisDoc{
+		ChainID:         "test-chain",
+		ConsensusParams: consensusParams,
+		Validators:      []types.GenesisValidator{{PubKey: ed25519.GenPrivKey().PubKey(), Power: 10}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to make state: %v", err)
+	}
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	if err := stateStore.Save(state); err != nil {
+		t.Fatalf("Failed to save state: %v", err)
+	}
+	pv, err := privval.NewInstrumentedPV(types.NewMockPVWithParams(privKey, false, false))
+	if err != nil {
+		t.Fatalf("Failed to create instrumented validator: %v", err)
+	}
+	vote := &tmproto.Vote{Type: tmproto.PrevoteType, Height: 1}
+	if err := pv.SignVote("test-chain", vote); err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	env = &Environment{StateStore: stateStore, SignerStats: pv}
+
+	algorithms, err = api.Algorithms(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(algorithms.ValidatorKeyTypes) != 2 || algorithms.ValidatorKeyTypes[0] != ed25519.KeyType {
+		t.Errorf("Expected the key types of the consensus params, got %v", algorithms.ValidatorKeyTypes)
+	}
+
+	metrics, err = api.Metrics(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if metrics.Signer == nil || metrics.Signer.SignCount != 1 || metrics.Signer.Algorithm != "dilithium3" {
+		t.Errorf("Expected one dilithium3 signature, got %+v", metrics.Signer)
+	}
+	if metrics.Signer.LastSignTime.IsZero() || metrics.Signer.LastSignTime.After(time.Now()) {
+		t.Errorf("Expected the time of the signature, got %v", metrics.Signer.LastSignTime)
+	}
+}
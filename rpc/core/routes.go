@@ -48,8 +48,10 @@ var Routes = map[string]*rpc.RPCFunc{
 	"broadcast_evidence": rpc.NewRPCFunc(BroadcastEvidence, "evidence"),
 
 	// quantum API
-	"quantum_reload": rpc.NewRPCFunc(QuantumReload, ""),
-	"quantum_status": rpc.NewRPCFunc(QuantumStatus, ""),
+	"quantum_status":     rpc.NewRPCFunc(QuantumStatus, ""),
+	"quantum_pubkey":     rpc.NewRPCFunc(QuantumPubKey, ""),
+	"quantum_algorithms": rpc.NewRPCFunc(QuantumAlgorithms, ""),
+	"quantum_metrics":    rpc.NewRPCFunc(QuantumMetrics, ""),
 }
 
 // AddUnsafeRoutes adds unsafe routes.
@@ -58,4 +60,7 @@ func AddUnsafeRoutes() {
 	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
 	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent,unconditional,private")
 	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+
+	// quantum API
+	Routes["quantum_reload"] = rpc.NewRPCFunc(QuantumReload, "")
 }
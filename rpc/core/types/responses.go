@@ -282,7 +282,48 @@ type ResultQuantumReload struct {
 
 // ResultQuantumStatus represents the current quantum signing status
 type ResultQuantumStatus struct {
-	Enabled    bool   `json:"enabled"`
+	// Whether the validator signs with a quantum-resistant key
+	Enabled bool `json:"enabled"`
+	// Algorithm the validator signs votes and proposals with
 	SignerName string `json:"signer_name"`
 	Mode       string `json:"mode,omitempty"`
+	// Type of the key the validator signs votes and proposals with
+	ValidatorKeyType string `json:"validator_key_type,omitempty"`
+}
+
+// ResultQuantumPubKey represents the post-quantum public key of the validator
+type ResultQuantumPubKey struct {
+	Address bytes.HexBytes `json:"address"`
+	KeyType string         `json:"key_type"`
+	// Dilithium mode of the key, empty for classical keys
+	Mode string `json:"mode,omitempty"`
+	// Dilithium public key, which is the quantum half of hybrid keys, and
+	// empty for classical keys
+	QuantumPubKey bytes.HexBytes `json:"quantum_pub_key,omitempty"`
+}
+
+// ResultQuantumAlgorithms lists the signing algorithms available on the node
+type ResultQuantumAlgorithms struct {
+	// Signers registered in the signer registry, and the active one
+	Signers      []string `json:"signers"`
+	ActiveSigner string   `json:"active_signer,omitempty"`
+	// Key types the consensus params accept for validators
+	ValidatorKeyTypes []string `json:"validator_key_types,omitempty"`
+}
+
+// ResultQuantumMetrics represents the counters of the votes and proposals
+// signed by the validator. Signer is omitted if the node doesn't keep them.
+type ResultQuantumMetrics struct {
+	Signer *SignerMetrics `json:"signer,omitempty"`
+}
+
+// SignerMetrics are the counters of the votes and proposals signed by the
+// validator, with the algorithm of its key
+type SignerMetrics struct {
+	Algorithm     string        `json:"algorithm"`
+	SignCount     int64         `json:"sign_count"`
+	ErrorCount    int64         `json:"error_count"`
+	AvgSignTime   time.Duration `json:"avg_sign_time"`
+	TotalSignTime time.Duration `json:"total_sign_time"`
+	LastSignTime  time.Time     `json:"last_sign_time"`
 }
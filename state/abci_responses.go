@@ -2,9 +2,40 @@ package state
 
 import (
 	abci "github.com/fluentum-chain/fluentum/abci/types"
+	tmstate "github.com/fluentum-chain/fluentum/proto/tendermint/state"
 )
 
 // ABCIResponses holds responses from the ABCI application for a block.
 type ABCIResponses struct {
 	FinalizeBlock *abci.FinalizeBlockResponse
 }
+
+// toProto encodes the responses, the FinalizeBlock response being a CometBFT
+// message. Nil tx results are stripped.
+func (ar *ABCIResponses) toProto() (*tmstate.ABCIResponses, error) {
+	if ar.FinalizeBlock == nil {
+		return &tmstate.ABCIResponses{}, nil
+	}
+	res := *ar.FinalizeBlock
+	res.TxResults = nil
+	for _, txResult := range ar.FinalizeBlock.TxResults {
+		if txResult != nil {
+			res.TxResults = append(res.TxResults, txResult)
+		}
+	}
+	bz, err := res.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &tmstate.ABCIResponses{FinalizeBlock: bz}, nil
+}
+
+func abciResponsesFromProto(pb *tmstate.ABCIResponses) (*ABCIResponses, error) {
+	res := new(abci.FinalizeBlockResponse)
+	if pb != nil {
+		if err := res.Unmarshal(pb.FinalizeBlock); err != nil {
+			return nil, err
+		}
+	}
+	return &ABCIResponses{FinalizeBlock: res}, nil
+}
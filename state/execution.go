@@ -7,6 +7,8 @@ import (
 
 	cometbftabci "github.com/cometbft/cometbft/abci/types"
 	cometproto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/fluentum-chain/fluentum/abci/compat"
 	abci "github.com/fluentum-chain/fluentum/abci/types"
 	"github.com/fluentum-chain/fluentum/crypto/encoding"
 	"github.com/fluentum-chain/fluentum/libs/fail"
@@ -93,6 +95,10 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allcoated for maximum sized evidence.
 // The rest is given to txs, up to the max gas.
+//
+// The reaped txs are passed to the application with PrepareProposal, which may
+// reorder them and add an ordering record. If the application fails, or returns
+// txs that don't fit in the block, the reaped txs are proposed as is.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
 	state State, commit *types.Commit,
@@ -104,12 +110,78 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	evidence, evSize := blockExec.evpool.PendingEvidence(state.ConsensusParams.Evidence.MaxBytes)
 
-	// Fetch a limited amount of valid txs
-	maxDataBytes := types.MaxDataBytes(maxBytes, evSize, state.Validators.Size())
+	// Fetch a limited amount of valid txs. The last commit grows with the
+	// quantum signatures of validators with post-quantum keys.
+	maxDataBytes := types.MaxDataBytes(
+		maxBytes-types.MaxQuantumCommitBytes(state.LastValidators),
+		evSize,
+		state.Validators.Size(),
+	)
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	// Ordering records are added by the application in PrepareProposal, never
+	// taken from the mempool.
+	reaped := make(types.Txs, 0, len(txs))
+	for _, tx := range txs {
+		if !types.IsOrderingRecord(tx) {
+			reaped = append(reaped, tx)
+		}
+	}
+
+	res, err := blockExec.proxyApp.PrepareProposal(context.Background(), &abci.PrepareProposalRequest{
+		MaxTxBytes:         maxDataBytes,
+		Txs:                reaped.ToSliceOfBytes(),
+		Height:             height,
+		Time:               state.blockTime(height, commit),
+		NextValidatorsHash: state.NextValidators.Hash(),
+		ProposerAddress:    proposerAddr,
+	})
+	if err != nil {
+		blockExec.logger.Error("error in proxyAppConn.PrepareProposal, proposing reaped txs", "height", height, "err", err)
+		return state.MakeBlock(height, reaped, commit, evidence, proposerAddr)
+	}
+
+	prepared := types.ToTxs(res.Txs)
+	if !types.OrderingRecordEnabled(state.ConsensusParams.Block, height) {
+		prepared = types.ToTxs(types.StripOrderingRecord(res.Txs))
+	}
+	if size := types.ComputeProtoSizeForTxs(prepared); size > maxDataBytes {
+		blockExec.logger.Error("PrepareProposal returned too many txs, proposing reaped txs",
+			"height", height, "size", size, "max", maxDataBytes)
+		return state.MakeBlock(height, reaped, commit, evidence, proposerAddr)
+	}
+	if err := types.ValidateOrderingRecord(prepared); err != nil {
+		blockExec.logger.Error("PrepareProposal returned an invalid ordering record, proposing reaped txs",
+			"height", height, "err", err)
+		return state.MakeBlock(height, reaped, commit, evidence, proposerAddr)
+	}
+
+	return state.MakeBlock(height, prepared, commit, evidence, proposerAddr)
+}
+
+// ProcessProposal asks the application whether to accept a proposed block,
+// which was already validated with ValidateBlock. As with FinalizeBlock, the
+// application gets the txs of the block without its ordering record.
+func (blockExec *BlockExecutor) ProcessProposal(block *types.Block, state State) (bool, error) {
+	txs := block.Txs.ToSliceOfBytes()
+	if types.OrderingRecordEnabled(state.ConsensusParams.Block, block.Height) {
+		txs = types.StripOrderingRecord(txs)
+	}
+	res, err := blockExec.proxyApp.ProcessProposal(context.Background(), &abci.ProcessProposalRequest{
+		Txs:                txs,
+		ProposedLastCommit: *getBeginBlockValidatorInfo(block, blockExec.store, state.InitialHeight),
+		Misbehavior:        misbehavior(block),
+		Hash:               block.Hash(),
+		Height:             block.Height,
+		Time:               block.Time,
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.IsAccepted(), nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -142,6 +214,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	ctx := context.Background()
 	abciResponses, err := execBlockOnProxyApp(
 		ctx, blockExec.logger, blockExec.proxyApp, block, blockExec.store, state.InitialHeight,
+		types.OrderingRecordEnabled(state.ConsensusParams.Block, block.Height),
 	)
 	endTime := time.Now().UnixNano()
 	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
@@ -166,18 +239,9 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	}
 
 	// Convert CometBFT validator updates to Tendermint types
-	var validatorUpdates []*types.Validator
-	for _, update := range abciValUpdates {
-		localPubKey := toLocalPubKey(update.PubKey)
-		pubKey, err := encoding.PubKeyFromProto(*localPubKey)
-		if err != nil {
-			return state, 0, fmt.Errorf("invalid validator pubkey: %v", err)
-		}
-		validatorUpdates = append(validatorUpdates, &types.Validator{
-			Address:     pubKey.Address(),
-			PubKey:      pubKey,
-			VotingPower: update.Power,
-		})
+	validatorUpdates, err := validatorUpdatesFromABCI(abciValUpdates)
+	if err != nil {
+		return state, 0, err
 	}
 
 	if len(validatorUpdates) > 0 {
@@ -275,29 +339,15 @@ func execBlockOnProxyApp(
 	block *types.Block,
 	store Store,
 	initialHeight int64,
+	stripOrderingRecord bool,
 ) (*ABCIResponses, error) {
-	// Convert evidence to CometBFT ABCI format
-	var byzantineValidators []*cometbftabci.Misbehavior
-	for _, ev := range block.Evidence.Evidence {
-		for _, evi := range ev.ABCI() {
-			if pb, ok := any(evi).(*cometbftabci.Misbehavior); ok {
-				byzantineValidators = append(byzantineValidators, pb)
-			}
-		}
-	}
-
-	txs := make([][]byte, len(block.Txs))
-	for i, tx := range block.Txs {
-		txs[i] = tx
-	}
-
-	// Convert []*Misbehavior to []Misbehavior
-	var byzVals []cometbftabci.Misbehavior
-	for _, pb := range byzantineValidators {
-		if pb != nil {
-			byzVals = append(byzVals, *pb)
-		}
+	// The ordering record isn't a transaction of the application: every node
+	// strips it, and records a successful result for it below.
+	txs := block.Txs.ToSliceOfBytes()
+	if stripOrderingRecord {
+		txs = types.StripOrderingRecord(txs)
 	}
+	hasOrderingRecord := len(txs) != len(block.Txs)
 
 	finalizeBlockReq := &cometbftabci.RequestFinalizeBlock{
 		Hash:               block.Hash(),
@@ -306,7 +356,7 @@ func execBlockOnProxyApp(
 		Txs:                txs,
 		ProposerAddress:    block.ProposerAddress,
 		DecidedLastCommit:  *getBeginBlockValidatorInfo(block, store, initialHeight),
-		Misbehavior:        byzVals,
+		Misbehavior:        misbehavior(block),
 		NextValidatorsHash: block.NextValidatorsHash,
 	}
 	finalizeBlockResp, err := proxyAppConn.FinalizeBlock(ctx, finalizeBlockReq)
@@ -314,6 +364,10 @@ func execBlockOnProxyApp(
 		logger.Error("error in proxyAppConn.FinalizeBlock", "err", err)
 		return nil, err
 	}
+	if hasOrderingRecord {
+		finalizeBlockResp.TxResults = append(
+			[]*cometbftabci.ExecTxResult{{Code: abci.CodeTypeOK}}, finalizeBlockResp.TxResults...)
+	}
 
 	abciResponses := &ABCIResponses{
 		FinalizeBlock: finalizeBlockResp,
@@ -322,6 +376,26 @@ func execBlockOnProxyApp(
 	return abciResponses, nil
 }
 
+// misbehavior converts the evidence of block to the CometBFT ABCI format.
+func misbehavior(block *types.Block) []cometbftabci.Misbehavior {
+	var byzVals []cometbftabci.Misbehavior
+	for _, ev := range block.Evidence.Evidence {
+		for _, evi := range ev.ABCI() {
+			byzVals = append(byzVals, cometbftabci.Misbehavior{
+				Type: cometbftabci.MisbehaviorType(evi.Type),
+				Validator: cometbftabci.Validator{
+					Address: evi.Validator.Address,
+					Power:   evi.Validator.Power,
+				},
+				Height:           evi.Height,
+				Time:             evi.Time,
+				TotalVotingPower: evi.TotalVotingPower,
+			})
+		}
+	}
+	return byzVals
+}
+
 func getBeginBlockValidatorInfo(block *types.Block, store Store,
 	initialHeight int64) *cometbftabci.CommitInfo {
 	voteInfos := make([]cometbftabci.VoteInfo, block.LastCommit.Size())
@@ -344,7 +418,7 @@ func getBeginBlockValidatorInfo(block *types.Block, store Store,
 					Address: val.PubKey.Address(),
 					Power:   val.VotingPower,
 				},
-				BlockIdFlag: 2, // COMMIT
+				BlockIdFlag: cmtproto.BlockIDFlag(block.LastCommit.Signatures[i].BlockIDFlag),
 			}
 		}
 	}
@@ -356,6 +430,14 @@ func getBeginBlockValidatorInfo(block *types.Block, store Store,
 
 func validateValidatorUpdates(abciUpdates []cometbftabci.ValidatorUpdate, params tmproto.ValidatorParams) error {
 	for _, valUpdate := range abciUpdates {
+		if valUpdate.GetPower() < 0 {
+			return fmt.Errorf("voting power can't be negative %v", valUpdate)
+		} else if valUpdate.GetPower() == 0 {
+			// continue, since this is deleting the validator, and thus there is no
+			// pubkey to check
+			continue
+		}
+
 		localPubKey := toLocalPubKey(valUpdate.PubKey)
 		pk, err := encoding.PubKeyFromProto(*localPubKey)
 		if err != nil {
@@ -368,6 +450,37 @@ func validateValidatorUpdates(abciUpdates []cometbftabci.ValidatorUpdate, params
 	return nil
 }
 
+// validatorUpdatesFromABCI converts the validator updates of the application.
+func validatorUpdatesFromABCI(abciUpdates []cometbftabci.ValidatorUpdate) ([]*types.Validator, error) {
+	var validatorUpdates []*types.Validator
+	for _, update := range abciUpdates {
+		localPubKey := toLocalPubKey(update.PubKey)
+		pubKey, err := encoding.PubKeyFromProto(*localPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator pubkey: %v", err)
+		}
+		validatorUpdates = append(validatorUpdates, &types.Validator{
+			Address:     pubKey.Address(),
+			PubKey:      pubKey,
+			VotingPower: update.Power,
+		})
+	}
+	return validatorUpdates, nil
+}
+
+// validateSwitchHeight checks that a switch of validator key types newly
+// scheduled by the params updated at height is not in the past.
+func validateSwitchHeight(params, nextParams tmproto.ValidatorParams, height int64) error {
+	if nextParams.SwitchHeight == params.SwitchHeight {
+		return nil
+	}
+	if nextParams.SwitchHeight <= height {
+		return fmt.Errorf("validator.SwitchHeight %d must be greater than the current height %d",
+			nextParams.SwitchHeight, height)
+	}
+	return nil
+}
+
 // updateState returns a new State updated according to the header and responses.
 func updateState(
 	state State,
@@ -398,10 +511,17 @@ func updateState(
 	// Update the params with the latest abciResponses.
 	nextParams := state.ConsensusParams
 	lastHeightParamsChanged := state.LastHeightConsensusParamsChanged
-	if abciResponses.FinalizeBlock.ConsensusParamUpdates != nil {
-		// TODO: convert ConsensusParamUpdates to the correct type if needed
-		nextParams = types.UpdateConsensusParams(state.ConsensusParams, nil)
-		err := types.ValidateConsensusParams(nextParams)
+	paramUpdates, err := compat.ConsensusParamUpdatesFromFinalizeBlock(abciResponses.FinalizeBlock)
+	if err != nil {
+		return state, fmt.Errorf("error updating consensus params: %v", err)
+	}
+	if paramUpdates != nil {
+		nextParams = types.UpdateConsensusParams(state.ConsensusParams, paramUpdates)
+		err = types.ValidateConsensusParams(nextParams)
+		if err != nil {
+			return state, fmt.Errorf("error updating consensus params: %v", err)
+		}
+		err = validateSwitchHeight(state.ConsensusParams.Validator, nextParams.Validator, header.Height)
 		if err != nil {
 			return state, fmt.Errorf("error updating consensus params: %v", err)
 		}
@@ -409,6 +529,16 @@ func updateState(
 		lastHeightParamsChanged = header.Height + 1
 	}
 
+	// Switch the validator key types if scheduled for the next height. As the
+	// switch height is part of the consensus params, every node switches at
+	// the same height. Only the validator updates are checked against the key
+	// types, so validators with a key type no longer allowed stay in the set
+	// until the application replaces them.
+	if switchedParams, ok := types.SwitchValidatorPubKeyTypes(nextParams, header.Height+1); ok {
+		nextParams = switchedParams
+		lastHeightParamsChanged = header.Height + 1
+	}
+
 	nextVersion := state.Version
 
 	// NOTE: the AppHash has not been populated.
@@ -497,7 +627,12 @@ func ExecCommitBlock(
 	store Store,
 	initialHeight int64,
 ) ([]byte, error) {
-	_, err := execBlockOnProxyApp(context.Background(), logger, appConnConsensus, block, store, initialHeight)
+	params, err := store.LoadConsensusParams(block.Height)
+	if err != nil {
+		return nil, err
+	}
+	_, err = execBlockOnProxyApp(context.Background(), logger, appConnConsensus, block, store, initialHeight,
+		types.OrderingRecordEnabled(params.Block, block.Height))
 	if err != nil {
 		logger.Error("failed executing block on proxy app", "height", block.Height, "err", err)
 		return nil, err
@@ -511,13 +646,15 @@ func ExecCommitBlock(
 	return nil, nil
 }
 
-// Helper to convert CometBFT proto PublicKey to local proto PublicKey
+// toLocalPubKey converts a CometBFT proto PublicKey to the local proto
+// PublicKey. Keys of other types than ed25519 and secp256k1, which CometBFT
+// can't encode, are returned empty and fail to decode.
 func toLocalPubKey(pk cometproto.PublicKey) *protocrypto.PublicKey {
-	// For now, return a simple implementation
-	// In a real implementation, you'd need to handle different key types properly
-	return &protocrypto.PublicKey{
-		Sum: &protocrypto.PublicKey_Ed25519{
-			Ed25519: []byte{}, // TODO: Extract actual key bytes from pk
-		},
+	switch sum := pk.Sum.(type) {
+	case *cometproto.PublicKey_Ed25519:
+		return &protocrypto.PublicKey{Sum: &protocrypto.PublicKey_Ed25519{Ed25519: sum.Ed25519}}
+	case *cometproto.PublicKey_Secp256K1:
+		return &protocrypto.PublicKey{Sum: &protocrypto.PublicKey_Secp256K1{Secp256K1: sum.Secp256K1}}
 	}
+	return &protocrypto.PublicKey{}
 }
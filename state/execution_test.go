@@ -2,6 +2,7 @@ package state_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"testing"
 	"time"
 
@@ -9,13 +10,16 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
-	abci "github.com/cometbft/cometbft/abci/types"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/fluentum-chain/fluentum/abci/compat"
+	abci "github.com/fluentum-chain/fluentum/abci/types"
 	"github.com/fluentum-chain/fluentum/crypto"
 	"github.com/fluentum-chain/fluentum/crypto/ed25519"
-	cryptoenc "github.com/fluentum-chain/fluentum/crypto/encoding"
 	"github.com/fluentum-chain/fluentum/crypto/tmhash"
 	"github.com/fluentum-chain/fluentum/libs/log"
 	mmock "github.com/fluentum-chain/fluentum/mempool/mock"
+	tmabci "github.com/fluentum-chain/fluentum/proto/tendermint/abci"
 	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
 	tmversion "github.com/fluentum-chain/fluentum/proto/tendermint/version"
 	"github.com/fluentum-chain/fluentum/proxy"
@@ -115,10 +119,10 @@ func TestBeginBlockValidators(t *testing.T) {
 			if ctr < len(tc.expectedAbsentValidators) &&
 				tc.expectedAbsentValidators[ctr] == i {
 
-				assert.False(t, v.SignedLastBlock)
+				assert.Equal(t, cmtproto.BlockIDFlagAbsent, v.BlockIdFlag)
 				ctr++
 			} else {
-				assert.True(t, v.SignedLastBlock)
+				assert.Equal(t, cmtproto.BlockIDFlagCommit, v.BlockIdFlag)
 			}
 		}
 	}
@@ -182,19 +186,23 @@ func TestBeginBlockByzantineValidators(t *testing.T) {
 
 	ev := []types.Evidence{dve, lcae}
 
-	abciEv := []types.Evidence{
+	abciVal := abci.Validator{
+		Address: state.Validators.Validators[0].Address,
+		Power:   state.Validators.Validators[0].VotingPower,
+	}
+	abciEv := []abci.Misbehavior{
 		{
-			Type:             types.EvidenceType_DUPLICATE_VOTE,
+			Type:             cmtabci.MisbehaviorType_DUPLICATE_VOTE,
 			Height:           3,
 			Time:             defaultEvidenceTime,
-			Validator:        types.TM2PB.Validator(state.Validators.Validators[0]),
+			Validator:        abciVal,
 			TotalVotingPower: 10,
 		},
 		{
-			Type:             types.EvidenceType_LIGHT_CLIENT_ATTACK,
+			Type:             cmtabci.MisbehaviorType_LIGHT_CLIENT_ATTACK,
 			Height:           8,
 			Time:             defaultEvidenceTime,
-			Validator:        types.TM2PB.Validator(state.Validators.Validators[0]),
+			Validator:        abciVal,
 			TotalVotingPower: 12,
 		},
 	}
@@ -223,10 +231,6 @@ func TestBeginBlockByzantineValidators(t *testing.T) {
 func TestValidateValidatorUpdates(t *testing.T) {
 	pubkey1 := ed25519.GenPrivKey().PubKey()
 	pubkey2 := ed25519.GenPrivKey().PubKey()
-	pk1, err := cryptoenc.PubKeyToProto(pubkey1)
-	assert.NoError(t, err)
-	pk2, err := cryptoenc.PubKeyToProto(pubkey2)
-	assert.NoError(t, err)
 
 	defaultValidatorParams := tmproto.ValidatorParams{PubKeyTypes: []string{types.ABCIPubKeyTypeEd25519}}
 
@@ -240,25 +244,25 @@ func TestValidateValidatorUpdates(t *testing.T) {
 	}{
 		{
 			"adding a validator is OK",
-			[]abci.ValidatorUpdate{{PubKey: pk2, Power: 20}},
+			[]abci.ValidatorUpdate{abciValidatorUpdate(pubkey2, 20)},
 			defaultValidatorParams,
 			false,
 		},
 		{
 			"updating a validator is OK",
-			[]abci.ValidatorUpdate{{PubKey: pk1, Power: 20}},
+			[]abci.ValidatorUpdate{abciValidatorUpdate(pubkey1, 20)},
 			defaultValidatorParams,
 			false,
 		},
 		{
 			"removing a validator is OK",
-			[]abci.ValidatorUpdate{{PubKey: pk2, Power: 0}},
+			[]abci.ValidatorUpdate{abciValidatorUpdate(pubkey2, 0)},
 			defaultValidatorParams,
 			false,
 		},
 		{
 			"adding a validator with negative power results in error",
-			[]abci.ValidatorUpdate{{PubKey: pk2, Power: -100}},
+			[]abci.ValidatorUpdate{abciValidatorUpdate(pubkey2, -100)},
 			defaultValidatorParams,
 			true,
 		},
@@ -283,16 +287,11 @@ func TestUpdateValidators(t *testing.T) {
 	pubkey2 := ed25519.GenPrivKey().PubKey()
 	val2 := types.NewValidator(pubkey2, 20)
 
-	pk, err := cryptoenc.PubKeyToProto(pubkey1)
-	require.NoError(t, err)
-	pk2, err := cryptoenc.PubKeyToProto(pubkey2)
-	require.NoError(t, err)
-
 	testCases := []struct {
 		name string
 
 		currentSet  *types.ValidatorSet
-		abciUpdates []abci.ValidatorUpdate
+		abciUpdates []tmabci.ValidatorUpdate
 
 		resultingSet *types.ValidatorSet
 		shouldErr    bool
@@ -300,28 +299,28 @@ func TestUpdateValidators(t *testing.T) {
 		{
 			"adding a validator is OK",
 			types.NewValidatorSet([]*types.Validator{val1}),
-			[]abci.ValidatorUpdate{{PubKey: pk2, Power: 20}},
+			[]tmabci.ValidatorUpdate{types.TM2PB.NewValidatorUpdate(pubkey2, 20)},
 			types.NewValidatorSet([]*types.Validator{val1, val2}),
 			false,
 		},
 		{
 			"updating a validator is OK",
 			types.NewValidatorSet([]*types.Validator{val1}),
-			[]abci.ValidatorUpdate{{PubKey: pk, Power: 20}},
+			[]tmabci.ValidatorUpdate{types.TM2PB.NewValidatorUpdate(pubkey1, 20)},
 			types.NewValidatorSet([]*types.Validator{types.NewValidator(pubkey1, 20)}),
 			false,
 		},
 		{
 			"removing a validator is OK",
 			types.NewValidatorSet([]*types.Validator{val1, val2}),
-			[]abci.ValidatorUpdate{{PubKey: pk2, Power: 0}},
+			[]tmabci.ValidatorUpdate{types.TM2PB.NewValidatorUpdate(pubkey2, 0)},
 			types.NewValidatorSet([]*types.Validator{val1}),
 			false,
 		},
 		{
 			"removing a non-existing validator results in error",
 			types.NewValidatorSet([]*types.Validator{val1}),
-			[]abci.ValidatorUpdate{{PubKey: pk2, Power: 0}},
+			[]tmabci.ValidatorUpdate{types.TM2PB.NewValidatorUpdate(pubkey2, 0)},
 			types.NewValidatorSet([]*types.Validator{val1}),
 			true,
 		},
@@ -390,10 +389,8 @@ func TestEndBlockValidatorUpdates(t *testing.T) {
 	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: block.MakePartSet(testPartSize).Header()}
 
 	pubkey := ed25519.GenPrivKey().PubKey()
-	pk, err := cryptoenc.PubKeyToProto(pubkey)
-	require.NoError(t, err)
 	app.ValidatorUpdates = []abci.ValidatorUpdate{
-		{PubKey: pk, Power: 10},
+		abciValidatorUpdate(pubkey, 10),
 	}
 
 	state, _, err = blockExec.ApplyBlock(state, blockID, block)
@@ -447,11 +444,9 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	block := makeBlock(state, 1)
 	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: block.MakePartSet(testPartSize).Header()}
 
-	vp, err := cryptoenc.PubKeyToProto(state.Validators.Validators[0].PubKey)
-	require.NoError(t, err)
 	// Remove the only validator
 	app.ValidatorUpdates = []abci.ValidatorUpdate{
-		{PubKey: vp, Power: 0},
+		abciValidatorUpdate(state.Validators.Validators[0].PubKey, 0),
 	}
 
 	assert.NotPanics(t, func() { state, _, err = blockExec.ApplyBlock(state, blockID, block) })
@@ -459,6 +454,211 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	assert.NotEmpty(t, state.NextValidators.Validators)
 }
 
+// paramsApp returns consensus param updates and events for every block.
+type paramsApp struct {
+	abci.BaseApplication
+	updates *cmtproto.ConsensusParams
+	events  []abci.Event
+}
+
+func (app *paramsApp) FinalizeBlock(
+	ctx context.Context,
+	req *abci.FinalizeBlockRequest,
+) (*abci.FinalizeBlockResponse, error) {
+	res, err := app.BaseApplication.FinalizeBlock(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res.ConsensusParamUpdates = app.updates
+	res.Events = app.events
+	return res, nil
+}
+
+// A switch of validator key types is scheduled by the application with a
+// validator_key_switch event next to its validator param updates, and every
+// node switches at the scheduled height.
+func TestApplyBlockSchedulesKeyTypeSwitch(t *testing.T) {
+	app := &paramsApp{}
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mmock.Mempool{}, sm.EmptyEvidencePool{})
+
+	block := makeBlock(state, 1)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: block.MakePartSet(testPartSize).Header()}
+	pubKeyTypes := []string{types.ABCIPubKeyTypeEd25519}
+	applyWithEvents := func(events ...abci.Event) (sm.State, error) {
+		app.updates = &cmtproto.ConsensusParams{
+			Validator: &cmtproto.ValidatorParams{PubKeyTypes: pubKeyTypes},
+		}
+		app.events = events
+		newState, _, err := blockExec.ApplyBlock(state, blockID, block)
+		return newState, err
+	}
+
+	scheduled := tmproto.ValidatorParams{
+		PubKeyTypes:     pubKeyTypes,
+		NextPubKeyTypes: []string{types.ABCIPubKeyTypeEd25519, types.ABCIPubKeyTypeHybrid},
+		SwitchHeight:    5,
+	}
+	newState, err := applyWithEvents(compat.ValidatorKeySwitchEvent(5, scheduled.NextPubKeyTypes))
+	require.NoError(t, err)
+	assert.Equal(t, scheduled, newState.ConsensusParams.Validator)
+
+	// a switch at the next height applies to the validators of that height
+	newState, err = applyWithEvents(compat.ValidatorKeySwitchEvent(2, scheduled.NextPubKeyTypes))
+	require.NoError(t, err)
+	assert.Equal(t, tmproto.ValidatorParams{PubKeyTypes: scheduled.NextPubKeyTypes}, newState.ConsensusParams.Validator)
+
+	// a switch can't be scheduled in the past, nor malformed
+	_, err = applyWithEvents(compat.ValidatorKeySwitchEvent(1, scheduled.NextPubKeyTypes))
+	assert.Error(t, err)
+	malformed := compat.ValidatorKeySwitchEvent(5, scheduled.NextPubKeyTypes)
+	malformed.Attributes[0].Value = "soon"
+	_, err = applyWithEvents(malformed)
+	assert.Error(t, err)
+	_, err = applyWithEvents(compat.ValidatorKeySwitchEvent(5, nil))
+	assert.Error(t, err)
+	_, err = applyWithEvents(
+		compat.ValidatorKeySwitchEvent(5, scheduled.NextPubKeyTypes),
+		compat.ValidatorKeySwitchEvent(6, scheduled.NextPubKeyTypes),
+	)
+	assert.Error(t, err)
+
+	// nor without validator param updates
+	app.updates = nil
+	_, _, err = blockExec.ApplyBlock(state, blockID, block)
+	assert.Error(t, err)
+}
+
+// orderingApp proposes blocks in reverse order, after an ordering record,
+// like the AI ordering application, and records the transactions of the
+// proposals it processes and of the blocks it executes.
+type orderingApp struct {
+	abci.BaseApplication
+	processed [][]byte
+	finalized [][]byte
+}
+
+func (app *orderingApp) PrepareProposal(
+	ctx context.Context,
+	req *abci.PrepareProposalRequest,
+) (*abci.PrepareProposalResponse, error) {
+	record, err := types.NewOrderingRecord(make([]byte, sha256.Size))
+	if err != nil {
+		return nil, err
+	}
+	txs := [][]byte{record}
+	for i := len(req.Txs) - 1; i >= 0; i-- {
+		txs = append(txs, req.Txs[i])
+	}
+	return &abci.PrepareProposalResponse{Txs: txs}, nil
+}
+
+func (app *orderingApp) ProcessProposal(
+	ctx context.Context,
+	req *abci.ProcessProposalRequest,
+) (*abci.ProcessProposalResponse, error) {
+	app.processed = req.Txs
+	return app.BaseApplication.ProcessProposal(ctx, req)
+}
+
+func (app *orderingApp) FinalizeBlock(
+	ctx context.Context,
+	req *abci.FinalizeBlockRequest,
+) (*abci.FinalizeBlockResponse, error) {
+	app.finalized = req.Txs
+	return app.BaseApplication.FinalizeBlock(ctx, req)
+}
+
+// reapMempool is a mempool holding txs.
+type reapMempool struct {
+	mmock.Mempool
+	txs types.Txs
+}
+
+func (mem reapMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return mem.txs }
+
+// From the OrderingRecordHeight on, the ordering record of a proposal is
+// stripped by every node, so nodes without the AI ordering application
+// process and execute the block the same way.
+func TestProposalOrderingRecord(t *testing.T) {
+	app := &orderingApp{}
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	state.ConsensusParams.Block.OrderingRecordHeight = 1
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	proposerAddr := state.Validators.GetProposer().Address
+
+	record, err := types.NewOrderingRecord(make([]byte, sha256.Size))
+	require.NoError(t, err)
+	// records are never taken from the mempool
+	mempool := reapMempool{txs: types.Txs{{1}, {2}, record, {3}}}
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop() //nolint:errcheck // ignore for tests
+	txSub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryTx, 4)
+	require.NoError(t, err)
+
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mempool, sm.EmptyEvidencePool{})
+	blockExec.SetEventBus(eventBus)
+	commit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	block, partSet := blockExec.CreateProposalBlock(1, state, commit, proposerAddr)
+	require.Len(t, block.Txs, 4)
+	assert.Equal(t, record, block.Txs[0])
+	assert.Equal(t, types.Txs{{3}, {2}, {1}}, block.Txs[1:])
+
+	// the application processes and executes the block without the record,
+	// which gets a successful result
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+	accepted, err := blockExec.ProcessProposal(block, state)
+	require.NoError(t, err)
+	assert.True(t, accepted)
+	assert.Equal(t, [][]byte{{3}, {2}, {1}}, app.processed)
+
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+	_, _, err = blockExec.ApplyBlock(state, blockID, block)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{3}, {2}, {1}}, app.finalized)
+	for i := 0; i < len(block.Txs); i++ {
+		msg := <-txSub.Out()
+		txEvent := msg.Data().(types.EventDataTx)
+		assert.Equal(t, []byte(block.Txs[i]), txEvent.Tx)
+		assert.Equal(t, abci.CodeTypeOK, txEvent.Result.Code)
+	}
+
+	// a record anywhere else invalidates the block
+	invalid, _ := state.MakeBlock(1, types.Txs{{1}, record}, commit, nil, proposerAddr)
+	assert.Error(t, blockExec.ValidateBlock(state, invalid))
+	malformed, _ := state.MakeBlock(1, types.Txs{record[:len(record)-1], {1}}, commit, nil, proposerAddr)
+	assert.Error(t, blockExec.ValidateBlock(state, malformed))
+
+	// before the OrderingRecordHeight, proposers drop the record, and a
+	// record is a transaction like any other
+	state.ConsensusParams.Block.OrderingRecordHeight = 2
+	block, _ = blockExec.CreateProposalBlock(1, state, commit, proposerAddr)
+	assert.Equal(t, types.Txs{{3}, {2}, {1}}, block.Txs)
+
+	require.NoError(t, blockExec.ValidateBlock(state, invalid))
+	accepted, err = blockExec.ProcessProposal(invalid, state)
+	require.NoError(t, err)
+	assert.True(t, accepted)
+	assert.Equal(t, [][]byte{{1}, record}, app.processed)
+}
+
 func makeBlockID(hash []byte, partSetSize uint32, partSetHash []byte) types.BlockID {
 	var (
 		h   = make([]byte, tmhash.Size)
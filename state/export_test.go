@@ -39,6 +39,12 @@ func ValidateValidatorUpdates(abciUpdates []abci.ValidatorUpdate, params tmproto
 	return validateValidatorUpdates(abciUpdates, params)
 }
 
+// ValidatorUpdatesFromABCI is an alias for validatorUpdatesFromABCI exported
+// from execution.go, exclusively and explicitly for testing.
+func ValidatorUpdatesFromABCI(abciUpdates []abci.ValidatorUpdate) ([]*types.Validator, error) {
+	return validatorUpdatesFromABCI(abciUpdates)
+}
+
 // SaveValidatorsInfo is an alias for the private saveValidatorsInfo method in
 // store.go, exported exclusively and explicitly for testing.
 func SaveValidatorsInfo(db dbm.DB, height, lastHeightChanged int64, valSet *types.ValidatorSet) error {
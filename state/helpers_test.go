@@ -2,12 +2,16 @@ package state_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 
-	abci "github.com/cometbft/cometbft/abci/types"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	abci "github.com/fluentum-chain/fluentum/abci/types"
+	"github.com/fluentum-chain/fluentum/crypto"
 	"github.com/fluentum-chain/fluentum/crypto/ed25519"
 	tmrand "github.com/fluentum-chain/fluentum/libs/rand"
 	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
@@ -106,10 +110,15 @@ func makeState(nVals, height int) (sm.State, dbm.DB, map[string]types.PrivValida
 		}
 		privVals[valAddr.String()] = types.NewMockPVWithParams(pk, false, false)
 	}
+	// updated params must be valid, and the default evidence params have no
+	// max age duration
+	params := types.DefaultConsensusParams()
+	params.Evidence.MaxAgeDuration = 48 * time.Hour
 	s, _ := sm.MakeGenesisState(&types.GenesisDoc{
-		ChainID:    chainID,
-		Validators: vals,
-		AppHash:    nil,
+		ChainID:         chainID,
+		Validators:      vals,
+		AppHash:         nil,
+		ConsensusParams: params,
 	})
 
 	stateDB := dbm.NewMemDB()
@@ -150,48 +159,48 @@ func genValSet(size int) *types.ValidatorSet {
 	return types.NewValidatorSet(vals)
 }
 
-func makeHeaderPartsResponsesVal(
-	height int64,
+// abciValidatorUpdate returns the ABCI update of the validator with pubKey
+// to power.
+func abciValidatorUpdate(pubKey crypto.PubKey, power int64) abci.ValidatorUpdate {
+	return cmtabci.UpdateValidator(pubKey.Bytes(), power, pubKey.Type())
+}
+
+func makeHeaderPartsResponsesValPubKeyChange(
 	state sm.State,
-	valSet *types.ValidatorSet,
-	appHash []byte,
-) (types.Header, types.BlockID, *ABCIResponses) {
+	pubkey crypto.PubKey,
+) (types.Header, types.BlockID, *sm.ABCIResponses) {
+
 	block := makeBlock(state, state.LastBlockHeight+1)
-	abciResponses := &ABCIResponses{
-		BeginBlock: &abci.ResponseBeginBlock{},
-		EndBlock:   &abci.ResponseEndBlock{ValidatorUpdates: nil},
+	abciResponses := &sm.ABCIResponses{
+		FinalizeBlock: &abci.FinalizeBlockResponse{ValidatorUpdates: nil},
 	}
 	// If the pubkey is new, remove the old and add the new.
 	_, val := state.NextValidators.GetByIndex(0)
-	if !bytes.Equal(valSet.GetPubKey(0).Bytes(), val.PubKey.Bytes()) {
-		abciResponses.EndBlock = &abci.ResponseEndBlock{
-			ValidatorUpdates: []abci.ValidatorUpdate{
-				types.TM2PB.NewValidatorUpdate(val.PubKey, 0),
-				types.TM2PB.NewValidatorUpdate(valSet.GetPubKey(0), 10),
-			},
+	if !bytes.Equal(pubkey.Bytes(), val.PubKey.Bytes()) {
+		abciResponses.FinalizeBlock.ValidatorUpdates = []abci.ValidatorUpdate{
+			abciValidatorUpdate(val.PubKey, 0),
+			abciValidatorUpdate(pubkey, 10),
 		}
 	}
+
 	return block.Header, types.BlockID{Hash: block.Hash(), PartSetHeader: types.PartSetHeader{}}, abciResponses
 }
 
 func makeHeaderPartsResponsesValPowerChange(
 	state sm.State,
 	power int64,
-) (types.Header, types.BlockID, *ABCIResponses) {
+) (types.Header, types.BlockID, *sm.ABCIResponses) {
 
 	block := makeBlock(state, state.LastBlockHeight+1)
-	abciResponses := &ABCIResponses{
-		BeginBlock: &abci.ResponseBeginBlock{},
-		EndBlock:   &abci.ResponseEndBlock{ValidatorUpdates: nil},
+	abciResponses := &sm.ABCIResponses{
+		FinalizeBlock: &abci.FinalizeBlockResponse{ValidatorUpdates: nil},
 	}
 
 	// If the pubkey is new, remove the old and add the new.
 	_, val := state.NextValidators.GetByIndex(0)
 	if val.VotingPower != power {
-		abciResponses.EndBlock = &abci.ResponseEndBlock{
-			ValidatorUpdates: []abci.ValidatorUpdate{
-				types.TM2PB.NewValidatorUpdate(val.PubKey, power),
-			},
+		abciResponses.FinalizeBlock.ValidatorUpdates = []abci.ValidatorUpdate{
+			abciValidatorUpdate(val.PubKey, power),
 		}
 	}
 
@@ -201,12 +210,20 @@ func makeHeaderPartsResponsesValPowerChange(
 func makeHeaderPartsResponsesParams(
 	state sm.State,
 	params tmproto.ConsensusParams,
-) (types.Header, types.BlockID, *ABCIResponses) {
+) (types.Header, types.BlockID, *sm.ABCIResponses) {
 
 	block := makeBlock(state, state.LastBlockHeight+1)
-	abciResponses := &ABCIResponses{
-		BeginBlock: &abci.ResponseBeginBlock{},
-		EndBlock:   &abci.ResponseEndBlock{ConsensusParamUpdates: types.TM2PB.ConsensusParams(&params)},
+	abciResponses := &sm.ABCIResponses{
+		FinalizeBlock: &abci.FinalizeBlockResponse{ConsensusParamUpdates: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: params.Block.MaxBytes, MaxGas: params.Block.MaxGas},
+			Evidence: &cmtproto.EvidenceParams{
+				MaxAgeNumBlocks: params.Evidence.MaxAgeNumBlocks,
+				MaxAgeDuration:  params.Evidence.MaxAgeDuration,
+				MaxBytes:        params.Evidence.MaxBytes,
+			},
+			Validator: &cmtproto.ValidatorParams{PubKeyTypes: params.Validator.PubKeyTypes},
+			Version:   &cmtproto.VersionParams{App: params.Version.AppVersion},
+		}},
 	}
 	return block.Header, types.BlockID{Hash: block.Hash(), PartSetHeader: types.PartSetHeader{}}, abciResponses
 }
@@ -234,16 +251,31 @@ type testApp struct {
 	abci.BaseApplication
 
 	CommitVotes         []abci.VoteInfo
-	ByzantineValidators []types.Evidence
+	ByzantineValidators []abci.Misbehavior
 	ValidatorUpdates    []abci.ValidatorUpdate
 }
 
 var _ abci.Application = (*testApp)(nil)
 
-func (app *testApp) Info(req abci.RequestInfo) (resInfo abci.ResponseInfo) {
-	return abci.ResponseInfo{}
+func (app *testApp) FinalizeBlock(
+	ctx context.Context,
+	req *abci.FinalizeBlockRequest,
+) (*abci.FinalizeBlockResponse, error) {
+	app.CommitVotes = req.DecidedLastCommit.Votes
+	app.ByzantineValidators = req.Misbehavior
+	res, err := app.BaseApplication.FinalizeBlock(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res.ValidatorUpdates = app.ValidatorUpdates
+	res.ConsensusParamUpdates = &cmtproto.ConsensusParams{
+		Version: &cmtproto.VersionParams{
+			App: 1,
+		},
+	}
+	return res, nil
 }
 
-func (app *testApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuery) {
-	return
+func (app *testApp) Commit(context.Context, *abci.CommitRequest) (*abci.CommitResponse, error) {
+	return &abci.CommitResponse{RetainHeight: 1}, nil
 }
@@ -7,8 +7,6 @@ import (
 
 	state "github.com/fluentum-chain/fluentum/state"
 
-	tendermintstate "github.com/fluentum-chain/fluentum/proto/tendermint/state"
-
 	tenderminttypes "github.com/fluentum-chain/fluentum/types"
 
 	types "github.com/fluentum-chain/fluentum/proto/tendermint/types"
@@ -69,15 +67,15 @@ func (_m *Store) Load() (state.State, error) {
 }
 
 // LoadABCIResponses provides a mock function with given fields: _a0
-func (_m *Store) LoadABCIResponses(_a0 int64) (*tendermintstate.ABCIResponses, error) {
+func (_m *Store) LoadABCIResponses(_a0 int64) (*state.ABCIResponses, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *tendermintstate.ABCIResponses
-	if rf, ok := ret.Get(0).(func(int64) *tendermintstate.ABCIResponses); ok {
+	var r0 *state.ABCIResponses
+	if rf, ok := ret.Get(0).(func(int64) *state.ABCIResponses); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*tendermintstate.ABCIResponses)
+			r0 = ret.Get(0).(*state.ABCIResponses)
 		}
 	}
 
@@ -155,15 +153,15 @@ func (_m *Store) LoadFromDBOrGenesisFile(_a0 string) (state.State, error) {
 }
 
 // LoadLastABCIResponse provides a mock function with given fields: _a0
-func (_m *Store) LoadLastABCIResponse(_a0 int64) (*tendermintstate.ABCIResponses, error) {
+func (_m *Store) LoadLastABCIResponse(_a0 int64) (*state.ABCIResponses, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *tendermintstate.ABCIResponses
-	if rf, ok := ret.Get(0).(func(int64) *tendermintstate.ABCIResponses); ok {
+	var r0 *state.ABCIResponses
+	if rf, ok := ret.Get(0).(func(int64) *state.ABCIResponses); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*tendermintstate.ABCIResponses)
+			r0 = ret.Get(0).(*state.ABCIResponses)
 		}
 	}
 
@@ -229,11 +227,11 @@ func (_m *Store) Save(_a0 state.State) error {
 }
 
 // SaveABCIResponses provides a mock function with given fields: _a0, _a1
-func (_m *Store) SaveABCIResponses(_a0 int64, _a1 *tendermintstate.ABCIResponses) error {
+func (_m *Store) SaveABCIResponses(_a0 int64, _a1 *state.ABCIResponses) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int64, *tendermintstate.ABCIResponses) error); ok {
+	if rf, ok := ret.Get(0).(func(int64, *state.ABCIResponses) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -366,7 +366,8 @@ func (store dbStore) PruneStates(from int64, to int64) error {
 //------------------------------------------------------------------------
 
 // ABCIResponsesResultsHash returns the Merkle root hash of
-// ABCIResponses.Results
+// ABCIResponses.Results. It isn't computed: the results hash of every block
+// is nil.
 func ABCIResponsesResultsHash(ar *ABCIResponses) []byte {
 	return nil
 }
@@ -387,17 +388,16 @@ func (store dbStore) LoadABCIResponses(height int64) (*ABCIResponses, error) {
 		return nil, ErrNoABCIResponsesForHeight{height}
 	}
 
-	abciResponses := new(ABCIResponses)
-	// Commented out: proto.Unmarshal with *ABCIResponses if not proto.Message
-	// err = proto.Unmarshal(buf, abciResponses)
-	// if err != nil {
-	// 	// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
-	// 	tmos.Exit(fmt.Sprintf(`LoadABCIResponses: Data has been corrupted or its spec has
-	//                 changed: %v\n`, err))
-	// }
+	abciResponses := new(tmstate.ABCIResponsesInfo)
+	err = abciResponses.Unmarshal(buf)
+	if err != nil {
+		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
+		tmos.Exit(fmt.Sprintf(`LoadABCIResponses: Data has been corrupted or its spec has
+                changed: %v\n`, err))
+	}
 	// TODO: ensure that buf is completely read.
 
-	return abciResponses, nil
+	return abciResponsesFromProto(abciResponses.AbciResponses)
 }
 
 // LoadLastABCIResponse loads the last ABCIResponse for the given height.
@@ -411,20 +411,20 @@ func (store dbStore) LoadLastABCIResponse(height int64) (*ABCIResponses, error)
 		return nil, errors.New("no last ABCI response has been persisted")
 	}
 
-	// abciResponse := new(ABCIResponsesInfo)
-	// err = abciResponse.Unmarshal(bz)
-	// if err != nil {
-	// 	tmos.Exit(fmt.Sprintf(`LoadLastABCIResponses: Data has been corrupted or its spec has
-	// 		changed: %v\n`, err))
-	// }
+	abciResponse := new(tmstate.ABCIResponsesInfo)
+	err = abciResponse.Unmarshal(bz)
+	if err != nil {
+		tmos.Exit(fmt.Sprintf(`LoadLastABCIResponses: Data has been corrupted or its spec has
+			changed: %v\n`, err))
+	}
 
-	// // Here we validate the result by comparing its height to the expected height.
-	// if height != abciResponse.GetHeight() {
-	// 	return nil, errors.New("expected height %d but last stored abci responses was at height %d")
-	// }
+	// Here we validate the result by comparing its height to the expected height.
+	if height != abciResponse.GetHeight() {
+		return nil, fmt.Errorf("expected height %d but last stored abci responses was at height %d",
+			height, abciResponse.GetHeight())
+	}
 
-	// return abciResponse.AbciResponses, nil
-	return nil, nil
+	return abciResponsesFromProto(abciResponse.AbciResponses)
 }
 
 // SaveABCIResponses persists the ABCIResponses to the database.
@@ -434,28 +434,32 @@ func (store dbStore) LoadLastABCIResponse(height int64) (*ABCIResponses, error)
 //
 // CONTRACT: height must be monotonically increasing every time this is called.
 func (store dbStore) SaveABCIResponses(height int64, abciResponses *ABCIResponses) error {
-	// Save the ABCIResponse for crash recovery and queries.
+	pb, err := abciResponses.toProto()
+	if err != nil {
+		return err
+	}
+	// The responses are saved with their height, so that they are never
+	// empty.
+	response := &tmstate.ABCIResponsesInfo{
+		AbciResponses: pb,
+		Height:        height,
+	}
+	bz, err := response.Marshal()
+	if err != nil {
+		return err
+	}
+
+	// If the flag is false then we save the ABCIResponse. This can be used for the /BlockResults
+	// query or to reindex an event using the command line.
 	if !store.DiscardABCIResponses {
-		// TODO: Properly serialize abciResponses (not a proto.Message)
-		// bz, err := proto.Marshal(abciResponses)
-		// if err != nil {
-		// 	return err
-		// }
-	}
-
-	// Always save the last ABCI response for crash recovery.
-	// TODO: Properly serialize response (not a proto.Message)
-	// response := &ABCIResponsesInfo{
-	// 	AbciResponses: abciResponses,
-	// 	Height:        height,
-	// }
-	// bz, err := proto.Marshal(response)
-	// if err != nil {
-	// 	return err
-	// }
-	// return store.db.SetSync(lastABCIResponseKey, bz)
-	// TODO: Implement crash recovery serialization for ABCIResponses
-	return nil
+		if err := store.db.Set(calcABCIResponsesKey(height), bz); err != nil {
+			return err
+		}
+	}
+
+	// We always save the last ABCI response for crash recovery.
+	// This overwrites the previous saved ABCI Response.
+	return store.db.SetSync(lastABCIResponseKey, bz)
 }
 
 //-----------------------------------------------------------------------------
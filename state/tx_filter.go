@@ -1,6 +1,7 @@
 package state
 
 import (
+	"errors"
 	"fmt"
 
 	mempl "github.com/fluentum-chain/fluentum/mempool"
@@ -8,7 +9,8 @@ import (
 )
 
 // TxPreCheck returns a function to filter transactions before processing.
-// The function limits the size of a transaction to the block's maximum data size.
+// The function limits the size of a transaction to the block's maximum data size,
+// and rejects ordering records, which only proposers may add to blocks.
 func TxPreCheck(state State) mempl.PreCheckFunc {
 	var validatorCount int
 	if state.Validators != nil {
@@ -16,10 +18,16 @@ func TxPreCheck(state State) mempl.PreCheckFunc {
 	}
 	fmt.Printf("[DEBUG] TxPreCheck: state.ConsensusParams.Block.MaxBytes=%d, validatorCount=%d\n", state.ConsensusParams.Block.MaxBytes, validatorCount)
 	maxDataBytes := types.MaxDataBytesNoEvidence(
-		state.ConsensusParams.Block.MaxBytes,
+		state.ConsensusParams.Block.MaxBytes-types.MaxQuantumCommitBytes(state.Validators),
 		validatorCount,
 	)
-	return mempl.PreCheckMaxBytes(maxDataBytes)
+	preCheckMaxBytes := mempl.PreCheckMaxBytes(maxDataBytes)
+	return func(tx types.Tx) error {
+		if types.IsOrderingRecord(tx) {
+			return errors.New("ordering records can only be added by block proposers")
+		}
+		return preCheckMaxBytes(tx)
+	}
 }
 
 // TxPostCheck returns a function to filter transactions after processing.
@@ -1,6 +1,7 @@
 package state_test
 
 import (
+	"crypto/sha256"
 	"os"
 	"testing"
 
@@ -47,3 +48,13 @@ func TestTxFilter(t *testing.T) {
 		}
 	}
 }
+
+func TestTxFilterOrderingRecord(t *testing.T) {
+	state, _, _ := makeState(1, 1)
+	record, err := types.NewOrderingRecord(make([]byte, sha256.Size))
+	require.NoError(t, err)
+
+	f := sm.TxPreCheck(state)
+	assert.Error(t, f(record))
+	assert.NoError(t, f(types.Tx("tx")))
+}
@@ -18,6 +18,12 @@ func validateBlock(state State, block *types.Block) error {
 		return err
 	}
 
+	if types.OrderingRecordEnabled(state.ConsensusParams.Block, block.Height) {
+		if err := types.ValidateOrderingRecord(block.Txs); err != nil {
+			return err
+		}
+	}
+
 	// Validate basic info.
 	if block.Version.App != state.Version.Consensus.App ||
 		block.Version.Block != state.Version.Consensus.Block {
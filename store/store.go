@@ -1,6 +1,7 @@
 package store
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -11,15 +12,17 @@ import (
 	tmstore "github.com/fluentum-chain/fluentum/proto/tendermint/store"
 	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
 	"github.com/fluentum-chain/fluentum/types"
+	"github.com/fluentum-chain/fluentum/zkprover"
 )
 
 /*
 BlockStore is a simple low level store for blocks.
 
-There are three types of information stored:
+There are four types of information stored:
   - BlockMeta:   Meta information about each block
   - Block part:  Parts of each block, aggregated w/ PartSet
   - Commit:      The commit part of each block, for gossiping precommit votes
+  - ZK batches:  Zero-knowledge proofs over the transactions of each block
 
 Currently the precommit signatures are duplicated in the Block parts as
 well as the Commit.  In the future this may change, perhaps by moving
@@ -297,6 +300,9 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
 			return 0, err
 		}
+		if err := batch.Delete(calcZKBatchesKey(h)); err != nil {
+			return 0, err
+		}
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
 			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
 				return 0, err
@@ -425,6 +431,67 @@ func (bs *BlockStore) SaveSeenCommit(height int64, seenCommit *types.Commit) err
 	return bs.db.Set(calcSeenCommitKey(height), seenCommitBytes)
 }
 
+// SaveZKBatches persists the ZK proof batches produced for the block at the
+// given height, and records the height as the last proved one, in a single
+// write. It overwrites any batches previously saved for that height. Blocks
+// without transactions are recorded as proved with no batches.
+func (bs *BlockStore) SaveZKBatches(height int64, batches []zkprover.ZKBatch) error {
+	if height < bs.Base() || height > bs.Height() {
+		return fmt.Errorf("no block at height %v (base %v, height %v)", height, bs.Base(), bs.Height())
+	}
+
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+
+	if len(batches) > 0 {
+		bz, err := json.Marshal(batches)
+		if err != nil {
+			return fmt.Errorf("unable to marshal zk batches: %w", err)
+		}
+		if err := batch.Set(calcZKBatchesKey(height), bz); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(zkProvedHeightKey, []byte(strconv.FormatInt(height, 10))); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// ZKProvedHeight returns the height of the last block saved with
+// SaveZKBatches, or 0 if none was.
+func (bs *BlockStore) ZKProvedHeight() int64 {
+	bz, err := bs.db.Get(zkProvedHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0
+	}
+	height, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		panic(fmt.Errorf("error reading zk proved height: %w", err))
+	}
+	return height
+}
+
+// LoadZKBatches returns the ZK proof batches saved for the block at the given
+// height, ordered by batch index. If none were saved, it returns nil.
+func (bs *BlockStore) LoadZKBatches(height int64) []zkprover.ZKBatch {
+	bz, err := bs.db.Get(calcZKBatchesKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	var batches []zkprover.ZKBatch
+	if err := json.Unmarshal(bz, &batches); err != nil {
+		panic(fmt.Errorf("error reading zk batches: %w", err))
+	}
+	return batches
+}
+
 func (bs *BlockStore) Close() error {
 	return bs.db.Close()
 }
@@ -447,6 +514,10 @@ func calcSeenCommitKey(height int64) []byte {
 	return []byte(fmt.Sprintf("SC:%v", height))
 }
 
+func calcZKBatchesKey(height int64) []byte {
+	return []byte(fmt.Sprintf("ZK:%v", height))
+}
+
 func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
@@ -455,6 +526,8 @@ func calcBlockHashKey(hash []byte) []byte {
 
 var blockStoreKey = []byte("blockStore")
 
+var zkProvedHeightKey = []byte("zkProvedHeight")
+
 // SaveBlockStoreState persists the blockStore state to the database.
 func SaveBlockStoreState(bsj *tmstore.BlockStoreState, db dbm.DB) {
 	bytes, err := proto.Marshal(bsj)
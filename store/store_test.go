@@ -24,6 +24,7 @@ import (
 	"github.com/fluentum-chain/fluentum/types"
 	tmtime "github.com/fluentum-chain/fluentum/types/time"
 	"github.com/fluentum-chain/fluentum/version"
+	"github.com/fluentum-chain/fluentum/zkprover"
 )
 
 // A cleanupFunc cleans up any config / test files created for a particular
@@ -460,6 +461,7 @@ func TestPruneBlocks(t *testing.T) {
 	assert.EqualValues(t, 1500, bs.Size())
 
 	prunedBlock := bs.LoadBlock(1199)
+	require.NoError(t, bs.SaveZKBatches(1199, []zkprover.ZKBatch{{Height: 1199, Proof: []byte("proof")}}))
 
 	// Check that basic pruning works
 	pruned, err := bs.PruneBlocks(1200)
@@ -479,6 +481,7 @@ func TestPruneBlocks(t *testing.T) {
 	require.Nil(t, bs.LoadBlockCommit(1199))
 	require.Nil(t, bs.LoadBlockMeta(1199))
 	require.Nil(t, bs.LoadBlockPart(1199, 1))
+	require.Nil(t, bs.LoadZKBatches(1199))
 
 	for i := int64(1); i < 1200; i++ {
 		require.Nil(t, bs.LoadBlock(i))
@@ -515,6 +518,36 @@ func TestPruneBlocks(t *testing.T) {
 	assert.Nil(t, bs.LoadBlock(1501))
 }
 
+func TestSaveLoadZKBatches(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	// no block at height 1 yet
+	batches := []zkprover.ZKBatch{
+		{Height: 1, Index: 0, Proof: []byte("p0"), PublicSignals: []byte(`["1"]`)},
+		{Height: 1, Index: 1, Proof: []byte("p1"), PublicSignals: []byte(`["2"]`)},
+	}
+	require.Error(t, bs.SaveZKBatches(1, batches))
+
+	block := makeBlock(1, state, new(types.Commit))
+	bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(1, tmtime.Now()))
+	require.Nil(t, bs.LoadZKBatches(1))
+
+	assert.EqualValues(t, 0, bs.ZKProvedHeight())
+
+	require.NoError(t, bs.SaveZKBatches(1, batches))
+	assert.Equal(t, batches, bs.LoadZKBatches(1))
+	assert.Nil(t, bs.LoadZKBatches(2))
+	assert.EqualValues(t, 1, bs.ZKProvedHeight())
+
+	// blocks without transactions are proved without batches
+	block = makeBlock(2, state, makeTestCommit(1, tmtime.Now()))
+	bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(2, tmtime.Now()))
+	require.NoError(t, bs.SaveZKBatches(2, nil))
+	assert.Nil(t, bs.LoadZKBatches(2))
+	assert.EqualValues(t, 2, bs.ZKProvedHeight())
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := freshBlockStore()
 	height := int64(10)
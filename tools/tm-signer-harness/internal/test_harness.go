@@ -109,7 +109,11 @@ func NewTestHarness(logger log.Logger, cfg TestHarnessConfig) (*TestHarness, err
 		return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
 	}
 
-	signerClient, err := privval.NewSignerClient(spv, st.ChainID)
+	signerClient, err := privval.NewSignerClient(
+		spv,
+		st.ChainID,
+		privval.SignerClientKeyTypes(st.ConsensusParams.Validator.PubKeyTypes...),
+	)
 	if err != nil {
 		return nil, newTestHarnessError(ErrFailedToCreateListener, err, "")
 	}
@@ -199,7 +203,8 @@ func (th *TestHarness) TestPublicKey() error {
 	th.logger.Info("Local", "pubKey", fpvk)
 	sck, err := th.signerClient.GetPubKey()
 	if err != nil {
-		return err
+		th.logger.Error("FAILED: Remote public key could not be retrieved", "err", err)
+		return newTestHarnessError(ErrTestPublicKeyFailed, err, "")
 	}
 	th.logger.Info("Remote", "pubKey", sck)
 	if !bytes.Equal(fpvk.Bytes(), sck.Bytes()) {
@@ -236,6 +241,7 @@ func (th *TestHarness) TestSignProposal() error {
 		return newTestHarnessError(ErrTestSignProposalFailed, err, "")
 	}
 	prop.Signature = p.Signature
+	prop.QuantumSignature = p.QuantumSignature
 	th.logger.Debug("Signed proposal", "prop", prop)
 	// first check that it's a basically valid proposal
 	if err := prop.ValidateBasic(); err != nil {
@@ -247,7 +253,7 @@ func (th *TestHarness) TestSignProposal() error {
 		return err
 	}
 	// now validate the signature on the proposal
-	if sck.VerifySignature(propBytes, prop.Signature) {
+	if types.VerifySignatures(sck, propBytes, prop.Signature, prop.QuantumSignature) {
 		th.logger.Info("Successfully validated proposal signature")
 	} else {
 		th.logger.Error("FAILED: Proposal signature validation failed")
@@ -286,6 +292,7 @@ func (th *TestHarness) TestSignVote() error {
 			return newTestHarnessError(ErrTestSignVoteFailed, err, fmt.Sprintf("voteType=%d", voteType))
 		}
 		vote.Signature = v.Signature
+		vote.QuantumSignature = v.QuantumSignature
 		th.logger.Debug("Signed vote", "vote", vote)
 		// validate the contents of the vote
 		if err := vote.ValidateBasic(); err != nil {
@@ -298,7 +305,7 @@ func (th *TestHarness) TestSignVote() error {
 		}
 
 		// now validate the signature on the proposal
-		if sck.VerifySignature(voteBytes, vote.Signature) {
+		if types.VerifySignatures(sck, voteBytes, vote.Signature, vote.QuantumSignature) {
 			th.logger.Info("Successfully validated vote signature", "type", voteType)
 		} else {
 			th.logger.Error("FAILED: Vote signature validation failed", "type", voteType)
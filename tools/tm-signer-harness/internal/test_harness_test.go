@@ -1,8 +1,10 @@
 package internal
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 
 	"github.com/fluentum-chain/fluentum/crypto"
 	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	tmjson "github.com/fluentum-chain/fluentum/libs/json"
 	"github.com/fluentum-chain/fluentum/libs/log"
 	"github.com/fluentum-chain/fluentum/privval"
 	"github.com/fluentum-chain/fluentum/types"
@@ -122,6 +125,42 @@ func TestRemoteSignerVoteSigningFailed(t *testing.T) {
 	)
 }
 
+func TestRemoteSignerTestHarnessQuantumKeys(t *testing.T) {
+	for _, keyType := range []string{privval.KeyTypeDilithium3, privval.KeyTypeHybrid} {
+		t.Run(keyType, func(t *testing.T) {
+			privKey, err := privval.GenPrivKey(keyType)
+			require.NoError(t, err)
+			cfg := makeConfigWithKey(t, privKey, []string{privKey.Type()})
+
+			harnessTestWithConfig(
+				t,
+				cfg,
+				func(th *TestHarness) *privval.SignerServer {
+					return newMockSignerServer(t, th, th.fpv.Key.PrivKey, false, false)
+				},
+				NoError,
+			)
+		})
+	}
+}
+
+func TestRemoteSignerKeyTypeNotAccepted(t *testing.T) {
+	privKey, err := privval.GenPrivKey(privval.KeyTypeHybrid)
+	require.NoError(t, err)
+	// the chain only accepts ed25519 keys
+	cfg := makeConfig(t, 100, 3)
+	privval.NewFilePV(privKey, cfg.KeyFile, cfg.StateFile).Save()
+
+	harnessTestWithConfig(
+		t,
+		cfg,
+		func(th *TestHarness) *privval.SignerServer {
+			return newMockSignerServer(t, th, th.fpv.Key.PrivKey, false, false)
+		},
+		ErrTestPublicKeyFailed,
+	)
+}
+
 func newMockSignerServer(
 	t *testing.T,
 	th *TestHarness,
@@ -145,7 +184,15 @@ func newMockSignerServer(
 
 // For running relatively standard tests.
 func harnessTest(t *testing.T, signerServerMaker func(th *TestHarness) *privval.SignerServer, expectedExitCode int) {
-	cfg := makeConfig(t, 100, 3)
+	harnessTestWithConfig(t, makeConfig(t, 100, 3), signerServerMaker, expectedExitCode)
+}
+
+func harnessTestWithConfig(
+	t *testing.T,
+	cfg TestHarnessConfig,
+	signerServerMaker func(th *TestHarness) *privval.SignerServer,
+	expectedExitCode int,
+) {
 	defer cleanup(cfg)
 
 	th, err := NewTestHarness(log.TestingLogger(), cfg)
@@ -179,6 +226,28 @@ func makeConfig(t *testing.T, acceptDeadline, acceptRetries int) TestHarnessConf
 	}
 }
 
+// makeConfigWithKey returns the config of a harness for a chain with privKey
+// as its only validator, and accepting keyTypes.
+func makeConfigWithKey(t *testing.T, privKey crypto.PrivKey, keyTypes []string) TestHarnessConfig {
+	cfg := makeConfig(t, 100, 3)
+	privval.NewFilePV(privKey, cfg.KeyFile, cfg.StateFile).Save()
+
+	pubKeyJSON, err := tmjson.Marshal(privKey.PubKey())
+	require.NoError(t, err)
+	keyTypesJSON, err := json.Marshal(keyTypes)
+	require.NoError(t, err)
+	genesis := strings.Replace(genesisFileContents, `[
+				"ed25519"
+			]`, string(keyTypesJSON), 1)
+	genesis = strings.Replace(genesis, `"address": "D08FCA3BA74CF17CBFC15E64F9505302BB0E2748",
+		"pub_key": {
+			"type": "tendermint/PubKeyEd25519",
+			"value": "ZCsuTjaczEyon70nmKxwvwu+jqrbq5OH3yQjcK0SFxc="
+		},`, fmt.Sprintf(`"pub_key": %s,`, pubKeyJSON), 1)
+	require.NoError(t, os.WriteFile(cfg.GenesisFile, []byte(genesis), 0o600))
+	return cfg
+}
+
 func cleanup(cfg TestHarnessConfig) {
 	os.Remove(cfg.KeyFile)
 	os.Remove(cfg.StateFile)
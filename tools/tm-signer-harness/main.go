@@ -134,7 +134,12 @@ func extractKey(tmhome, outputPath string) {
 	keyFile := filepath.Join(internal.ExpandPath(tmhome), "config", "priv_validator_key.json")
 	stateFile := filepath.Join(internal.ExpandPath(tmhome), "data", "priv_validator_state.json")
 	fpv := privval.LoadFilePV(keyFile, stateFile)
-	pkb := []byte(fpv.Key.PrivKey.(ed25519.PrivKey))
+	privKey, ok := fpv.Key.PrivKey.(ed25519.PrivKey)
+	if !ok {
+		logger.Error("Only ed25519 keys can be extracted", "keyType", fpv.Key.PrivKey.Type())
+		os.Exit(1)
+	}
+	pkb := []byte(privKey)
 	if err := os.WriteFile(internal.ExpandPath(outputPath), pkb[:32], 0o600); err != nil {
 		logger.Info("Failed to write private key", "output", outputPath, "err", err)
 		os.Exit(1)
@@ -614,6 +614,7 @@ type CommitSig struct {
 	ValidatorAddress Address     `json:"validator_address"`
 	Timestamp        time.Time   `json:"timestamp"`
 	Signature        []byte      `json:"signature"`
+	QuantumSignature []byte      `json:"quantum_signature,omitempty"`
 }
 
 // NewCommitSigForBlock returns new CommitSig with BlockIDFlagCommit.
@@ -632,6 +633,24 @@ func MaxCommitBytes(valCount int) int64 {
 	return MaxCommitOverheadBytes + ((MaxCommitSigBytes + protoEncodingOverhead) * int64(valCount))
 }
 
+// MaxQuantumCommitBytes returns the size the QuantumSignatures of the
+// validators of vals with post-quantum keys add to MaxCommitBytes.
+func MaxQuantumCommitBytes(vals *ValidatorSet) int64 {
+	// 1 byte for the field tag, 2 for its length and 1 more for the length of
+	// the commit sig, which no longer fits in 1 byte.
+	const protoEncodingOverhead int64 = 4
+	if vals == nil {
+		return 0
+	}
+	var size int64
+	for _, val := range vals.Validators {
+		if n := quantumSignatureSize(val.PubKey); n > 0 {
+			size += int64(n) + protoEncodingOverhead
+		}
+	}
+	return size
+}
+
 // NewCommitSigAbsent returns new CommitSig with BlockIDFlagAbsent. Other
 // fields are all empty.
 func NewCommitSigAbsent() CommitSig {
@@ -699,7 +718,7 @@ func (cs CommitSig) ValidateBasic() error {
 		if !cs.Timestamp.IsZero() {
 			return errors.New("time is present")
 		}
-		if len(cs.Signature) != 0 {
+		if len(cs.Signature) != 0 || len(cs.QuantumSignature) != 0 {
 			return errors.New("signature is present")
 		}
 	default:
@@ -710,11 +729,8 @@ func (cs CommitSig) ValidateBasic() error {
 			)
 		}
 		// NOTE: Timestamp validation is subtle and handled elsewhere.
-		if len(cs.Signature) == 0 {
-			return errors.New("signature is missing")
-		}
-		if len(cs.Signature) > MaxSignatureSize {
-			return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
+		if err := validateSignatures(cs.Signature, cs.QuantumSignature); err != nil {
+			return err
 		}
 	}
 
@@ -732,6 +748,7 @@ func (cs *CommitSig) ToProto() *tmproto.CommitSig {
 		ValidatorAddress: cs.ValidatorAddress,
 		Timestamp:        cs.Timestamp,
 		Signature:        cs.Signature,
+		QuantumSignature: cs.QuantumSignature,
 	}
 }
 
@@ -743,6 +760,7 @@ func (cs *CommitSig) FromProto(csp tmproto.CommitSig) error {
 	cs.ValidatorAddress = csp.ValidatorAddress
 	cs.Timestamp = csp.Timestamp
 	cs.Signature = csp.Signature
+	cs.QuantumSignature = csp.QuantumSignature
 
 	return cs.ValidateBasic()
 }
@@ -797,6 +815,7 @@ func CommitToVoteSet(chainID string, commit *Commit, vals *ValidatorSet) *VoteSe
 			BlockID:          commitSig.BlockID(commit.BlockID),
 			Timestamp:        commitSig.Timestamp,
 			Signature:        commitSig.Signature,
+			QuantumSignature: commitSig.QuantumSignature,
 		}
 		_, err := voteSet.AddVote(vote)
 		if err != nil {
@@ -823,6 +842,7 @@ func (commit *Commit) GetVote(valIdx int32) *Vote {
 		ValidatorAddress: commitSig.ValidatorAddress,
 		ValidatorIndex:   valIdx,
 		Signature:        commitSig.Signature,
+		QuantumSignature: commitSig.QuantumSignature,
 	}
 }
 
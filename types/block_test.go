@@ -417,7 +417,8 @@ func TestMaxHeaderBytes(t *testing.T) {
 		ProposerAddress:    crypto.AddressHash([]byte("proposer_address")),
 	}
 
-	bz, err := h.ToProto().Marshal()
+	pbh := h.ToProto()
+	bz, err := pbh.Marshal()
 	require.NoError(t, err)
 
 	assert.EqualValues(t, MaxHeaderBytes, int64(len(bz)))
@@ -29,8 +29,8 @@ func TestEventBusPublishEventTx(t *testing.T) {
 	tx := Tx("foo")
 	result := abci.ExecTxResult{
 		Data: []byte("bar"),
-		Events: []*abci.Event{
-			{Type: "testType", Attributes: []*abci.EventAttribute{{Key: "baz", Value: "1"}}},
+		Events: []abci.Event{
+			{Type: "testType", Attributes: []abci.EventAttribute{{Key: "baz", Value: "1"}}},
 		},
 	}
 
@@ -77,8 +77,8 @@ func TestEventBusPublishEventNewBlock(t *testing.T) {
 
 	block := MakeBlock(0, []Tx{}, nil, []Evidence{})
 	events := []*abci.Event{
-		{Type: "testType", Attributes: []*abci.EventAttribute{{Key: "baz", Value: "1"}}},
-		{Type: "testType", Attributes: []*abci.EventAttribute{{Key: "foz", Value: "2"}}},
+		{Type: "testType", Attributes: []abci.EventAttribute{{Key: "baz", Value: "1"}}},
+		{Type: "testType", Attributes: []abci.EventAttribute{{Key: "foz", Value: "2"}}},
 	}
 
 	// PublishEventNewBlock adds the tm.event compositeKey, so the query below should work
@@ -121,10 +121,10 @@ func TestEventBusPublishEventTxDuplicateKeys(t *testing.T) {
 	tx := Tx("foo")
 	result := abci.ExecTxResult{
 		Data: []byte("bar"),
-		Events: []*abci.Event{
+		Events: []abci.Event{
 			{
 				Type: "transfer",
-				Attributes: []*abci.EventAttribute{
+				Attributes: []abci.EventAttribute{
 					{Key: "sender", Value: "foo"},
 					{Key: "recipient", Value: "bar"},
 					{Key: "amount", Value: "5"},
@@ -132,7 +132,7 @@ func TestEventBusPublishEventTxDuplicateKeys(t *testing.T) {
 			},
 			{
 				Type: "transfer",
-				Attributes: []*abci.EventAttribute{
+				Attributes: []abci.EventAttribute{
 					{Key: "sender", Value: "baz"},
 					{Key: "recipient", Value: "cat"},
 					{Key: "amount", Value: "13"},
@@ -140,7 +140,7 @@ func TestEventBusPublishEventTxDuplicateKeys(t *testing.T) {
 			},
 			{
 				Type: "withdraw.rewards",
-				Attributes: []*abci.EventAttribute{
+				Attributes: []abci.EventAttribute{
 					{Key: "address", Value: "bar"},
 					{Key: "source", Value: "iceman"},
 					{Key: "amount", Value: "33"},
@@ -228,8 +228,8 @@ func TestEventBusPublishEventNewBlockHeader(t *testing.T) {
 
 	block := MakeBlock(0, []Tx{}, nil, []Evidence{})
 	events := []*abci.Event{
-		{Type: "testType", Attributes: []*abci.EventAttribute{{Key: "baz", Value: "1"}}},
-		{Type: "testType", Attributes: []*abci.EventAttribute{{Key: "foz", Value: "2"}}},
+		{Type: "testType", Attributes: []abci.EventAttribute{{Key: "baz", Value: "1"}}},
+		{Type: "testType", Attributes: []abci.EventAttribute{{Key: "foz", Value: "2"}}},
 	}
 
 	// PublishEventNewBlockHeader adds the tm.event compositeKey, so the query below should work
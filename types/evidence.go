@@ -573,10 +573,12 @@ func NewMockDuplicateVoteEvidenceWithValidator(height int64, time time.Time,
 	vA := voteA.ToProto()
 	_ = pv.SignVote(chainID, vA)
 	voteA.Signature = vA.Signature
+	voteA.QuantumSignature = vA.QuantumSignature
 	voteB := makeMockVote(height, 0, 0, pubKey.Address(), randBlockID(), time)
 	vB := voteB.ToProto()
 	_ = pv.SignVote(chainID, vB)
 	voteB.Signature = vB.Signature
+	voteB.QuantumSignature = vB.QuantumSignature
 	return NewDuplicateVoteEvidence(voteA, voteB, time, NewValidatorSet([]*Validator{val}))
 }
 
@@ -144,10 +144,19 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 		return err
 	}
 
+	if sh := genDoc.ConsensusParams.Validator.SwitchHeight; sh != 0 && sh <= genDoc.InitialHeight {
+		return fmt.Errorf("validator switch_height (%d) must be greater than initial_height (%d)",
+			sh, genDoc.InitialHeight)
+	}
+
 	for i, v := range genDoc.Validators {
 		if v.Power == 0 {
 			return fmt.Errorf("the genesis file cannot contain validators with no voting power: %v", v)
 		}
+		if !IsValidPubkeyType(genDoc.ConsensusParams.Validator, v.PubKey.Type()) {
+			return fmt.Errorf("validator %v in the genesis file is using pubkey %s, which is unsupported for consensus",
+				v, v.PubKey.Type())
+		}
 		if len(v.Address) > 0 && !bytes.Equal(v.PubKey.Address(), v.Address) {
 			return fmt.Errorf("incorrect address for validator %v in the genesis file, should be %v", v, v.PubKey.Address())
 		}
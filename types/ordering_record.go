@@ -0,0 +1,83 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
+)
+
+// orderingRecordPrefix starts the ordering record, the transaction a proposer
+// puts first in a block it ordered with the AI model. The record holds the
+// hash of the model, so the ordering of every block can be traced back to
+// the weights that produced it.
+//
+// The record is not a transaction of the application: every node strips it
+// before processing and executing a block, and records a successful result
+// for it, so blocks with and without records execute the same way on every
+// node. Records are only allowed from the OrderingRecordHeight of the block
+// params on, so that existing chains opt in with a coordinated upgrade. The
+// mempool never accepts them.
+const orderingRecordPrefix = "fluentum/ai-order/v1:"
+
+// OrderingRecordSize is the size of an ordering record.
+const OrderingRecordSize = len(orderingRecordPrefix) + sha256.Size
+
+// OrderingRecordEnabled returns whether the block at height, under the block
+// params, may hold an ordering record.
+func OrderingRecordEnabled(params tmproto.BlockParams, height int64) bool {
+	return params.OrderingRecordHeight > 0 && height >= params.OrderingRecordHeight
+}
+
+// NewOrderingRecord returns the ordering record of a block ordered by the
+// model with the given hash.
+func NewOrderingRecord(modelHash []byte) (Tx, error) {
+	if len(modelHash) != sha256.Size {
+		return nil, fmt.Errorf("model hash has %d bytes, expected %d", len(modelHash), sha256.Size)
+	}
+	record := make(Tx, 0, OrderingRecordSize)
+	record = append(record, orderingRecordPrefix...)
+	return append(record, modelHash...), nil
+}
+
+// ParseOrderingRecord returns the model hash held by tx, and whether tx is an
+// ordering record.
+func ParseOrderingRecord(tx []byte) ([]byte, bool) {
+	if len(tx) != OrderingRecordSize || !bytes.HasPrefix(tx, []byte(orderingRecordPrefix)) {
+		return nil, false
+	}
+	return tx[len(orderingRecordPrefix):], true
+}
+
+// IsOrderingRecord returns whether tx has the prefix of an ordering record.
+// Unlike ParseOrderingRecord, it also matches malformed records.
+func IsOrderingRecord(tx []byte) bool {
+	return bytes.HasPrefix(tx, []byte(orderingRecordPrefix))
+}
+
+// StripOrderingRecord returns txs without its leading ordering record, if
+// any.
+func StripOrderingRecord(txs [][]byte) [][]byte {
+	if len(txs) > 0 && IsOrderingRecord(txs[0]) {
+		return txs[1:]
+	}
+	return txs
+}
+
+// ValidateOrderingRecord returns an error if txs holds an ordering record
+// that is malformed or isn't the first transaction.
+func ValidateOrderingRecord(txs Txs) error {
+	for i, tx := range txs {
+		if !IsOrderingRecord(tx) {
+			continue
+		}
+		if i != 0 {
+			return fmt.Errorf("ordering record at index %d", i)
+		}
+		if _, ok := ParseOrderingRecord(tx); !ok {
+			return fmt.Errorf("malformed ordering record of %d bytes", len(tx))
+		}
+	}
+	return nil
+}
@@ -63,7 +63,12 @@ func DefaultEvidenceParams() tmproto.EvidenceParams {
 }
 
 // DefaultValidatorParams returns a default ValidatorParams, which allows
-// only ed25519 pubkeys.
+// only ed25519 pubkeys. Adding ABCIPubKeyTypeHybrid lets validators migrate
+// to hybrid pubkeys, whose votes and proposals carry both an ed25519 and a
+// dilithium signature. Such a migration is scheduled by setting
+// NextPubKeyTypes and SwitchHeight, either in the genesis or through the
+// consensus param updates of the application, see
+// compat.EventTypeValidatorKeySwitch.
 func DefaultValidatorParams() tmproto.ValidatorParams {
 	return tmproto.ValidatorParams{
 		PubKeyTypes: []string{ABCIPubKeyTypeEd25519},
@@ -107,6 +112,11 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 			params.Block.TimeIotaMs)
 	}
 
+	if params.Block.OrderingRecordHeight < 0 {
+		return fmt.Errorf("block.OrderingRecordHeight must be non negative. Got %d",
+			params.Block.OrderingRecordHeight)
+	}
+
 	if params.Evidence.MaxAgeNumBlocks <= 0 {
 		return fmt.Errorf("evidence.MaxAgeNumBlocks must be greater than 0. Got %d",
 			params.Evidence.MaxAgeNumBlocks)
@@ -144,6 +154,30 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 		}
 	}
 
+	if params.Validator.SwitchHeight < 0 {
+		return fmt.Errorf("validator.SwitchHeight must be non negative. Got: %d",
+			params.Validator.SwitchHeight)
+	}
+
+	if params.Validator.SwitchHeight == 0 {
+		if len(params.Validator.NextPubKeyTypes) > 0 {
+			return errors.New("validator.NextPubKeyTypes must be empty if no SwitchHeight is set")
+		}
+		return nil
+	}
+
+	if len(params.Validator.NextPubKeyTypes) == 0 {
+		return errors.New("len(Validator.NextPubKeyTypes) must be greater than 0 if a SwitchHeight is set")
+	}
+
+	for i := 0; i < len(params.Validator.NextPubKeyTypes); i++ {
+		keyType := params.Validator.NextPubKeyTypes[i]
+		if _, ok := ABCIPubKeyTypesToNames[keyType]; !ok {
+			return fmt.Errorf("params.Validator.NextPubKeyTypes[%d], %s, is an unknown pubkey type",
+				i, keyType)
+		}
+	}
+
 	return nil
 }
 
@@ -171,6 +205,26 @@ func HashConsensusParams(params tmproto.ConsensusParams) []byte {
 	return hasher.Sum(nil)
 }
 
+// SwitchValidatorPubKeyTypes returns a copy of the params for the given
+// height. If a switch of validator key types is scheduled at or before
+// height, the NextPubKeyTypes replace the PubKeyTypes and it returns true.
+//
+// The key types only restrict the validator updates of the application: the
+// validators of the set whose key type is no longer allowed keep signing
+// after the switch, until the application replaces or removes them.
+func SwitchValidatorPubKeyTypes(params tmproto.ConsensusParams, height int64) (tmproto.ConsensusParams, bool) {
+	res := params // explicit copy
+
+	if res.Validator.SwitchHeight == 0 || height < res.Validator.SwitchHeight {
+		return res, false
+	}
+
+	res.Validator = tmproto.ValidatorParams{
+		PubKeyTypes: append([]string{}, params.Validator.NextPubKeyTypes...),
+	}
+	return res, true
+}
+
 // Update returns a copy of the params with updates from the non-zero fields of p2.
 // NOTE: note: must not modify the original
 func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *abci.ConsensusParams) tmproto.ConsensusParams {
@@ -194,6 +248,12 @@ func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *abci.Consens
 		// Copy params2.Validator.PubkeyTypes, and set result's value to the copy.
 		// This avoids having to initialize the slice to 0 values, and then write to it again.
 		res.Validator.PubKeyTypes = append([]string{}, params2.Validator.PubKeyTypes...)
+		// A switch of key types is scheduled, or rescheduled, only by updates
+		// with a SwitchHeight, so that other updates keep a pending switch.
+		if params2.Validator.SwitchHeight != 0 {
+			res.Validator.NextPubKeyTypes = append([]string{}, params2.Validator.NextPubKeyTypes...)
+			res.Validator.SwitchHeight = params2.Validator.SwitchHeight
+		}
 	}
 	if params2.Version != nil {
 		res.Version.AppVersion = params2.Version.AppVersion
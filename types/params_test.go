@@ -8,7 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
-	abci "github.com/cometbft/cometbft/abci/types"
+	abci "github.com/fluentum-chain/fluentum/proto/tendermint/abci"
 	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
 )
 
@@ -75,6 +75,36 @@ func makeParams(
 	}
 }
 
+func TestConsensusParamsValidationSwitch(t *testing.T) {
+	testCases := []struct {
+		nextPubKeyTypes []string
+		switchHeight    int64
+		valid           bool
+	}{
+		0: {nil, 0, true},
+		1: {valSecp256k1, 10, true},
+		2: {[]string{ABCIPubKeyTypeEd25519, ABCIPubKeyTypeHybrid}, 10, true},
+		// test negative switch height
+		3: {valSecp256k1, -1, false},
+		// test next pubkey types without a switch height
+		4: {valSecp256k1, 0, false},
+		// test switch height without next pubkey types
+		5: {nil, 10, false},
+		// test invalid next pubkey type provided
+		6: {[]string{"potatoes make good pubkeys"}, 10, false},
+	}
+	for i, tc := range testCases {
+		params := makeParams(1, 0, 10, 2, 0, valEd25519)
+		params.Validator.NextPubKeyTypes = tc.nextPubKeyTypes
+		params.Validator.SwitchHeight = tc.switchHeight
+		if tc.valid {
+			assert.NoErrorf(t, ValidateConsensusParams(params), "expected no error for valid params (#%d)", i)
+		} else {
+			assert.Errorf(t, ValidateConsensusParams(params), "expected error for non valid params (#%d)", i)
+		}
+	}
+}
+
 func TestConsensusParamsHash(t *testing.T) {
 	params := []tmproto.ConsensusParams{
 		makeParams(4, 2, 10, 3, 1, valEd25519),
@@ -105,19 +135,19 @@ func TestConsensusParamsHash(t *testing.T) {
 func TestConsensusParamsUpdate(t *testing.T) {
 	testCases := []struct {
 		params        tmproto.ConsensusParams
-		updates       *types.ConsensusParams
+		updates       *abci.ConsensusParams
 		updatedParams tmproto.ConsensusParams
 	}{
 		// empty updates
 		{
 			makeParams(1, 2, 10, 3, 0, valEd25519),
-			&types.ConsensusParams{},
+			&abci.ConsensusParams{},
 			makeParams(1, 2, 10, 3, 0, valEd25519),
 		},
 		// fine updates
 		{
 			makeParams(1, 2, 10, 3, 0, valEd25519),
-			&types.ConsensusParams{
+			&abci.ConsensusParams{
 				Block: &abci.BlockParams{
 					MaxBytes: 100,
 					MaxGas:   200,
@@ -145,7 +175,54 @@ func TestConsensusParamsUpdate_AppVersion(t *testing.T) {
 	assert.EqualValues(t, 0, params.Version.AppVersion)
 
 	updated := UpdateConsensusParams(params,
-		&types.ConsensusParams{Version: &tmproto.VersionParams{AppVersion: 1}})
+		&abci.ConsensusParams{Version: &tmproto.VersionParams{AppVersion: 1}})
 
 	assert.EqualValues(t, 1, updated.Version.AppVersion)
 }
+
+func TestConsensusParamsUpdate_SwitchHeight(t *testing.T) {
+	params := makeParams(1, 2, 10, 3, 0, valEd25519)
+
+	scheduled := UpdateConsensusParams(params, &abci.ConsensusParams{
+		Validator: &tmproto.ValidatorParams{
+			PubKeyTypes:     valEd25519,
+			NextPubKeyTypes: valSecp256k1,
+			SwitchHeight:    10,
+		},
+	})
+	assert.Equal(t, valEd25519, scheduled.Validator.PubKeyTypes)
+	assert.Equal(t, valSecp256k1, scheduled.Validator.NextPubKeyTypes)
+	assert.EqualValues(t, 10, scheduled.Validator.SwitchHeight)
+
+	// an update without a switch height keeps the pending switch
+	updated := UpdateConsensusParams(scheduled, &abci.ConsensusParams{
+		Validator: &tmproto.ValidatorParams{PubKeyTypes: valEd25519},
+	})
+	assert.Equal(t, scheduled.Validator, updated.Validator)
+}
+
+func TestSwitchValidatorPubKeyTypes(t *testing.T) {
+	params := makeParams(1, 2, 10, 3, 0, valEd25519)
+
+	// no switch scheduled
+	res, ok := SwitchValidatorPubKeyTypes(params, 100)
+	assert.False(t, ok)
+	assert.Equal(t, params, res)
+
+	params.Validator.NextPubKeyTypes = valSecp256k1
+	params.Validator.SwitchHeight = 10
+
+	// before the switch height
+	res, ok = SwitchValidatorPubKeyTypes(params, 9)
+	assert.False(t, ok)
+	assert.Equal(t, params, res)
+
+	// at the switch height
+	res, ok = SwitchValidatorPubKeyTypes(params, 10)
+	assert.True(t, ok)
+	assert.Equal(t, tmproto.ValidatorParams{PubKeyTypes: valSecp256k1}, res.Validator)
+	assert.Equal(t, params.Block, res.Block)
+	assert.NoError(t, ValidateConsensusParams(res))
+	// the original params are not modified
+	assert.EqualValues(t, 10, params.Validator.SwitchHeight)
+}
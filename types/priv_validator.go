@@ -81,7 +81,7 @@ func (pv MockPV) SignVote(chainID string, vote *tmproto.Vote) error {
 	if err != nil {
 		return err
 	}
-	vote.Signature = sig
+	vote.Signature, vote.QuantumSignature = SplitSignature(pv.PrivKey.PubKey(), sig)
 	return nil
 }
 
@@ -97,7 +97,7 @@ func (pv MockPV) SignProposal(chainID string, proposal *tmproto.Proposal) error
 	if err != nil {
 		return err
 	}
-	proposal.Signature = sig
+	proposal.Signature, proposal.QuantumSignature = SplitSignature(pv.PrivKey.PubKey(), sig)
 	return nil
 }
 
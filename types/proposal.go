@@ -23,13 +23,14 @@ var (
 // a so-called Proof-of-Lock (POL) round, as noted in the POLRound.
 // If POLRound >= 0, then BlockID corresponds to the block that is locked in POLRound.
 type Proposal struct {
-	Type      tmproto.SignedMsgType
-	Height    int64     `json:"height"`
-	Round     int32     `json:"round"`     // there can not be greater than 2_147_483_647 rounds
-	POLRound  int32     `json:"pol_round"` // -1 if null.
-	BlockID   BlockID   `json:"block_id"`
-	Timestamp time.Time `json:"timestamp"`
-	Signature []byte    `json:"signature"`
+	Type             tmproto.SignedMsgType
+	Height           int64     `json:"height"`
+	Round            int32     `json:"round"`     // there can not be greater than 2_147_483_647 rounds
+	POLRound         int32     `json:"pol_round"` // -1 if null.
+	BlockID          BlockID   `json:"block_id"`
+	Timestamp        time.Time `json:"timestamp"`
+	Signature        []byte    `json:"signature"`
+	QuantumSignature []byte    `json:"quantum_signature,omitempty"`
 }
 
 // NewProposal returns a new Proposal.
@@ -69,14 +70,7 @@ func (p *Proposal) ValidateBasic() error {
 
 	// NOTE: Timestamp validation is subtle and handled elsewhere.
 
-	if len(p.Signature) == 0 {
-		return errors.New("signature is missing")
-	}
-
-	if len(p.Signature) > MaxSignatureSize {
-		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
-	}
-	return nil
+	return validateSignatures(p.Signature, p.QuantumSignature)
 }
 
 // String returns a string representation of the Proposal.
@@ -132,6 +126,7 @@ func (p *Proposal) ToProto() *tmproto.Proposal {
 	pb.PolRound = p.POLRound
 	pb.Timestamp = p.Timestamp
 	pb.Signature = p.Signature
+	pb.QuantumSignature = p.QuantumSignature
 
 	return pb
 }
@@ -157,6 +152,7 @@ func ProposalFromProto(pp *tmproto.Proposal) (*Proposal, error) {
 	p.POLRound = pp.PolRound
 	p.Timestamp = pp.Timestamp
 	p.Signature = pp.Signature
+	p.QuantumSignature = pp.QuantumSignature
 
 	return p, p.ValidateBasic()
 }
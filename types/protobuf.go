@@ -2,8 +2,10 @@ package types
 
 import (
 	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
 	"github.com/fluentum-chain/fluentum/crypto/ed25519"
 	cryptoenc "github.com/fluentum-chain/fluentum/crypto/encoding"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
 	"github.com/fluentum-chain/fluentum/crypto/secp256k1"
 	abci "github.com/fluentum-chain/fluentum/proto/tendermint/abci"
 	protocrypto "github.com/fluentum-chain/fluentum/proto/tendermint/crypto"
@@ -17,6 +19,8 @@ import (
 const (
 	ABCIPubKeyTypeEd25519   = ed25519.KeyType
 	ABCIPubKeyTypeSecp256k1 = secp256k1.KeyType
+	ABCIPubKeyTypeDilithium = dilithium.KeyType
+	ABCIPubKeyTypeHybrid    = hybrid.KeyType
 )
 
 // TODO: Make non-global by allowing for registration of more pubkey types
@@ -24,6 +28,8 @@ const (
 var ABCIPubKeyTypesToNames = map[string]string{
 	ABCIPubKeyTypeEd25519:   ed25519.PubKeyName,
 	ABCIPubKeyTypeSecp256k1: secp256k1.PubKeyName,
+	ABCIPubKeyTypeDilithium: dilithium.PubKeyName,
+	ABCIPubKeyTypeHybrid:    hybrid.PubKeyName,
 }
 
 //-------------------------------------------------------
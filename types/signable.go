@@ -1,7 +1,13 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
 	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
 	tmmath "github.com/fluentum-chain/fluentum/libs/math"
 )
 
@@ -10,6 +16,10 @@ var (
 	// and Vote.
 	// XXX: secp256k1 does not have Size nor MaxSize defined.
 	MaxSignatureSize = tmmath.MaxInt(ed25519.SignatureSize, 64)
+
+	// MaxQuantumSignatureSize is a maximum allowed post-quantum signature size
+	// for the Proposal and Vote.
+	MaxQuantumSignatureSize = dilithium.MaxSignatureSize
 )
 
 // Signable is an interface for all signable things.
@@ -21,3 +31,78 @@ var (
 type Signable interface {
 	SignBytes(chainID string) []byte
 }
+
+// SplitSignature splits a signature made with the private key of pubKey into
+// the Signature and QuantumSignature of a Vote or a Proposal. Dilithium keys
+// only make a QuantumSignature, hybrid keys make both and other keys only make
+// a Signature.
+func SplitSignature(pubKey crypto.PubKey, sig []byte) (signature, quantumSignature []byte) {
+	switch pubKey.(type) {
+	case dilithium.PubKey:
+		return nil, sig
+	case hybrid.PubKey:
+		return hybrid.SplitSignature(sig)
+	default:
+		return sig, nil
+	}
+}
+
+// VerifySignatures verifies the Signature and QuantumSignature of a Vote, a
+// Proposal or a CommitSig. Validators with hybrid keys must provide both
+// signatures, and every signature provided must be valid.
+func VerifySignatures(pubKey crypto.PubKey, msg, signature, quantumSignature []byte) bool {
+	sig, ok := joinSignatures(pubKey, signature, quantumSignature)
+	return ok && pubKey.VerifySignature(msg, sig)
+}
+
+// joinSignatures returns the signature pubKey.VerifySignature expects for the
+// Signature and QuantumSignature of a Vote, a Proposal or a CommitSig, or
+// false if a signature that pubKey requires is missing or one it doesn't make
+// is present.
+func joinSignatures(pubKey crypto.PubKey, signature, quantumSignature []byte) ([]byte, bool) {
+	switch pubKey.(type) {
+	case dilithium.PubKey:
+		return quantumSignature, len(signature) == 0
+	case hybrid.PubKey:
+		if len(signature) != hybrid.ClassicSignatureSize || len(quantumSignature) == 0 {
+			return nil, false
+		}
+		return append(append([]byte{}, signature...), quantumSignature...), true
+	default:
+		return signature, len(quantumSignature) == 0
+	}
+}
+
+// quantumSignatureSize returns the size of the QuantumSignatures made by
+// pubKey, which is 0 for classical keys.
+func quantumSignatureSize(pubKey crypto.PubKey) int {
+	var (
+		m  dilithium.Mode
+		ok bool
+	)
+	switch pk := pubKey.(type) {
+	case dilithium.PubKey:
+		m, ok = pk.Mode()
+	case hybrid.PubKey:
+		m, ok = pk.Mode()
+	}
+	if !ok {
+		return 0
+	}
+	return m.SignatureSize()
+}
+
+// validateSignatures checks that at least one of the signatures of a Vote, a
+// Proposal or a CommitSig is present, and that none is too big.
+func validateSignatures(signature, quantumSignature []byte) error {
+	if len(signature) == 0 && len(quantumSignature) == 0 {
+		return errors.New("signature is missing")
+	}
+	if len(signature) > MaxSignatureSize {
+		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
+	}
+	if len(quantumSignature) > MaxQuantumSignatureSize {
+		return fmt.Errorf("quantum signature is too big (max: %d)", MaxQuantumSignatureSize)
+	}
+	return nil
+}
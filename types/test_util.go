@@ -40,6 +40,7 @@ func signAddVote(privVal PrivValidator, vote *Vote, voteSet *VoteSet) (signed bo
 		return false, err
 	}
 	vote.Signature = v.Signature
+	vote.QuantumSignature = v.QuantumSignature
 	return voteSet.AddVote(vote)
 }
 
@@ -73,6 +74,7 @@ func MakeVote(
 	}
 
 	vote.Signature = v.Signature
+	vote.QuantumSignature = v.QuantumSignature
 
 	return vote, nil
 }
@@ -76,6 +76,26 @@ func (txs Txs) IndexByHash(hash []byte) int {
 	return -1
 }
 
+// ToSliceOfBytes returns the transactions as a slice of byte slices, as used
+// by ABCI requests and responses.
+func (txs Txs) ToSliceOfBytes() [][]byte {
+	txBzs := make([][]byte, len(txs))
+	for i := 0; i < len(txs); i++ {
+		txBzs[i] = txs[i]
+	}
+	return txBzs
+}
+
+// ToTxs converts a slice of byte slices, as used by ABCI requests and
+// responses, to transactions.
+func ToTxs(txBzs [][]byte) Txs {
+	txs := make(Txs, len(txBzs))
+	for i := 0; i < len(txBzs); i++ {
+		txs[i] = txBzs[i]
+	}
+	return txs
+}
+
 // Proof returns a simple merkle proof for this node.
 // Panics if i < 0 or i >= len(txs)
 // TODO: optimize this!
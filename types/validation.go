@@ -2,8 +2,11 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/batch"
 	"github.com/fluentum-chain/fluentum/crypto/tmhash"
 	tmtime "github.com/fluentum-chain/fluentum/types/time"
 )
@@ -38,3 +41,238 @@ func ValidateHash(h []byte) error {
 	}
 	return nil
 }
+
+// batchVerifyThreshold is the minimum number of signatures of a commit for
+// them to be batch verified.
+const batchVerifyThreshold = 2
+
+func shouldBatchVerify(vals *ValidatorSet, commit *Commit) bool {
+	if len(commit.Signatures) < batchVerifyThreshold {
+		return false
+	}
+	for _, val := range vals.Validators {
+		if !batch.SupportsBatchVerifier(val.PubKey) {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyCommitBatch batch verifies commits. This routine is equivalent to
+// verifyCommitSingle in behavior, just faster iff every signature in the
+// batch is valid.
+//
+// Note: The caller is responsible for checking to see if this routine is
+// usable via `shouldBatchVerify(vals, commit)`.
+func verifyCommitBatch(
+	chainID string,
+	vals *ValidatorSet,
+	commit *Commit,
+	votingPowerNeeded int64,
+	ignoreSig func(CommitSig) bool,
+	countSig func(CommitSig) bool,
+	countAllSignatures bool,
+	lookUpByIndex bool,
+) error {
+	var (
+		bv                 = newCommitBatchVerifier()
+		seenVals           = make(map[int32]int, len(commit.Signatures))
+		talliedVotingPower int64
+	)
+	for idx, commitSig := range commit.Signatures {
+		// skip over signatures that should be ignored
+		if ignoreSig(commitSig) {
+			continue
+		}
+
+		val, err := commitValidator(vals, commit, idx, seenVals, lookUpByIndex)
+		if err != nil {
+			return err
+		}
+		// if the signature doesn't belong to anyone in the validator set
+		// then we just skip over it
+		if val == nil {
+			continue
+		}
+
+		sig, ok := joinSignatures(val.PubKey, commitSig.Signature, commitSig.QuantumSignature)
+		if !ok {
+			return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
+		}
+		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
+		if err := bv.add(idx, val.PubKey, voteSignBytes, sig); err != nil {
+			return fmt.Errorf("wrong signature (#%d): %w", idx, err)
+		}
+
+		// If this signature counts then add the voting power of the validator
+		// to the tally
+		if countSig(commitSig) {
+			talliedVotingPower += val.VotingPower
+		}
+
+		// if we don't need to verify all signatures and already have sufficient
+		// voting power we can break from batching and verify all the signatures
+		if !countAllSignatures && talliedVotingPower > votingPowerNeeded {
+			break
+		}
+	}
+
+	// ensure that we have batched together enough signatures to exceed the
+	// voting power needed else there is no need to even verify
+	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+	}
+
+	if idx, ok := bv.verify(); !ok {
+		return fmt.Errorf("wrong signature (#%d): %X", idx, commit.Signatures[idx].Signature)
+	}
+	return nil
+}
+
+// verifyCommitSingle verifies the signatures of a commit one by one. It is
+// used when a key of the validator set does not support batch verification,
+// and behaves like verifyCommitBatch otherwise.
+func verifyCommitSingle(
+	chainID string,
+	vals *ValidatorSet,
+	commit *Commit,
+	votingPowerNeeded int64,
+	ignoreSig func(CommitSig) bool,
+	countSig func(CommitSig) bool,
+	countAllSignatures bool,
+	lookUpByIndex bool,
+) error {
+	var (
+		seenVals           = make(map[int32]int, len(commit.Signatures))
+		talliedVotingPower int64
+	)
+	for idx, commitSig := range commit.Signatures {
+		if ignoreSig(commitSig) {
+			continue
+		}
+
+		val, err := commitValidator(vals, commit, idx, seenVals, lookUpByIndex)
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			continue
+		}
+
+		// Validate signature.
+		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
+		if !VerifySignatures(val.PubKey, voteSignBytes, commitSig.Signature, commitSig.QuantumSignature) {
+			return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
+		}
+
+		if countSig(commitSig) {
+			talliedVotingPower += val.VotingPower
+		}
+
+		// check if we have enough signatures and can thus exit early
+		if !countAllSignatures && talliedVotingPower > votingPowerNeeded {
+			return nil
+		}
+	}
+
+	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+	}
+
+	return nil
+}
+
+// commitValidator returns the validator of the signature idx of commit, or
+// nil if it is not in vals.
+//
+// If the vals and commit have a 1-to-1 correspondence, the validator is
+// retrieved by index. Otherwise it is retrieved by address, and seenVals is
+// used to make sure that the same validator doesn't commit twice.
+func commitValidator(
+	vals *ValidatorSet,
+	commit *Commit,
+	idx int,
+	seenVals map[int32]int,
+	lookUpByIndex bool,
+) (*Validator, error) {
+	if lookUpByIndex {
+		return vals.Validators[idx], nil
+	}
+
+	valIdx, val := vals.GetByAddress(commit.Signatures[idx].ValidatorAddress)
+	if val == nil {
+		return nil, nil
+	}
+	if firstIndex, ok := seenVals[valIdx]; ok {
+		secondIndex := idx
+		return nil, fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
+	}
+	seenVals[valIdx] = idx
+	return val, nil
+}
+
+// commitBatchVerifier batch verifies the signatures of a commit with one
+// crypto.BatchVerifier per key type, as the validators may use different key
+// types while the signature scheme is being migrated.
+type commitBatchVerifier struct {
+	batches map[string]*keyTypeBatch
+}
+
+type keyTypeBatch struct {
+	verifier crypto.BatchVerifier
+	// indexes of the signatures in commit.Signatures, in the order they were
+	// added to verifier
+	sigIdxs []int
+}
+
+func newCommitBatchVerifier() *commitBatchVerifier {
+	return &commitBatchVerifier{batches: make(map[string]*keyTypeBatch)}
+}
+
+// add adds the signature idx of the commit to the batch of its key type.
+func (v *commitBatchVerifier) add(idx int, pubKey crypto.PubKey, msg, sig []byte) error {
+	b, ok := v.batches[pubKey.Type()]
+	if !ok {
+		bv, ok := batch.CreateBatchVerifier(pubKey)
+		if !ok {
+			// This should *NEVER* happen, see shouldBatchVerify.
+			return fmt.Errorf("key type %s does not support batch verification", pubKey.Type())
+		}
+		b = &keyTypeBatch{verifier: bv}
+		v.batches[pubKey.Type()] = b
+	}
+	if err := b.verifier.Add(pubKey, msg, sig); err != nil {
+		return err
+	}
+	b.sigIdxs = append(b.sigIdxs, idx)
+	return nil
+}
+
+// verify verifies every batch, and returns true if every signature is valid,
+// or else the index in commit.Signatures of the first invalid signature.
+func (v *commitBatchVerifier) verify() (int, bool) {
+	var invalid []int
+	for _, b := range v.batches {
+		ok, validSigs := b.verifier.Verify()
+		if ok {
+			continue
+		}
+		found := false
+		for i, valid := range validSigs {
+			if !valid {
+				invalid = append(invalid, b.sigIdxs[i])
+				found = true
+			}
+		}
+		if !found {
+			// Verify() returned `false, []bool{true, ..., true}`, which is a
+			// bug. Fail safe by blaming the first signature of the batch.
+			invalid = append(invalid, b.sigIdxs[0])
+		}
+	}
+	if len(invalid) == 0 {
+		return 0, true
+	}
+	sort.Ints(invalid)
+	return invalid[0], false
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
 	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
 	tmmath "github.com/fluentum-chain/fluentum/libs/math"
 	tmrand "github.com/fluentum-chain/fluentum/libs/rand"
 	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
@@ -327,7 +329,7 @@ func TestProposerSelection3(t *testing.T) {
 		got := vset.GetProposer().Address
 		expected := proposerOrder[j%4].Address
 		if !bytes.Equal(got, expected) {
-			t.Fatalf(fmt.Sprintf("vset.Proposer (%X) does not match expected proposer (%X) for (%d, %d)", got, expected, i, j))
+			t.Fatalf("vset.Proposer (%X) does not match expected proposer (%X) for (%d, %d)", got, expected, i, j)
 		}
 
 		// serialize, deserialize, check proposer
@@ -338,13 +340,11 @@ func TestProposerSelection3(t *testing.T) {
 		if i != 0 {
 			if !bytes.Equal(got, computed.Address) {
 				t.Fatalf(
-					fmt.Sprintf(
-						"vset.Proposer (%X) does not match computed proposer (%X) for (%d, %d)",
-						got,
-						computed.Address,
-						i,
-						j,
-					),
+					"vset.Proposer (%X) does not match computed proposer (%X) for (%d, %d)",
+					got,
+					computed.Address,
+					i,
+					j,
 				)
 			}
 		}
@@ -768,6 +768,98 @@ func TestValidatorSet_VerifyCommit_CheckAllSignatures(t *testing.T) {
 	}
 }
 
+func TestValidatorSet_VerifyCommit_Hybrid(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+		vals    = make([]*Validator, 4)
+		privs   = make([]PrivValidator, 4)
+	)
+
+	// half of the validators have migrated to hybrid keys
+	for i := range privs {
+		var privKey crypto.PrivKey = ed25519.GenPrivKey()
+		if i%2 == 0 {
+			privKey = hybrid.GenPrivKeyWithMode(dilithium.Mode2)
+		}
+		privs[i] = NewMockPVWithParams(privKey, false, false)
+		vals[i] = NewValidator(privKey.PubKey(), 10)
+	}
+	sort.Sort(PrivValidatorsByAddress(privs))
+	valSet := NewValidatorSet(vals)
+	voteSet := NewVoteSet(chainID, h, 0, tmproto.PrecommitType, valSet)
+	commit, err := MakeCommit(blockID, h, 0, voteSet, privs, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, valSet.VerifyCommit(chainID, blockID, h, commit))
+	assert.LessOrEqual(t, int64(commit.ToProto().Size()), MaxCommitBytes(4)+MaxQuantumCommitBytes(valSet))
+
+	for idx, val := range valSet.Validators {
+		if val.PubKey.Type() != hybrid.KeyType {
+			continue
+		}
+		// drop the quantum signature of a hybrid validator
+		stripped := *commit
+		stripped.Signatures = append([]CommitSig{}, commit.Signatures...)
+		stripped.Signatures[idx].QuantumSignature = nil
+
+		err = valSet.VerifyCommit(chainID, blockID, h, &stripped)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), fmt.Sprintf("wrong signature (#%d)", idx))
+		}
+		err = valSet.VerifyCommitLightTrusting(chainID, &stripped, tmmath.Fraction{Numerator: 1, Denominator: 1})
+		assert.Error(t, err)
+	}
+}
+
+func TestValidatorSet_VerifyCommit_BatchMixedKeyTypes(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+		vals    = make([]*Validator, 6)
+		privs   = make([]PrivValidator, 6)
+	)
+
+	// the signatures are spread over an ed25519 batch and a parallel batch
+	for i := range privs {
+		var privKey crypto.PrivKey = ed25519.GenPrivKey()
+		if i%2 == 0 {
+			privKey = dilithium.GenPrivKeyWithMode(dilithium.Mode2)
+		}
+		privs[i] = NewMockPVWithParams(privKey, false, false)
+		vals[i] = NewValidator(privKey.PubKey(), 10)
+	}
+	sort.Sort(PrivValidatorsByAddress(privs))
+	valSet := NewValidatorSet(vals)
+	require.True(t, shouldBatchVerify(valSet, &Commit{Signatures: make([]CommitSig, 6)}))
+
+	voteSet := NewVoteSet(chainID, h, 0, tmproto.PrecommitType, valSet)
+	commit, err := MakeCommit(blockID, h, 0, voteSet, privs, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, valSet.VerifyCommit(chainID, blockID, h, commit))
+	require.NoError(t, valSet.VerifyCommitLight(chainID, blockID, h, commit))
+
+	// the first invalid signature is reported, whatever its batch
+	for _, idx := range []int{1, 4} {
+		malleated := *commit
+		malleated.Signatures = append([]CommitSig{}, commit.Signatures...)
+		sig := &malleated.Signatures[idx]
+		if len(sig.QuantumSignature) > 0 {
+			sig.QuantumSignature = append([]byte{}, sig.QuantumSignature...)
+			sig.QuantumSignature[0] ^= 0x01
+		} else {
+			sig.Signature = append([]byte{}, sig.Signature...)
+			sig.Signature[0] ^= 0x01
+		}
+
+		err = valSet.VerifyCommit(chainID, blockID, h, &malleated)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), fmt.Sprintf("wrong signature (#%d)", idx))
+		}
+	}
+}
+
 func TestValidatorSet_VerifyCommitLight_ReturnsAsSoonAsMajorityOfVotingPowerSigned(t *testing.T) {
 	var (
 		chainID = "test_chain_id"
@@ -56,6 +56,7 @@ type Vote struct {
 	ValidatorAddress Address               `json:"validator_address"`
 	ValidatorIndex   int32                 `json:"validator_index"`
 	Signature        []byte                `json:"signature"`
+	QuantumSignature []byte                `json:"quantum_signature,omitempty"`
 }
 
 // CommitSig converts the Vote to a CommitSig.
@@ -79,6 +80,7 @@ func (vote *Vote) CommitSig() CommitSig {
 		ValidatorAddress: vote.ValidatorAddress,
 		Timestamp:        vote.Timestamp,
 		Signature:        vote.Signature,
+		QuantumSignature: vote.QuantumSignature,
 	}
 }
 
@@ -149,7 +151,7 @@ func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
 		return ErrVoteInvalidValidatorAddress
 	}
 	v := vote.ToProto()
-	if !pubKey.VerifySignature(VoteSignBytes(chainID, v), vote.Signature) {
+	if !VerifySignatures(pubKey, VoteSignBytes(chainID, v), vote.Signature, vote.QuantumSignature) {
 		return ErrVoteInvalidSignature
 	}
 	return nil
@@ -190,15 +192,7 @@ func (vote *Vote) ValidateBasic() error {
 	if vote.ValidatorIndex < 0 {
 		return errors.New("negative ValidatorIndex")
 	}
-	if len(vote.Signature) == 0 {
-		return errors.New("signature is missing")
-	}
-
-	if len(vote.Signature) > MaxSignatureSize {
-		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
-	}
-
-	return nil
+	return validateSignatures(vote.Signature, vote.QuantumSignature)
 }
 
 // ToProto converts the handwritten type to proto generated type
@@ -218,6 +212,7 @@ func (vote *Vote) ToProto() *tmproto.Vote {
 		ValidatorAddress: vote.ValidatorAddress,
 		ValidatorIndex:   vote.ValidatorIndex,
 		Signature:        vote.Signature,
+		QuantumSignature: vote.QuantumSignature,
 	}
 }
 
@@ -242,6 +237,7 @@ func VoteFromProto(pv *tmproto.Vote) (*Vote, error) {
 	vote.ValidatorAddress = pv.ValidatorAddress
 	vote.ValidatorIndex = pv.ValidatorIndex
 	vote.Signature = pv.Signature
+	vote.QuantumSignature = pv.QuantumSignature
 
 	return vote, vote.ValidateBasic()
 }
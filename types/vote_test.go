@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/fluentum-chain/fluentum/crypto"
+	"github.com/fluentum-chain/fluentum/crypto/dilithium"
 	"github.com/fluentum-chain/fluentum/crypto/ed25519"
+	"github.com/fluentum-chain/fluentum/crypto/hybrid"
 	"github.com/fluentum-chain/fluentum/crypto/tmhash"
 	"github.com/fluentum-chain/fluentum/libs/protoio"
 	tmproto "github.com/fluentum-chain/fluentum/proto/tendermint/types"
@@ -293,3 +295,44 @@ func TestVoteProtobuf(t *testing.T) {
 		}
 	}
 }
+
+func TestVoteVerifyHybrid(t *testing.T) {
+	privVal := NewMockPVWithParams(hybrid.GenPrivKeyWithMode(dilithium.Mode2), false, false)
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	vote := examplePrecommit()
+	vote.ValidatorAddress = pubKey.Address()
+	v := vote.ToProto()
+	require.NoError(t, privVal.SignVote("test_chain_id", v))
+	vote.Signature, vote.QuantumSignature = v.Signature, v.QuantumSignature
+
+	require.Len(t, vote.Signature, ed25519.SignatureSize)
+	require.Len(t, vote.QuantumSignature, dilithium.Mode2.SignatureSize())
+	require.NoError(t, vote.ValidateBasic())
+	require.NoError(t, vote.Verify("test_chain_id", pubKey))
+
+	// both signatures are required
+	classicOnly := vote.Copy()
+	classicOnly.QuantumSignature = nil
+	assert.Equal(t, ErrVoteInvalidSignature, classicOnly.Verify("test_chain_id", pubKey))
+	quantumOnly := vote.Copy()
+	quantumOnly.Signature = nil
+	assert.Equal(t, ErrVoteInvalidSignature, quantumOnly.Verify("test_chain_id", pubKey))
+
+	// classic keys don't accept quantum signatures
+	classicVal := NewMockPV()
+	classicPubKey, err := classicVal.GetPubKey()
+	require.NoError(t, err)
+	vote.ValidatorAddress = classicPubKey.Address()
+	v = vote.ToProto()
+	require.NoError(t, classicVal.SignVote("test_chain_id", v))
+	vote.Signature = v.Signature
+	require.Empty(t, v.QuantumSignature)
+	assert.Equal(t, ErrVoteInvalidSignature, vote.Verify("test_chain_id", classicPubKey))
+	vote.QuantumSignature = nil
+	assert.NoError(t, vote.Verify("test_chain_id", classicPubKey))
+
+	vote.QuantumSignature = make([]byte, MaxQuantumSignatureSize+1)
+	assert.Error(t, vote.ValidateBasic())
+}
@@ -102,3 +102,26 @@ func Compatible(minVersion string) bool {
 
 	return true
 }
+
+// Compare compares two semantic versions, returning -1, 0 or 1 if a is lower
+// than, equal to or greater than b
+func Compare(a, b string) (int, error) {
+	aMajor, aMinor, aPatch, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	bMajor, bMinor, bPatch, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, diff := range []int{aMajor - bMajor, aMinor - bMinor, aPatch - bPatch} {
+		switch {
+		case diff < 0:
+			return -1, nil
+		case diff > 0:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}